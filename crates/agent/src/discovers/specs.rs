@@ -10,7 +10,10 @@ pub fn parse_response(
     response: &[u8],
 ) -> Result<(models::CaptureEndpoint, Vec<Binding>), serde_json::Error> {
     let image_composed = format!("{image_name}{image_tag}");
-    tracing::debug!(%image_composed, response=%String::from_utf8_lossy(response), "converting response");
+    // Responses of large catalogs may be hundreds of megabytes.
+    // Log only a bounded prefix of the response.
+    let prefix = &response[..response.len().min(MAX_LOGGED_RESPONSE)];
+    tracing::debug!(%image_composed, response_len=response.len(), response=%String::from_utf8_lossy(prefix), "converting response");
 
     let Discovered { mut bindings } = serde_json::from_slice(response)?;
 
@@ -30,11 +33,17 @@ pub fn parse_response(
         models::CaptureEndpoint::Connector(models::ConnectorConfig {
             image: image_composed,
             config: endpoint_config.to_owned().into(),
+            env: Default::default(),
+            mounts: Vec::new(),
+            tmpfs: Vec::new(),
         }),
         bindings,
     ))
 }
 
+// Maximum number of bytes of a discover response which are logged.
+const MAX_LOGGED_RESPONSE: usize = 1 << 16;
+
 #[derive(Clone, Copy, Serialize, Deserialize, Debug, PartialEq)]
 pub enum BindingType {
     Existing,
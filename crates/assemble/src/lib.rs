@@ -102,6 +102,7 @@ pub fn partition_template(
             models::FragmentTemplate {
                 compression_codec: codec,
                 flush_interval,
+                kms_key,
                 length,
                 retention,
             },
@@ -171,7 +172,20 @@ pub fn partition_template(
             retention,
             stores: stores
                 .iter()
-                .map(|s| s.to_url(&collection).into())
+                .map(|s| {
+                    let mut url = s.to_url(&collection);
+
+                    // Fragments are persisted to S3 with SSE-KMS under the
+                    // customer-managed key. Gazette's S3 store parses these
+                    // query arguments into its server-side encryption config.
+                    // Validation rejects a kms_key for any other kind of store.
+                    if let (Some(key), models::Store::S3(_)) = (&kms_key, s) {
+                        url.query_pairs_mut()
+                            .append_pair("SSE", "aws:kms")
+                            .append_pair("SSEKMSKeyId", key);
+                    }
+                    url.into()
+                })
                 .collect(),
         }),
         flags,
@@ -298,6 +312,8 @@ pub fn shard_template(
         read_channel_size,
         ring_buffer_size,
         log_level,
+        rediscover_interval,
+        memory_limit,
     } = shard;
 
     // We hard-code that recovery logs always have prefix "recovery".
@@ -387,6 +403,19 @@ pub fn shard_template(
             });
         }
     }
+    // Re-discovery applies only to captures. Its label is a Go duration.
+    if let (Some(interval), labels::TASK_TYPE_CAPTURE) = (rediscover_interval, task_type) {
+        labels.push(broker::Label {
+            name: labels::REDISCOVER_INTERVAL.to_string(),
+            value: format!("{}s", interval.as_secs()),
+        });
+    }
+    if let Some(limit) = memory_limit {
+        labels.push(broker::Label {
+            name: labels::MEMORY_LIMIT.to_string(),
+            value: limit.to_string(),
+        });
+    }
     // Labels must be in lexicographic order.
     labels.sort_by(|l, r| l.name.cmp(&r.name));
 
@@ -739,4 +768,85 @@ mod test {
         let labels = journal_selector(&collection, Some(&selector));
         insta::assert_debug_snapshot!(labels);
     }
+
+    #[test]
+    fn partition_stores_use_kms_key() {
+        let journals = models::JournalTemplate {
+            fragments: models::FragmentTemplate {
+                kms_key: Some("arn:aws:kms:us-east-1:123:key/abc".to_string()),
+                ..Default::default()
+            },
+        };
+        let stores = vec![
+            models::Store::S3(models::BucketAndPrefix {
+                bucket: "a-bucket".to_string(),
+                prefix: None,
+            }),
+            models::Store::Gcs(models::BucketAndPrefix {
+                bucket: "b-bucket".to_string(),
+                prefix: None,
+            }),
+        ];
+        let collection = models::Collection::new("acmeCo/collection");
+        let spec = partition_template("a-build", &collection, &journals, &stores);
+        let stores = spec.fragment.unwrap().stores;
+
+        assert_eq!(
+            stores,
+            vec![
+                "s3://a-bucket/?SSE=aws%3Akms&SSEKMSKeyId=arn%3Aaws%3Akms%3Aus-east-1%3A123%3Akey%2Fabc"
+                    .to_string(),
+                "gs://b-bucket/".to_string(),
+            ]
+        );
+    }
+
+    #[test]
+    fn shard_template_labels() {
+        let shard = models::ShardTemplate {
+            rediscover_interval: Some(Duration::from_secs(6 * 60 * 60)),
+            memory_limit: Some(1 << 30),
+            ..Default::default()
+        };
+        let shard_labels = |task_type: &str| -> Vec<(String, String)> {
+            shard_template("a-build", "acmeCo/task", task_type, &shard, false, &[])
+                .labels
+                .unwrap()
+                .labels
+                .into_iter()
+                .map(|l| (l.name, l.value))
+                .collect()
+        };
+
+        // Captures are labeled with their re-discover interval, as a Go duration.
+        assert_eq!(
+            shard_labels(labels::TASK_TYPE_CAPTURE),
+            vec![
+                (
+                    labels::MANAGED_BY.to_string(),
+                    labels::MANAGED_BY_FLOW.to_string()
+                ),
+                (labels::BUILD.to_string(), "a-build".to_string()),
+                (labels::LOG_LEVEL.to_string(), "info".to_string()),
+                (labels::MEMORY_LIMIT.to_string(), "1073741824".to_string()),
+                (
+                    labels::REDISCOVER_INTERVAL.to_string(),
+                    "21600s".to_string()
+                ),
+                (labels::TASK_NAME.to_string(), "acmeCo/task".to_string()),
+                (
+                    labels::TASK_TYPE.to_string(),
+                    labels::TASK_TYPE_CAPTURE.to_string()
+                ),
+            ]
+        );
+        // Other task types are not, but have a memory limit.
+        let materialization = shard_labels(labels::TASK_TYPE_MATERIALIZATION);
+        assert!(!materialization
+            .iter()
+            .any(|(name, _)| name == labels::REDISCOVER_INTERVAL));
+        assert!(
+            materialization.contains(&(labels::MEMORY_LIMIT.to_string(), "1073741824".to_string()))
+        );
+    }
 }
@@ -77,7 +77,9 @@ pub fn project_root(source: &url::Url) -> url::Url {
 /// Load a Flow specification `source` into tables::Sources.
 /// All file:// resources are rooted ("jailed") to the given `file_root`.
 pub async fn load(source: &url::Url, file_root: &Path) -> tables::Sources {
-    let loader = sources::Loader::new(tables::Sources::default(), Fetcher::new(file_root));
+    let fetcher = Fetcher::new(file_root);
+    let refused = fetcher.refused.clone();
+    let loader = sources::Loader::new(tables::Sources::default(), fetcher);
 
     loader
         .load_resource(
@@ -87,7 +89,41 @@ pub async fn load(source: &url::Url, file_root: &Path) -> tables::Sources {
         )
         .await;
 
-    loader.into_tables()
+    let mut tables = loader.into_tables();
+    collect_refused(&mut tables.errors, source, &refused.lock().unwrap());
+    tables
+}
+
+// Air-gapped mode refuses each remote resource of the catalog. Rather than
+// failing on each, replace their fetch errors with one error listing all of
+// the remote resources which the catalog requires.
+fn collect_refused(errors: &mut tables::Errors, source: &url::Url, refused: &[url::Url]) {
+    if refused.is_empty() {
+        return;
+    }
+    errors.retain(|tables::Error { error, .. }| {
+        !matches!(
+            error.downcast_ref::<sources::LoadError>(),
+            Some(sources::LoadError::Fetch { uri, .. }) if refused.contains(uri)
+        )
+    });
+
+    let mut refused = refused.to_vec();
+    refused.sort();
+    refused.dedup();
+
+    let listing = refused
+        .iter()
+        .map(|resource| format!("\n  {resource}"))
+        .collect::<String>();
+
+    errors.insert_row(
+        source,
+        anyhow::anyhow!(
+            "air-gapped mode doesn't allow fetching remote resources, and this catalog requires {} of them (use local copies instead):{listing}",
+            refused.len(),
+        ),
+    );
 }
 
 /// Perform validations and produce built specifications for `sources`.
@@ -324,6 +360,10 @@ pub fn write_files(project_root: &url::Url, files: Vec<(url::Url, Vec<u8>)>) ->
 struct Fetcher {
     client: reqwest::Result<reqwest::Client>,
     file_root: PathBuf,
+    // If true, remote resources are not fetched.
+    airgapped: bool,
+    // Remote resources which were refused because of `airgapped`.
+    refused: std::sync::Arc<std::sync::Mutex<Vec<url::Url>>>,
 }
 
 impl Fetcher {
@@ -333,6 +373,8 @@ impl Fetcher {
         Self {
             client,
             file_root: file_root.into(),
+            airgapped: runtime::airgapped(),
+            refused: Default::default(),
         }
     }
 
@@ -342,6 +384,12 @@ impl Fetcher {
         mut file_path: PathBuf,
     ) -> anyhow::Result<bytes::Bytes> {
         match resource.scheme() {
+            "http" | "https" if self.airgapped => {
+                self.refused.lock().unwrap().push(resource.clone());
+                anyhow::bail!(
+                    "air-gapped mode doesn't allow fetching remote resource '{resource}' (use a local copy instead)"
+                );
+            }
             "http" | "https" => {
                 let client = match &self.client {
                     Ok(ok) => ok,
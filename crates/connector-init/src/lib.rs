@@ -32,9 +32,17 @@ pub async fn run(
     log_level: String,
 ) -> anyhow::Result<()> {
     // Bind our port before we do anything else.
-    let addr = format!("0.0.0.0:{}", port).parse().unwrap();
-    let incoming = TcpIncoming::new(addr, true, None)
-        .map_err(|e| anyhow::anyhow!("tcp incoming error {}", e))?;
+    // Prefer the IPv6 unspecified address, which also accepts IPv4 connections
+    // on dual-stack hosts, and fall back to IPv4 where IPv6 is disabled.
+    let incoming = match TcpIncoming::new(format!("[::]:{port}").parse().unwrap(), true, None) {
+        Ok(incoming) => incoming,
+        // Don't log here: our container host expects our first write to stderr
+        // to be the readiness byte written below.
+        Err(_) => {
+            TcpIncoming::new(format!("0.0.0.0:{port}").parse().unwrap(), true, None)
+                .map_err(|e| anyhow::anyhow!("tcp incoming error {}", e))?
+        }
+    };
 
     // Now write a byte to stderr to let our container host know that we're alive.
     // Whitespace avoids interfering with JSON logs that also write to stderr.
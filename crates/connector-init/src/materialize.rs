@@ -1,6 +1,7 @@
 use super::{codec::Codec, rpc};
 use futures::{StreamExt, TryStreamExt};
 use proto_flow::materialize::{Request, Response};
+use proto_flow::runtime::materialize_response_ext;
 
 pub struct Proxy {
     pub entrypoint: Vec<String>,
@@ -19,16 +20,28 @@ impl proto_grpc::materialize::connector_server::Connector for Proxy {
         crate::inc(&crate::GRPC_SERVER_STARTED_TOTAL);
         let guard = crate::IncOnDrop(&crate::GRPC_SERVER_HANDLED_TOTAL);
 
+        let keepalive_interval = rpc::keepalive_interval(request.metadata());
+
+        fn keepalive() -> Response {
+            Response::default().with_internal(|internal| {
+                internal.keepalive = Some(materialize_response_ext::Keepalive {});
+            })
+        }
+
         Ok(tonic::Response::new(
-            rpc::bidi::<Request, Response, _, _>(
-                rpc::new_command(&self.entrypoint),
-                self.codec,
-                request.into_inner().map_ok(|mut request| {
-                    request.internal.clear(); // TODO(johnny): Temporarily remove $internal.
-                    request
-                }),
-                ops::stderr_log_handler,
-            )?
+            rpc::with_keepalives(
+                rpc::bidi::<Request, Response, _, _>(
+                    rpc::new_command(&self.entrypoint),
+                    self.codec,
+                    request.into_inner().map_ok(|mut request| {
+                        request.internal.clear(); // TODO(johnny): Temporarily remove $internal.
+                        request
+                    }),
+                    ops::stderr_log_handler,
+                )?,
+                keepalive_interval,
+                keepalive,
+            )
             .map(move |response| {
                 let _ = &guard;
                 response
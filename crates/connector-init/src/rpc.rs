@@ -217,13 +217,69 @@ where
     last_log
 }
 
+/// Metadata key of an RPC through which the runtime requests keepalives,
+/// sent at an interval of integer milliseconds.
+pub const KEEPALIVE_METADATA_KEY: &str = "flow-keepalive-interval-ms";
+
+/// Keepalive interval requested by the `metadata` of an RPC, if any.
+pub fn keepalive_interval(metadata: &tonic::metadata::MetadataMap) -> Option<std::time::Duration> {
+    let interval = metadata.get(KEEPALIVE_METADATA_KEY)?.to_str().ok()?;
+
+    match interval.parse::<u64>() {
+        Ok(ms) if ms != 0 => Some(std::time::Duration::from_millis(ms)),
+        _ => {
+            tracing::warn!(%interval, "ignoring invalid {KEEPALIVE_METADATA_KEY}");
+            None
+        }
+    }
+}
+
+/// Interleave keepalives into `responses`, which are sent whenever `interval`
+/// elapses without a response. Keepalives are produced regardless of whether
+/// the connector is consuming its requests, so that a runtime which awaits
+/// a response can distinguish a busy connector from a dead one.
+/// The returned stream ends with `responses`.
+pub fn with_keepalives<Out, S>(
+    responses: S,
+    interval: Option<std::time::Duration>,
+    keepalive: fn() -> Out,
+) -> futures::stream::BoxStream<'static, tonic::Result<Out>>
+where
+    Out: Send + 'static,
+    S: futures::Stream<Item = tonic::Result<Out>> + Send + 'static,
+{
+    let Some(interval) = interval else {
+        return responses.boxed();
+    };
+    let ticker = tokio::time::interval_at(tokio::time::Instant::now() + interval, interval);
+
+    futures::stream::unfold(
+        (responses.boxed(), ticker),
+        move |(mut responses, mut ticker)| async move {
+            tokio::select! {
+                biased;
+
+                response = responses.next() => {
+                    ticker.reset();
+                    response.map(|response| (response, (responses, ticker)))
+                }
+                _ = ticker.tick() => Some((Ok(keepalive()), (responses, ticker))),
+            }
+        },
+    )
+    .boxed()
+}
+
 fn map_status<E: Into<anyhow::Error>>(message: &'static str, err: E) -> Status {
     Status::internal(format!("{:#}", anyhow::anyhow!(err).context(message)))
 }
 
 #[cfg(test)]
 mod test {
-    use super::{bidi, new_command, process_logs, unary, Codec};
+    use super::{
+        bidi, keepalive_interval, new_command, process_logs, unary, with_keepalives, Codec,
+        KEEPALIVE_METADATA_KEY,
+    };
     use futures::{StreamExt, TryStreamExt};
     use proto_flow::flow::TestSpec;
 
@@ -356,6 +412,47 @@ mod test {
         }
     }
 
+    #[tokio::test]
+    async fn test_keepalives() {
+        fn keepalive() -> TestSpec {
+            TestSpec {
+                name: "keepalive".to_string(),
+                ..Default::default()
+            }
+        }
+
+        // "sleep" produces no output, but keepalives are sent while it runs.
+        let responses: Vec<String> = with_keepalives(
+            bidi::<TestSpec, TestSpec, _, _>(
+                new_command(&["sleep".to_string(), "1".to_string()]),
+                Codec::Proto,
+                futures::stream::empty(),
+                ops::stderr_log_handler,
+            )
+            .unwrap(),
+            Some(std::time::Duration::from_millis(200)),
+            keepalive,
+        )
+        .map_ok(|response| response.name)
+        .try_collect()
+        .await
+        .unwrap();
+
+        assert!(responses.len() >= 3, "{responses:?}");
+        assert!(responses.iter().all(|name| name == "keepalive"));
+
+        // The interval is passed through RPC metadata.
+        let mut metadata = tonic::metadata::MetadataMap::new();
+        assert_eq!(keepalive_interval(&metadata), None);
+        metadata.insert(KEEPALIVE_METADATA_KEY, "2500".parse().unwrap());
+        assert_eq!(
+            keepalive_interval(&metadata),
+            Some(std::time::Duration::from_millis(2500))
+        );
+        metadata.insert(KEEPALIVE_METADATA_KEY, "10s".parse().unwrap());
+        assert_eq!(keepalive_interval(&metadata), None);
+    }
+
     #[tokio::test]
     async fn test_bidi_true() {
         let requests = futures::stream::repeat_with(|| {
@@ -1,4 +1,7 @@
-use super::{dbutil, do_validate, parse_validate, Config, Lambda, Param, Transform};
+use super::{
+    dbutil, do_validate, lambda::convert_value_ref, parse_validate, Config, ErrorPolicy, Lambda,
+    OnError, Param, Transform,
+};
 use anyhow::Context;
 use futures::channel::mpsc;
 use futures::{SinkExt, StreamExt};
@@ -6,8 +9,9 @@ use prost::Message;
 use proto_flow::runtime::{derive_request_ext, derive_response_ext, DeriveRequestExt};
 use proto_flow::{
     derive::{request, response, Request, Response},
-    flow, RuntimeCheckpoint,
+    flow, ops, RuntimeCheckpoint,
 };
+use std::time::{Duration, Instant};
 
 pub fn connector<R>(request_rx: R) -> mpsc::Receiver<anyhow::Result<Response>>
 where
@@ -39,6 +43,8 @@ where
     let mut migrations: Vec<String> = Vec::new();
     // Configured transform blocks and parameters of the last Request.Open.
     let mut transforms: Vec<Transform> = Vec::new();
+    // Failed documents of each transform within the current transaction.
+    let mut failures: Vec<Failures> = Vec::new();
     // A possibly opened Sqlite DB context.
     let mut maybe_handle: Option<Handle> = None;
 
@@ -90,6 +96,11 @@ where
                     .await;
 
                 maybe_handle = Some(handle);
+                failures = transforms
+                    .iter()
+                    .map(Failures::new)
+                    .collect::<anyhow::Result<_>>()
+                    .map_err(anyhow_to_status)?;
             }
             Some(Request {
                 read: Some(read), ..
@@ -98,20 +109,50 @@ where
                     .as_mut()
                     .ok_or_else(|| tonic::Status::invalid_argument("Read without Open"))?;
 
-                do_read(&mut handle.transforms, read, response_tx, &tokio_handle)
-                    .map_err(anyhow_to_status)?;
+                do_read(
+                    handle,
+                    &transforms,
+                    &mut failures,
+                    read,
+                    response_tx,
+                    &tokio_handle,
+                )
+                .map_err(anyhow_to_status)?;
             }
             Some(Request {
                 flush: Some(request::Flush {}),
                 ..
             }) => {
+                let stats: std::collections::BTreeMap<_, _> = transforms
+                    .iter()
+                    .zip(failures.iter_mut())
+                    .filter_map(|(transform, failures)| {
+                        failures
+                            .take_stats()
+                            .map(|stats| (transform.name.clone(), stats))
+                    })
+                    .collect();
+
+                let mut flushed = Response {
+                    flushed: Some(response::Flushed {}),
+                    ..Default::default()
+                };
+                // Extend Flushed with the stats of failed documents, if there were any.
+                if !stats.is_empty() {
+                    flushed = flushed.with_internal(|internal| {
+                        internal.flushed = Some(derive_response_ext::Flushed {
+                            stats: Some(ops::Stats {
+                                derive: Some(ops::stats::Derive {
+                                    transforms: stats,
+                                    ..Default::default()
+                                }),
+                                ..Default::default()
+                            }),
+                        });
+                    });
+                }
                 // Send Flushed to runtime.
-                let _ = response_tx
-                    .send(Ok(Response {
-                        flushed: Some(response::Flushed {}),
-                        ..Default::default()
-                    }))
-                    .await;
+                let _ = response_tx.send(Ok(flushed)).await;
             }
             Some(Request {
                 start_commit: Some(request::StartCommit { runtime_checkpoint }),
@@ -140,6 +181,24 @@ where
                 let (db, _runtime_checkpoint) =
                     Handle::new(":memory:", &migrations, &transforms).map_err(anyhow_to_status)?;
                 maybe_handle = Some(db);
+                failures = transforms
+                    .iter()
+                    .map(Failures::new)
+                    .collect::<anyhow::Result<_>>()
+                    .map_err(anyhow_to_status)?;
+            }
+            Some(request) if is_query(&request) => {
+                let handle = maybe_handle
+                    .as_ref()
+                    .ok_or_else(|| tonic::Status::invalid_argument("Query without Open"))?;
+                let query = request.get_internal()?.query.unwrap();
+
+                // Send Queried to runtime.
+                let _ = response_tx
+                    .send(Ok(Response::default().with_internal(|internal| {
+                        internal.queried = Some(do_query(handle.conn, query));
+                    })))
+                    .await;
             }
             Some(malformed) => Err(tonic::Status::invalid_argument(format!(
                 "invalid request {malformed:?}"
@@ -213,6 +272,7 @@ fn parse_open(
                 block,
                 source: source.name.clone(),
                 params,
+                policy: config.on_error.get(name).cloned(),
             })
         })
         .collect::<Result<_, anyhow::Error>>()?;
@@ -220,49 +280,244 @@ fn parse_open(
     Ok((sqlite_uri, config.migrations, transforms))
 }
 
-fn do_read<'db>(
-    transforms: &mut [(String, Vec<Lambda<'db>>)],
+fn do_read(
+    handle: &mut Handle,
+    transforms: &[Transform],
+    failures: &mut [Failures],
     read: request::Read,
     response_tx: &mut mpsc::Sender<anyhow::Result<Response>>,
     tokio_handle: &tokio::runtime::Handle,
 ) -> anyhow::Result<()> {
     let request::Read {
-        transform,
+        transform: index,
         doc_json,
         uuid: _,
         shuffle: _,
     } = read;
 
-    let (transform, stack) = transforms
-        .get_mut(transform as usize)
-        .with_context(|| format!("invalid transform index {transform}"))?;
+    let conn = handle.conn;
+    let (transform, stack) = handle
+        .transforms
+        .get_mut(index as usize)
+        .with_context(|| format!("invalid transform index {index}"))?;
 
     let doc: serde_json::Value = serde_json::from_str(&doc_json)
         .with_context(|| format!("couldn't parse read document as JSON: {doc_json}",))?;
 
-    // Invoke each lambda of the stack in turn, streaming published documents into `response_tx`.
-    // It's important that we don't block here -- these result sets could be very large.
+    let Some(policy) = &transforms[index as usize].policy else {
+        // Invoke each lambda of the stack in turn, streaming published documents into `response_tx`.
+        // It's important that we don't block here -- these result sets could be very large.
+        for (index, lambda) in stack.iter_mut().enumerate() {
+            let it = lambda.invoke(&doc)?;
+
+            let it = it.map(|published| match published {
+                Ok(published) => Ok(Ok(Response {
+                    published: Some(response::Published {
+                        doc_json: published.to_string(),
+                    }),
+                    ..Default::default()
+                })),
+                Err(err) => Ok(Err(anyhow::anyhow!(
+                    "failed to invoke transform {transform:?} lambda statement at offset {index}: {err}\nDocument was {}",
+                    serde_json::to_string_pretty(&doc).unwrap()
+                ))),
+            });
+
+            _ = tokio_handle.block_on(response_tx.send_all(&mut futures::stream::iter(it)));
+        }
+        return Ok(());
+    };
+
+    // The transform has an error policy. Its published documents are buffered
+    // and its lambdas are invoked within a savepoint, so that a document which
+    // fails has no effect upon either the derived collection or the database.
+    conn.execute_batch("SAVEPOINT flow_read;")?;
+
+    let mut published = Vec::new();
+    let mut result = Ok(());
+
     for (index, lambda) in stack.iter_mut().enumerate() {
-        let it = lambda.invoke(&doc)?;
-
-        let it = it.map(|published| match published {
-            Ok(published) => Ok(Ok(Response {
-                published: Some(response::Published {
-                    doc_json: published.to_string(),
-                }),
-                ..Default::default()
-            })),
-            Err(err) => Ok(Err(anyhow::anyhow!(
-                "failed to invoke transform {transform:?} lambda statement at offset {index}: {err}\nDocument was {}",
-                serde_json::to_string_pretty(&doc).unwrap()
-            ))),
-        });
-
-        _ = tokio_handle.block_on(response_tx.send_all(&mut futures::stream::iter(it)));
+        result = lambda
+            .invoke(&doc)
+            .map_err(anyhow::Error::from)
+            .and_then(|it| {
+                for row in it {
+                    published.push(row?);
+                }
+                Ok(())
+            })
+            .with_context(|| {
+                format!(
+                    "failed to invoke transform {transform:?} lambda statement at offset {index}"
+                )
+            });
+
+        if result.is_err() {
+            break;
+        }
+    }
+
+    if let Err(err) = result {
+        conn.execute_batch("ROLLBACK TO flow_read; RELEASE flow_read;")?;
+
+        if let Some(dead_letter) =
+            failures[index as usize].record(conn, transform, policy, &doc, doc_json.len(), err)?
+        {
+            _ = tokio_handle.block_on(response_tx.send(Ok(dead_letter)));
+        }
+        return Ok(());
     }
+    conn.execute_batch("RELEASE flow_read;")?;
+
+    let mut it = futures::stream::iter(published.into_iter().map(|published| {
+        Ok(Ok(Response {
+            published: Some(response::Published {
+                doc_json: published.to_string(),
+            }),
+            ..Default::default()
+        }))
+    }));
+    _ = tokio_handle.block_on(response_tx.send_all(&mut it));
+
     Ok(())
 }
 
+// Failures tracks the documents of a transform which failed within the
+// current transaction, and were handled by its error policy.
+#[derive(Default)]
+struct Failures {
+    skipped: ops::stats::DocsAndBytes,
+    dead_lettered: ops::stats::DocsAndBytes,
+    // Validator of dead-lettered documents, if the transform has a dead-letter collection.
+    dead_letter_validator: Option<doc::Validator>,
+}
+
+impl Failures {
+    fn new(transform: &Transform) -> anyhow::Result<Self> {
+        let Some(schema) = transform
+            .policy
+            .as_ref()
+            .and_then(|policy| policy.dead_letter_schema.as_ref())
+        else {
+            return Ok(Self::default());
+        };
+
+        let built_schema = doc::validation::build_bundle(schema.get()).with_context(|| {
+            format!(
+                "transform {:?} dead-letter write schema is not a JSON schema",
+                transform.name
+            )
+        })?;
+        let validator =
+            doc::Validator::new(built_schema).context("could not build a schema validator")?;
+
+        Ok(Self {
+            dead_letter_validator: Some(validator),
+            ..Default::default()
+        })
+    }
+
+    // Record a failure of `doc`, which either continues (skipping or dead-lettering
+    // the document) or returns the error to halt the derivation, per `policy`.
+    // A dead-lettered document is returned as a Published response of its
+    // dead-letter collection.
+    fn record(
+        &mut self,
+        conn: &rusqlite::Connection,
+        transform: &str,
+        policy: &ErrorPolicy,
+        doc: &serde_json::Value,
+        doc_bytes: usize,
+        err: anyhow::Error,
+    ) -> anyhow::Result<Option<Response>> {
+        let halt = |err: anyhow::Error| {
+            err.context(format!(
+                "Document was {}",
+                serde_json::to_string_pretty(doc).unwrap()
+            ))
+        };
+
+        if let Some(budget) = policy.error_budget {
+            // Failures are counted within the database, so that the budget
+            // spans the transactions of the derivation.
+            if dbutil::increment_failures(conn, transform)? > budget as u64 {
+                return Err(halt(err).context(format!(
+                    "transform {transform:?} exceeded its error budget of {budget} failed documents"
+                )));
+            }
+        }
+
+        match policy.on_error {
+            OnError::Halt => Err(halt(err)),
+            OnError::Skip => {
+                self.skipped.docs_total += 1;
+                self.skipped.bytes_total += doc_bytes as u64;
+                Ok(None)
+            }
+            OnError::DeadLetter => {
+                // The runtime places the document UUID at `_meta/uuid`. We validate
+                // with its placeholder, as is done for documents of the derived collection.
+                let dead_letter = serde_json::json!({
+                    "_meta": {"uuid": UUID_PLACEHOLDER},
+                    "transform": transform,
+                    "error": format!("{err:#}"),
+                    "document": doc,
+                });
+
+                if let Some(validator) = &mut self.dead_letter_validator {
+                    () = || -> anyhow::Result<()> {
+                        let _valid = validator.validate(None, &dead_letter)?.ok()?;
+                        Ok(())
+                    }()
+                    .map_err(halt)
+                    .with_context(|| {
+                        format!(
+                            "dead-lettered document of transform {transform:?} is invalid against the schema of collection {}",
+                            policy.dead_letter.as_deref().unwrap_or_default(),
+                        )
+                    })?;
+                }
+                self.dead_lettered.docs_total += 1;
+                self.dead_lettered.bytes_total += doc_bytes as u64;
+
+                let doc_json = dead_letter.to_string();
+
+                Ok(Some(
+                    Response {
+                        published: Some(response::Published { doc_json }),
+                        ..Default::default()
+                    }
+                    .with_internal(|internal| {
+                        internal.published = Some(derive_response_ext::Published {
+                            dead_letter: policy.dead_letter.clone().unwrap_or_default(),
+                            ..Default::default()
+                        });
+                    }),
+                ))
+            }
+        }
+    }
+
+    // Take the failures of a completed transaction as transform stats,
+    // if there were any.
+    fn take_stats(&mut self) -> Option<ops::stats::derive::Transform> {
+        let skipped = std::mem::take(&mut self.skipped);
+        let dead_lettered = std::mem::take(&mut self.dead_lettered);
+
+        if skipped.docs_total == 0 && dead_lettered.docs_total == 0 {
+            return None;
+        }
+        Some(ops::stats::derive::Transform {
+            skipped: Some(skipped).filter(|s| s.docs_total != 0),
+            dead_lettered: Some(dead_lettered).filter(|s| s.docs_total != 0),
+            ..Default::default()
+        })
+    }
+}
+
+// Placeholder of document UUIDs, which matches that of the runtime.
+const UUID_PLACEHOLDER: &str = "DocUUIDPlaceholder-329Bb50aa48EAa9ef";
+
 fn do_commit(
     conn: &rusqlite::Connection,
     runtime_checkpoint: Option<RuntimeCheckpoint>,
@@ -275,6 +530,114 @@ fn do_commit(
     Ok(response::StartedCommit { state: None })
 }
 
+fn is_query(request: &Request) -> bool {
+    matches!(
+        request.get_internal(),
+        Ok(DeriveRequestExt { query: Some(_), .. })
+    )
+}
+
+// Run a read-only query of the database, which is between transactions.
+// Query errors are returned to the client and don't fail the derivation.
+fn do_query(
+    conn: &rusqlite::Connection,
+    query: derive_request_ext::Query,
+) -> derive_response_ext::Queried {
+    match try_query(conn, &query) {
+        Ok((columns, rows)) => derive_response_ext::Queried {
+            columns,
+            rows_json: serde_json::to_string(&rows).unwrap(),
+            error: String::new(),
+        },
+        Err(err) => derive_response_ext::Queried {
+            error: format!("{err:#}"),
+            ..Default::default()
+        },
+    }
+}
+
+fn try_query(
+    conn: &rusqlite::Connection,
+    derive_request_ext::Query {
+        sql,
+        params_json,
+        timeout_ms,
+        max_rows,
+    }: &derive_request_ext::Query,
+) -> anyhow::Result<(Vec<String>, Vec<Vec<serde_json::Value>>)> {
+    let params: Vec<serde_json::Value> = if params_json.is_empty() {
+        Vec::new()
+    } else {
+        serde_json::from_str(params_json).context("failed to parse query parameters")?
+    };
+
+    let mut stmt = conn.prepare(sql)?;
+    if !stmt.readonly() {
+        anyhow::bail!("query must be read-only");
+    }
+    let columns: Vec<String> = stmt.column_names().into_iter().map(String::from).collect();
+
+    for (index, param) in params.into_iter().enumerate() {
+        use serde_json::Value;
+
+        let index = index + 1;
+        match param {
+            Value::Null => stmt.raw_bind_parameter(index, rusqlite::types::Null),
+            Value::Bool(b) => stmt.raw_bind_parameter(index, b),
+            Value::Number(n) if n.is_i64() => stmt.raw_bind_parameter(index, n.as_i64()),
+            Value::Number(n) => stmt.raw_bind_parameter(index, n.as_f64()),
+            Value::String(s) => stmt.raw_bind_parameter(index, s),
+            other => stmt.raw_bind_parameter(index, other.to_string()),
+        }
+        .with_context(|| format!("failed to bind query parameter {index}"))?;
+    }
+
+    // Interrupt the query if it runs past its deadline, so that it
+    // doesn't block the next transaction of the derivation.
+    if *timeout_ms != 0 {
+        let deadline = Instant::now() + Duration::from_millis(*timeout_ms as u64);
+        conn.progress_handler(1000, Some(move || Instant::now() > deadline));
+    }
+    let rows = collect_query_rows(&mut stmt, columns.len(), *max_rows as usize);
+    conn.progress_handler(0, None::<fn() -> bool>);
+
+    match rows {
+        Err(err) if is_interrupted(&err) => {
+            anyhow::bail!("query didn't complete within {timeout_ms}ms and was interrupted")
+        }
+        rows => Ok((columns, rows?)),
+    }
+}
+
+fn is_interrupted(err: &anyhow::Error) -> bool {
+    matches!(
+        err.downcast_ref::<rusqlite::Error>(),
+        Some(rusqlite::Error::SqliteFailure(failure, _))
+            if failure.code == rusqlite::ErrorCode::OperationInterrupted
+    )
+}
+
+fn collect_query_rows(
+    stmt: &mut rusqlite::Statement<'_>,
+    num_columns: usize,
+    max_rows: usize,
+) -> anyhow::Result<Vec<Vec<serde_json::Value>>> {
+    let mut rows = stmt.raw_query();
+    let mut out = Vec::new();
+
+    while let Some(row) = rows.next()? {
+        if max_rows != 0 && out.len() == max_rows {
+            anyhow::bail!("query returned more than {max_rows} rows");
+        }
+        out.push(
+            (0..num_columns)
+                .map(|index| convert_value_ref(row.get_ref_unwrap(index)))
+                .collect(),
+        );
+    }
+    Ok(out)
+}
+
 struct Handle {
     conn: &'static rusqlite::Connection,
     transforms: Vec<(String, Vec<Lambda<'static>>)>,
@@ -314,3 +677,187 @@ impl Drop for Handle {
 fn anyhow_to_status(err: anyhow::Error) -> tonic::Status {
     tonic::Status::internal(format!("{err:#}"))
 }
+
+#[cfg(test)]
+mod test {
+    use super::*;
+
+    #[test]
+    fn test_failures_within_budget() {
+        let (conn, _) = dbutil::open(":memory:", &[]).unwrap();
+
+        let transform = Transform {
+            name: "fromSource".to_string(),
+            source: "a/source".to_string(),
+            block: String::new(),
+            params: Vec::new(),
+            policy: Some(ErrorPolicy {
+                on_error: OnError::DeadLetter,
+                error_budget: Some(3),
+                dead_letter: Some("dead/letters".to_string()),
+                dead_letter_schema: Some(
+                    serde_json::value::to_raw_value(&serde_json::json!({
+                        "type": "object",
+                        "properties": {
+                            "_meta": {"required": ["uuid"]},
+                            "document": {"required": ["id"]},
+                        },
+                        "required": ["_meta", "transform", "error", "document"],
+                    }))
+                    .unwrap(),
+                ),
+            }),
+        };
+        let policy = transform.policy.as_ref().unwrap();
+        let doc = serde_json::json!({"id": 42});
+        let mut failures = Failures::new(&transform).unwrap();
+
+        let dead_letter = failures
+            .record(
+                &conn,
+                "fromSource",
+                policy,
+                &doc,
+                9,
+                anyhow::anyhow!("whoops"),
+            )
+            .unwrap()
+            .unwrap();
+
+        let response::Published { doc_json } = dead_letter.published.clone().unwrap();
+        assert_eq!(
+            serde_json::from_str::<serde_json::Value>(&doc_json).unwrap(),
+            serde_json::json!({
+                "_meta": {"uuid": UUID_PLACEHOLDER},
+                "transform": "fromSource",
+                "error": "whoops",
+                "document": {"id": 42},
+            })
+        );
+        let ext = dead_letter.get_internal().unwrap();
+        assert_eq!(ext.published.unwrap().dead_letter, "dead/letters");
+
+        // A dead-lettered document which is invalid against the schema
+        // of the dead-letter collection halts the derivation.
+        let err = failures
+            .record(
+                &conn,
+                "fromSource",
+                policy,
+                &serde_json::json!({"other": true}),
+                14,
+                anyhow::anyhow!("whoops"),
+            )
+            .unwrap_err();
+        assert!(
+            format!("{err:#}").contains("is invalid against the schema of collection dead/letters")
+        );
+
+        let stats = failures.take_stats().unwrap();
+        assert_eq!(stats.skipped, None);
+        assert_eq!(
+            stats.dead_lettered,
+            Some(ops::stats::DocsAndBytes {
+                docs_total: 1,
+                bytes_total: 9,
+            })
+        );
+        assert!(failures.take_stats().is_none());
+
+        // The error budget spans transactions.
+        dbutil::commit_and_begin(&conn).unwrap();
+
+        assert!(failures
+            .record(
+                &conn,
+                "fromSource",
+                policy,
+                &doc,
+                9,
+                anyhow::anyhow!("whoops")
+            )
+            .is_ok());
+        let err = failures
+            .record(
+                &conn,
+                "fromSource",
+                policy,
+                &doc,
+                9,
+                anyhow::anyhow!("whoops"),
+            )
+            .unwrap_err();
+        assert!(format!("{err:#}").contains("exceeded its error budget of 3"));
+
+        let policy = ErrorPolicy {
+            on_error: OnError::Skip,
+            error_budget: None,
+            dead_letter: None,
+            dead_letter_schema: None,
+        };
+        let mut failures = Failures::default();
+
+        for _ in 0..100 {
+            assert!(failures
+                .record(&conn, "other", &policy, &doc, 9, anyhow::anyhow!("whoops"))
+                .unwrap()
+                .is_none());
+        }
+        assert_eq!(failures.skipped.docs_total, 100);
+        assert_eq!(failures.skipped.bytes_total, 900);
+
+        assert!(Failures::default().take_stats().is_none());
+    }
+
+    #[test]
+    fn test_query_between_transactions() {
+        let migrations = vec![r#"
+            CREATE TABLE totals (key TEXT PRIMARY KEY NOT NULL, total INTEGER NOT NULL);
+            INSERT INTO totals (key, total) VALUES ('a', 1), ('b', 2), ('c', 3);
+        "#
+        .to_string()];
+        let (handle, _) = Handle::new(":memory:", &migrations, &[]).unwrap();
+
+        let query = |sql: &str, params_json: &str, timeout_ms, max_rows| {
+            do_query(
+                handle.conn,
+                derive_request_ext::Query {
+                    sql: sql.to_string(),
+                    params_json: params_json.to_string(),
+                    timeout_ms,
+                    max_rows,
+                },
+            )
+        };
+
+        let queried = query(
+            "SELECT key, total, key || '!' AS shout FROM totals WHERE total >= ? ORDER BY key",
+            "[2]",
+            1000,
+            10,
+        );
+        assert_eq!(queried.error, "");
+        assert_eq!(queried.columns, vec!["key", "total", "shout"]);
+        assert_eq!(queried.rows_json, r#"[["b",2,"b!"],["c",3,"c!"]]"#);
+
+        // Errors are returned, and don't fail the connector.
+        for (sql, timeout_ms, max_rows, expect) in [
+            ("SELECT * FROM totals", 1000, 2, "query returned more than 2 rows"),
+            ("DELETE FROM totals", 1000, 10, "query must be read-only"),
+            ("SELECT nope FROM totals", 1000, 10, "no such column: nope"),
+            (
+                "WITH RECURSIVE c(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM c) SELECT count(*) FROM c",
+                10,
+                10,
+                "query didn't complete within 10ms and was interrupted",
+            ),
+        ] {
+            let queried = query(sql, "", timeout_ms, max_rows);
+            assert!(queried.error.contains(expect), "{sql}: {}", queried.error);
+        }
+
+        // The database is unchanged, and further queries succeed.
+        let queried = query("SELECT count(*) AS n FROM totals", "", 1000, 10);
+        assert_eq!(queried.rows_json, "[[3]]");
+    }
+}
@@ -62,6 +62,7 @@ pub fn build_transforms<'db>(
         source: _,
         block,
         params,
+        policy: _,
     } in transforms
     {
         out.push((name.clone(), Vec::new()));
@@ -126,6 +127,24 @@ pub fn update_checkpoint(conn: &Connection, checkpoint: RuntimeCheckpoint) -> an
     Ok(())
 }
 
+// Increment the count of failed documents of `transform`, returning the updated count.
+// Counts are committed with transactions, and are tracked across them.
+pub fn increment_failures(conn: &Connection, transform: &str) -> anyhow::Result<u64> {
+    let failures: u64 = conn
+        .query_row(
+            r#"
+            INSERT INTO flow_failures (transform, failures) VALUES (?, 1)
+            ON CONFLICT (transform) DO UPDATE SET failures = failures + 1
+            RETURNING failures;
+            "#,
+            [transform],
+            |row| row.get(0),
+        )
+        .context("failed to update flow_failures")?;
+
+    Ok(failures)
+}
+
 pub fn commit_and_begin(conn: &Connection) -> anyhow::Result<()> {
     conn.execute_batch(
         r#"
@@ -247,6 +266,13 @@ const BOOTSTRAP: &str = r#"
         script       TEXT NOT NULL
     );
 
+    -- Prepare a table for tracking failed documents of transforms,
+    -- against which their error budgets are enforced.
+    CREATE TABLE IF NOT EXISTS flow_failures (
+        transform TEXT PRIMARY KEY NOT NULL,
+        failures  INTEGER NOT NULL
+    );
+
     "#;
 
 #[cfg(test)]
@@ -222,7 +222,7 @@ fn row_to_json(
     }
 }
 
-fn convert_value_ref(value: rusqlite::types::ValueRef<'_>) -> serde_json::Value {
+pub(crate) fn convert_value_ref(value: rusqlite::types::ValueRef<'_>) -> serde_json::Value {
     use rusqlite::types::ValueRef;
     use serde_json::{Number, Value};
 
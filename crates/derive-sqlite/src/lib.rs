@@ -50,6 +50,32 @@ fn is_url_to_generate(statement: &str) -> bool {
 pub struct Config {
     #[serde(default)]
     migrations: Vec<String>,
+    // Error policies of transforms, keyed on their names.
+    // These are populated from the derivation's transforms during its build.
+    #[serde(default, skip_serializing_if = "std::collections::BTreeMap::is_empty")]
+    on_error: std::collections::BTreeMap<String, ErrorPolicy>,
+}
+
+// Policy for source documents which a transform fails to process.
+#[derive(serde::Serialize, serde::Deserialize, Clone, Debug)]
+#[serde(rename_all = "camelCase")]
+pub struct ErrorPolicy {
+    on_error: OnError,
+    #[serde(default)]
+    error_budget: Option<u32>,
+    #[serde(default)]
+    dead_letter: Option<String>,
+    // Write schema of the `dead_letter` collection, populated during the build.
+    #[serde(default)]
+    dead_letter_schema: Option<Box<serde_json::value::RawValue>>,
+}
+
+#[derive(serde::Serialize, serde::Deserialize, Clone, Copy, Debug, PartialEq)]
+#[serde(rename_all = "camelCase")]
+pub enum OnError {
+    Skip,
+    DeadLetter,
+    Halt,
 }
 
 #[derive(Debug)]
@@ -58,6 +84,7 @@ pub struct Transform {
     source: String,
     block: String,
     params: Vec<Param>,
+    policy: Option<ErrorPolicy>,
 }
 
 #[cfg(test)]
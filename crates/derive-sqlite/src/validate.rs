@@ -50,6 +50,7 @@ pub fn parse_validate(
                 block,
                 source: source.name.clone(),
                 params,
+                policy: config.on_error.get(name).cloned(),
             })
         })
         .collect::<Result<_, anyhow::Error>>()?;
@@ -124,6 +125,7 @@ fn lambda_stub(
         source,
         block: _,
         params,
+        policy: _,
     }: &Transform,
 ) -> String {
     use std::fmt::Write;
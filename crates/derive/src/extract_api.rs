@@ -66,7 +66,7 @@ pub struct API {
 
 struct State {
     uuid_ptr: doc::Pointer,
-    extractors: Vec<doc::Extractor>,
+    extractors: doc::BatchExtractor,
     validator: Option<doc::Validator>,
 }
 
@@ -107,11 +107,11 @@ impl cgo::Service for API {
 
                 self.state = Some(State {
                     uuid_ptr: doc::Pointer::from(&uuid_ptr),
-                    extractors: extractors::for_key(
+                    extractors: doc::BatchExtractor::new(extractors::for_key(
                         &field_ptrs,
                         &projections,
                         &doc::SerPolicy::noop(),
-                    )?,
+                    )?),
                     validator,
                 });
                 Ok(())
@@ -141,9 +141,8 @@ impl cgo::Service for API {
                 // Send extracted, packed field pointers.
                 let begin = arena.len();
 
-                for ex in &state.extractors {
-                    ex.extract(&doc, arena).unwrap(); // Vec<u8> is infallible for io::Write.
-                }
+                // Vec<u8> is infallible for io::Write.
+                state.extractors.extract(&doc, arena).unwrap();
                 cgo::send_bytes(Code::ExtractedFields as u32, begin, arena, out);
 
                 self.state = Some(state);
@@ -1,4 +1,6 @@
-use crate::{compare::compare, AsNode, Node, OwnedNode, Pointer, SerPolicy};
+use crate::{
+    compare::compare, ptr::Token, AsNode, Field, Fields, Node, OwnedNode, Pointer, SerPolicy,
+};
 use bytes::BufMut;
 use std::{
     borrow::Cow,
@@ -80,7 +82,16 @@ impl Extractor {
         &'s self,
         doc: &'n N,
     ) -> Result<&'n N, Cow<'s, serde_json::Value>> {
-        let Some(node) = self.ptr.query(doc) else {
+        self.query_resolved(self.ptr.query(doc))
+    }
+
+    // Map the document node resolved by this Extractor's pointer
+    // (or None if it doesn't exist) into its extracted value.
+    fn query_resolved<'s, 'n, N: AsNode>(
+        &'s self,
+        node: Option<&'n N>,
+    ) -> Result<&'n N, Cow<'s, serde_json::Value>> {
+        let Some(node) = node else {
             return Err(Cow::Borrowed(&self.default));
         };
 
@@ -196,7 +207,17 @@ impl Extractor {
         w: &mut W,
         indicator: &AtomicBool,
     ) -> std::io::Result<()> {
-        match self.query(doc) {
+        self.pack(self.query(doc), w, indicator)
+    }
+
+    // Pack an extracted value into the writer.
+    fn pack<N: AsNode, W: std::io::Write>(
+        &self,
+        value: Result<&N, Cow<'_, serde_json::Value>>,
+        w: &mut W,
+        indicator: &AtomicBool,
+    ) -> std::io::Result<()> {
+        match value {
             Ok(v) => self
                 .policy
                 .with_truncation_indicator(v, indicator)
@@ -231,6 +252,214 @@ impl Extractor {
     }
 }
 
+/// BatchExtractor is a compiled form of an ordered set of Extractors,
+/// for use where many locations are extracted from each of many documents.
+///
+/// The pointers of its Extractors are arranged into a trie, so that a pointer
+/// prefix which is common to many Extractors is walked only once per document.
+/// Property names of pointer tokens are also rendered just once, where
+/// Pointer::query must render array indices on each query of an object.
+#[derive(Debug, Clone)]
+pub struct BatchExtractor {
+    extractors: Vec<Extractor>,
+    // Steps of the pointer trie. The first Step is the document root.
+    steps: Vec<Step>,
+}
+
+#[derive(Debug, Clone)]
+struct Step {
+    // Property name matched by this Step when applied to an object, if any.
+    property: Option<String>,
+    // Index matched by this Step when applied to an array, if any.
+    index: Option<usize>,
+    // Indices of child Steps.
+    children: Vec<usize>,
+    // Indices of Extractors whose pointers resolve to this Step.
+    extractors: Vec<usize>,
+}
+
+impl BatchExtractor {
+    /// Compile the ordered Extractors into a BatchExtractor.
+    /// Extracted tuples have the same order and encoding as they
+    /// would when using Extractor::extract_all with `extractors`.
+    pub fn new(extractors: Vec<Extractor>) -> Self {
+        let mut steps = vec![Step {
+            property: None,
+            index: None,
+            children: Vec::new(),
+            extractors: Vec::new(),
+        }];
+
+        for (ex_index, ex) in extractors.iter().enumerate() {
+            let mut cur = 0;
+
+            for token in ex.ptr.iter() {
+                let (property, index) = match token {
+                    Token::Index(ind) => (Some(ind.to_string()), Some(*ind)),
+                    Token::Property(prop) => (Some(prop.clone()), None),
+                    // These never match an existing document location.
+                    Token::NextIndex | Token::NextProperty => (None, None),
+                };
+
+                let found = steps[cur]
+                    .children
+                    .iter()
+                    .copied()
+                    .find(|c| steps[*c].property == property && steps[*c].index == index);
+
+                cur = match found {
+                    Some(next) => next,
+                    None => {
+                        steps.push(Step {
+                            property,
+                            index,
+                            children: Vec::new(),
+                            extractors: Vec::new(),
+                        });
+                        let next = steps.len() - 1;
+                        steps[cur].children.push(next);
+                        next
+                    }
+                };
+            }
+            steps[cur].extractors.push(ex_index);
+        }
+
+        Self { extractors, steps }
+    }
+
+    /// Extractors of this BatchExtractor, in their extraction order.
+    pub fn extractors(&self) -> &[Extractor] {
+        &self.extractors
+    }
+
+    /// Extract a packed tuple representation from an instance of doc::AsNode.
+    pub fn extract_all<N: AsNode>(&self, doc: &N, out: &mut bytes::BytesMut) -> bytes::Bytes {
+        let indicator = &AtomicBool::new(false);
+        self.extract_all_indicate_truncation(doc, out, indicator)
+    }
+
+    /// Extract a packed tuple representation from an instance of doc::AsNode.
+    pub fn extract_all_indicate_truncation<N: AsNode>(
+        &self,
+        doc: &N,
+        out: &mut bytes::BytesMut,
+        indicator: &AtomicBool,
+    ) -> bytes::Bytes {
+        let mut resolved = Vec::new();
+        self.resolve(doc, &mut resolved);
+        self.pack_all(&resolved, out, indicator)
+    }
+
+    /// Extract a packed tuple representation from an instance of doc::OwnedNode.
+    pub fn extract_all_owned_indicate_truncation(
+        &self,
+        doc: &OwnedNode,
+        out: &mut bytes::BytesMut,
+        indicator: &AtomicBool,
+    ) -> bytes::Bytes {
+        match doc {
+            OwnedNode::Heap(n) => self.extract_all_indicate_truncation(n.get(), out, indicator),
+            OwnedNode::Archived(n) => self.extract_all_indicate_truncation(n.get(), out, indicator),
+        }
+    }
+
+    /// Extract packed tuple representations from each of a batch of documents,
+    /// returned in the order of `docs`.
+    pub fn extract_batch<'n, N: AsNode + 'n, I: IntoIterator<Item = &'n N>>(
+        &self,
+        docs: I,
+        out: &mut bytes::BytesMut,
+    ) -> Vec<bytes::Bytes> {
+        let mut resolved = Vec::new();
+
+        docs.into_iter()
+            .map(|doc| {
+                // Each document is separately truncated.
+                let indicator = &AtomicBool::new(false);
+                // Re-use `resolved` to avoid an allocation for each document.
+                self.resolve(doc, &mut resolved);
+                self.pack_all(&resolved, out, indicator)
+            })
+            .collect()
+    }
+
+    /// Extract from an instance of doc::AsNode, writing a packed encoding into the writer.
+    pub fn extract<N: AsNode, W: std::io::Write>(&self, doc: &N, w: &mut W) -> std::io::Result<()> {
+        let indicator = &AtomicBool::new(false);
+        let mut resolved = Vec::new();
+        self.resolve(doc, &mut resolved);
+
+        for (ex, node) in self.extractors.iter().zip(resolved.into_iter()) {
+            ex.pack(ex.query_resolved(node), w, indicator)?;
+        }
+        Ok(())
+    }
+
+    // Resolve the document node of each Extractor, or None if it doesn't exist.
+    fn resolve<'n, N: AsNode>(&self, doc: &'n N, resolved: &mut Vec<Option<&'n N>>) {
+        resolved.clear();
+        resolved.resize(self.extractors.len(), None);
+        self.walk(0, doc, resolved);
+    }
+
+    fn walk<'n, N: AsNode>(&self, step: usize, node: &'n N, resolved: &mut [Option<&'n N>]) {
+        let step = &self.steps[step];
+
+        for ex in &step.extractors {
+            resolved[*ex] = Some(node);
+        }
+        for child in &step.children {
+            let Step {
+                property, index, ..
+            } = &self.steps[*child];
+
+            let next = match node.as_node() {
+                Node::Object(fields) => property
+                    .as_ref()
+                    .and_then(|property| fields.get(property))
+                    .map(|field| field.value()),
+                Node::Array(arr) => index.and_then(|index| arr.get(index)),
+                _ => None,
+            };
+
+            // If `next` doesn't exist then neither do its children,
+            // and their Extractors remain unresolved.
+            if let Some(next) = next {
+                self.walk(*child, next, resolved);
+            }
+        }
+    }
+
+    fn pack_all<N: AsNode>(
+        &self,
+        resolved: &[Option<&N>],
+        out: &mut bytes::BytesMut,
+        indicator: &AtomicBool,
+    ) -> bytes::Bytes {
+        let mut w = out.writer();
+
+        // As with Extractor::extract_all_indicate_truncation,
+        // remember the offset of a projected truncation indicator
+        // so that it may be updated if any value was truncated.
+        let mut projected_indicator_pos: Option<usize> = None;
+        for (ex, node) in self.extractors.iter().zip(resolved.iter()) {
+            if ex.magic == Some(Magic::TruncationIndicator) {
+                projected_indicator_pos = Some(w.get_ref().len());
+            }
+            // Unwrap because Write is infallible for BytesMut.
+            ex.pack(ex.query_resolved(*node), &mut w, indicator)
+                .unwrap();
+        }
+
+        let write_indicator = projected_indicator_pos.filter(|_| indicator.load(Ordering::SeqCst));
+        if let Some(pos) = write_indicator {
+            out[pos] = 0x27; // this is the Foundation tuple byte value of `true`
+        }
+        out.split().freeze()
+    }
+}
+
 #[cfg(test)]
 mod test {
     use super::*;
@@ -379,6 +608,68 @@ mod test {
         assert_eq!(tuple::Element::Bool(true), unpacked[0]);
     }
 
+    #[test]
+    fn test_batch_extractor_matches_extractors() {
+        let docs = vec![
+            json!({
+                "a": "value",
+                "obj": {"tru": true, "other": "value", "1": "one", "nested": {"deep": [1, 2]}},
+                "arr": ["foo", {"bar": "baz"}],
+                "uuid-ts": "85bad119-15f2-11ee-8401-43f05f562888",
+                "long-str": "very very very very very very very very long",
+            }),
+            json!({"obj": ["not", "an", "object"], "arr": {"0": "indexed property"}}),
+            json!(["a root array"]),
+            json!({}),
+        ];
+        let policy = SerPolicy::truncate_strings(16);
+
+        let extractors = vec![
+            Extractor::new("/missing", &policy),
+            Extractor::with_default("/missing/default", &policy, json!("default")),
+            Extractor::new("/obj/tru", &policy),
+            Extractor::new("/obj/1", &policy),
+            Extractor::new("/obj/nested/deep/1", &policy),
+            Extractor::new("/obj/nested", &policy),
+            Extractor::new("/obj", &policy),
+            Extractor::new("/arr/0", &policy),
+            Extractor::new("/arr/1/bar", &policy),
+            Extractor::new("/arr/-", &policy),
+            Extractor::new("/0", &policy),
+            Extractor::for_uuid_v1_date_time("/uuid-ts"),
+            Extractor::for_truncation_indicator(),
+            Extractor::new("/long-str", &policy),
+            Extractor::new("", &policy),
+            Extractor::new("/obj/tru", &policy), // Repeated pointer.
+        ];
+        let batch = BatchExtractor::new(extractors.clone());
+
+        let mut buffer = bytes::BytesMut::new();
+        let batched = batch.extract_batch(docs.iter(), &mut buffer);
+
+        for (doc, batched) in docs.iter().zip(batched.into_iter()) {
+            let expect = Extractor::extract_all(doc, &extractors, &mut buffer);
+            assert_eq!(expect, batched);
+            assert_eq!(expect, batch.extract_all(doc, &mut buffer));
+
+            let mut w = Vec::new();
+            batch.extract(doc, &mut w).unwrap();
+
+            let mut w_expect = Vec::new();
+            for ex in &extractors {
+                ex.extract(doc, &mut w_expect).unwrap();
+            }
+            assert_eq!(w_expect, w);
+        }
+
+        // Truncation of a long string is reflected in the projected indicator.
+        let indicator = AtomicBool::new(false);
+        let packed = batch.extract_all_indicate_truncation(&docs[0], &mut buffer, &indicator);
+        assert!(indicator.load(std::sync::atomic::Ordering::SeqCst));
+        let unpacked: Vec<tuple::Element> = tuple::unpack(&packed).unwrap();
+        assert_eq!(tuple::Element::Bool(true), unpacked[12]);
+    }
+
     #[test]
     fn test_compare_objects() {
         let d1 = &json!({"a": 1, "b": 2, "c": 3});
@@ -1,6 +1,7 @@
 use crate::dataplane::{self};
 use crate::{collection::CollectionJournalSelector, output::OutputType};
 use anyhow::Context;
+use futures::AsyncReadExt;
 use journal_client::{
     broker,
     fragments::FragmentIter,
@@ -8,7 +9,10 @@ use journal_client::{
     read::uncommitted::{ExponentialBackoff, JournalRead, ReadStart, ReadUntil, Reader},
     Client,
 };
+use std::collections::BTreeMap;
+use std::path::{Path, PathBuf};
 use time::OffsetDateTime;
+use tokio::io::AsyncWriteExt;
 use tokio_util::compat::FuturesAsyncReadCompatExt;
 
 #[derive(clap::Args, Debug, Clone)]
@@ -30,10 +34,54 @@ pub struct ReadArgs {
     /// the default.
     #[clap(long)]
     pub uncommitted: bool,
+    /// Path of a cursor file, into which the journal offsets of the read are
+    /// periodically persisted as documents are output. A read which fails or is
+    /// interrupted may later be continued from its cursor file using `--resume`.
+    #[clap(long)]
+    pub cursor: Option<PathBuf>,
+    /// Resume a previous read from the journal offsets of its `--cursor` file,
+    /// rather than starting from the beginning. Documents which were output after
+    /// the cursor was last persisted may be output again. The checksums of
+    /// fragment files which are re-read are verified.
+    #[clap(long, requires = "cursor", conflicts_with = "since")]
+    pub resume: bool,
     #[clap(skip)]
     pub auth_prefixes: Vec<String>,
 }
 
+/// Cursor of a collection read, which is persisted to its `--cursor` file.
+#[derive(serde::Serialize, serde::Deserialize, Debug, Default)]
+#[serde(rename_all = "camelCase")]
+pub struct Cursor {
+    /// Name of the collection being read.
+    pub collection: String,
+    /// Offsets of each journal through which documents have been output.
+    pub journals: BTreeMap<String, i64>,
+}
+
+impl Cursor {
+    fn load(path: &Path) -> anyhow::Result<Cursor> {
+        let content = std::fs::read(path)
+            .with_context(|| format!("reading cursor file {}", path.display()))?;
+
+        serde_json::from_slice(&content)
+            .with_context(|| format!("parsing cursor file {}", path.display()))
+    }
+
+    // Persist the cursor by writing a temporary file which is then renamed
+    // over `path`, so that an interrupted write cannot leave a partial cursor.
+    fn persist(&self, path: &Path) -> anyhow::Result<()> {
+        let tmp_path = path.with_extension("tmp");
+
+        std::fs::write(&tmp_path, serde_json::to_vec(self)?)
+            .with_context(|| format!("writing cursor file {}", tmp_path.display()))?;
+        std::fs::rename(&tmp_path, path)
+            .with_context(|| format!("renaming cursor file to {}", path.display()))?;
+
+        Ok(())
+    }
+}
+
 /// Common definition for arguments specifying the begin and and bounds of a read command.
 #[derive(clap::Args, Debug, Default, Clone)]
 pub struct ReadBounds {
@@ -50,7 +98,8 @@ pub struct ReadBounds {
 pub async fn journal_reader(
     ctx: &mut crate::CliContext,
     args: &ReadArgs,
-) -> anyhow::Result<Reader<ExponentialBackoff>> {
+    resume: Option<&Cursor>,
+) -> anyhow::Result<(String, Reader<ExponentialBackoff>)> {
     let auth_prefixes = if args.auth_prefixes.is_empty() {
         vec![args.selector.collection.clone()]
     } else {
@@ -79,7 +128,16 @@ pub async fn journal_reader(
         )
     })?;
 
-    let start = if let Some(since) = args.bounds.since {
+    let start = if let Some(cursor) = resume {
+        let offset = cursor.journals.get(&journal.name).with_context(|| {
+            format!(
+                "cursor file has no offset of journal '{}' (was it written by a read of a different collection?)",
+                journal.name
+            )
+        })?;
+        tracing::debug!(%offset, journal = %journal.name, "resuming read from cursor");
+        ReadStart::Offset(*offset as u64)
+    } else if let Some(since) = args.bounds.since {
         let start_time = OffsetDateTime::now_utc() - *since;
         tracing::debug!(%since, begin_mod_time = %start_time, "resolved --since to begin_mod_time");
         find_start_offset(data_plane_client.clone(), journal.name.clone(), start_time).await?
@@ -93,7 +151,8 @@ pub async fn journal_reader(
     };
     let read = JournalRead::new(journal.name.clone())
         .starting_at(start)
-        .read_until(end);
+        .read_until(end)
+        .verify_fragment_checksums(resume.is_some());
 
     tracing::debug!(journal = %journal.name, "starting read of journal");
 
@@ -101,7 +160,7 @@ pub async fn journal_reader(
     let backoff = ExponentialBackoff::new(5);
     let reader = Reader::start_read(data_plane_client.clone(), read, backoff);
 
-    Ok(reader)
+    Ok((journal.name, reader))
 }
 
 /// Reads collection data and prints it to stdout. This function has a number of limitations at present:
@@ -129,12 +188,112 @@ pub async fn read_collection(ctx: &mut crate::CliContext, args: &ReadArgs) -> an
         );
     }
 
-    let reader = journal_reader(ctx, args).await?;
+    let resume = match &args.cursor {
+        Some(path) if args.resume => {
+            let cursor = Cursor::load(path)?;
 
-    tokio::io::copy(&mut reader.compat(), &mut tokio::io::stdout()).await?;
-    Ok(())
+            if cursor.collection != args.selector.collection {
+                anyhow::bail!(
+                    "cursor file {} is of a read of collection '{}', not '{}'",
+                    path.display(),
+                    cursor.collection,
+                    args.selector.collection,
+                );
+            }
+            Some(cursor)
+        }
+        _ => None,
+    };
+
+    let (journal, reader) = journal_reader(ctx, args, resume.as_ref()).await?;
+
+    let Some(path) = &args.cursor else {
+        tokio::io::copy(&mut reader.compat(), &mut tokio::io::stdout()).await?;
+        return Ok(());
+    };
+    let cursor = resume.unwrap_or_else(|| Cursor {
+        collection: args.selector.collection.clone(),
+        journals: BTreeMap::new(),
+    });
+
+    copy_with_cursor(
+        reader,
+        Reader::current_offset,
+        tokio::io::stdout(),
+        journal,
+        cursor,
+        path,
+    )
+    .await
 }
 
+/// Copies the content of `reader` to `stdout`, while periodically persisting the
+/// offset of `journal` through which complete documents have been output to
+/// the `cursor` file at `path`. The cursor is also persisted if the read fails.
+async fn copy_with_cursor<R, W>(
+    mut reader: R,
+    current_offset: impl Fn(&R) -> i64,
+    mut stdout: W,
+    journal: String,
+    mut cursor: Cursor,
+    path: &Path,
+) -> anyhow::Result<()>
+where
+    R: futures::AsyncRead + Unpin,
+    W: tokio::io::AsyncWrite + Unpin,
+{
+    let mut buf = vec![0; 1 << 16];
+    // Content which follows the last output newline, and is a partial document.
+    let mut partial = Vec::new();
+    let mut persisted_at = std::time::Instant::now();
+
+    loop {
+        let n = match reader.read(&mut buf).await {
+            Ok(0) => break,
+            Ok(n) => n,
+            Err(err) => {
+                stdout.flush().await?;
+                cursor.journals.insert(
+                    journal.clone(),
+                    current_offset(&reader) - partial.len() as i64,
+                );
+                cursor.persist(path)?;
+
+                return Err(anyhow::Error::new(err).context(format!(
+                    "reading journal {journal} (resume from cursor file {} using --resume)",
+                    path.display()
+                )));
+            }
+        };
+        partial.extend_from_slice(&buf[..n]);
+
+        // Output through the last complete document, retaining a partial trailing document.
+        let Some(newline) = partial.iter().rposition(|b| *b == b'\n') else {
+            continue;
+        };
+        stdout.write_all(&partial[..=newline]).await?;
+        partial.drain(..=newline);
+
+        if persisted_at.elapsed() >= CURSOR_PERSIST_INTERVAL {
+            stdout.flush().await?;
+            cursor.journals.insert(
+                journal.clone(),
+                current_offset(&reader) - partial.len() as i64,
+            );
+            cursor.persist(path)?;
+            persisted_at = std::time::Instant::now();
+        }
+    }
+
+    stdout.write_all(&partial).await?;
+    stdout.flush().await?;
+    cursor.journals.insert(journal, current_offset(&reader));
+    cursor.persist(path)
+}
+
+// Interval at which the cursor of a read is persisted.
+const CURSOR_PERSIST_INTERVAL: std::time::Duration = std::time::Duration::from_secs(1);
+
 async fn find_start_offset(
     client: Client,
     journal: String,
@@ -165,3 +324,87 @@ async fn find_start_offset(
         }
     }
 }
+
+#[cfg(test)]
+mod test {
+    use super::*;
+    use futures::AsyncRead;
+
+    // Reader of `content`, which fails once it's been read.
+    struct FailingReader(futures::io::Cursor<&'static [u8]>);
+
+    impl AsyncRead for FailingReader {
+        fn poll_read(
+            mut self: std::pin::Pin<&mut Self>,
+            cx: &mut std::task::Context<'_>,
+            buf: &mut [u8],
+        ) -> std::task::Poll<std::io::Result<usize>> {
+            match std::pin::Pin::new(&mut self.0).poll_read(cx, buf) {
+                std::task::Poll::Ready(Ok(0)) => std::task::Poll::Ready(Err(std::io::Error::new(
+                    std::io::ErrorKind::Other,
+                    "broker went away",
+                ))),
+                poll => poll,
+            }
+        }
+    }
+
+    #[tokio::test]
+    async fn test_cursor_excludes_partial_document() {
+        let dir = tempfile::tempdir().unwrap();
+        let path = dir.path().join("cursor.json");
+        let cursor = || Cursor {
+            collection: "acmeCo/anvils".to_string(),
+            journals: BTreeMap::new(),
+        };
+        let offset = |r: &FailingReader| r.0.position() as i64;
+
+        const CONTENT: &[u8] = b"{\"id\":1}\n{\"id\":2}\n{\"id\":";
+
+        let mut out = Vec::new();
+        let err = copy_with_cursor(
+            FailingReader(futures::io::Cursor::new(CONTENT)),
+            offset,
+            &mut out,
+            "acmeCo/anvils/pivot=00".to_string(),
+            cursor(),
+            &path,
+        )
+        .await
+        .unwrap_err();
+
+        assert!(format!("{err:#}").contains("broker went away"));
+        // Only complete documents are output, and the cursor offset
+        // is that of the trailing partial document.
+        assert_eq!(out, b"{\"id\":1}\n{\"id\":2}\n");
+
+        let persisted = Cursor::load(&path).unwrap();
+        assert_eq!(persisted.collection, "acmeCo/anvils");
+        assert_eq!(
+            persisted.journals.get("acmeCo/anvils/pivot=00"),
+            Some(&(out.len() as i64))
+        );
+
+        // A read which reaches its end outputs and includes all content.
+        let mut out = Vec::new();
+        copy_with_cursor(
+            futures::io::Cursor::new(CONTENT),
+            |r: &futures::io::Cursor<&'static [u8]>| r.position() as i64,
+            &mut out,
+            "acmeCo/anvils/pivot=00".to_string(),
+            cursor(),
+            &path,
+        )
+        .await
+        .unwrap();
+
+        assert_eq!(out, CONTENT);
+        assert_eq!(
+            Cursor::load(&path)
+                .unwrap()
+                .journals
+                .get("acmeCo/anvils/pivot=00"),
+            Some(&(CONTENT.len() as i64))
+        );
+    }
+}
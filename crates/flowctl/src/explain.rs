@@ -0,0 +1,564 @@
+use crate::output::OutputType;
+use std::io::Write;
+
+/// Failed is the error of catalog sources which failed to build or validate.
+/// It retains the errors, so that they may be explained with `--explain`.
+pub struct Failed(pub tables::Errors);
+
+impl std::fmt::Display for Failed {
+    fn fmt(&self, f: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+        f.write_str("failed due to encountered errors")
+    }
+}
+
+impl std::fmt::Debug for Failed {
+    fn fmt(&self, f: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+        write!(f, "failed due to {} encountered errors", self.0.len())
+    }
+}
+
+impl std::error::Error for Failed {}
+
+/// Explanation of a single build or validation error.
+#[derive(Debug, Default, serde::Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct Explanation {
+    /// Kind of the error, such as "KeyWrongType".
+    pub kind: String,
+    /// Error message.
+    pub error: String,
+    /// Scope of the error, as a resource URL and JSON pointer fragment.
+    pub scope: String,
+    /// Local source file of the error, if the scope is a file.
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub file: Option<String>,
+    /// One-based line of the error within its source file, if known.
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub line: Option<usize>,
+    /// Content of the source line.
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub source: Option<String>,
+    /// Schema keyword or constraint which was violated.
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub constraint: Option<String>,
+    /// Raw message of the connector, for connector errors and constraints.
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub connector_message: Option<String>,
+    /// Suggested fixes.
+    #[serde(skip_serializing_if = "Vec::is_empty")]
+    pub suggestions: Vec<String>,
+}
+
+/// Explain each of the `errors`.
+pub fn explain(errors: &tables::Errors) -> Vec<Explanation> {
+    errors
+        .iter()
+        .map(|tables::Error { scope, error }| explain_one(scope, error))
+        .collect()
+}
+
+fn explain_one(scope: &url::Url, error: &anyhow::Error) -> Explanation {
+    let mut out = Explanation {
+        kind: "Error".to_string(),
+        error: format!("{error:#}"),
+        scope: scope.to_string(),
+        ..Default::default()
+    };
+
+    if let Some(err) = error.downcast_ref::<validation::Error>() {
+        out.kind = kind_of(err);
+        explain_validation(err, &mut out);
+    } else if let Some(err) = error.downcast_ref::<sources::LoadError>() {
+        out.kind = kind_of(err);
+        explain_load(err, &mut out);
+    }
+
+    // Locate the scope within its source file, unless the line is already known.
+    let mut resource = scope.clone();
+    resource.set_fragment(None);
+
+    if let Ok(path) = resource.to_file_path() {
+        let content = std::fs::read_to_string(&path).ok();
+
+        if out.line.is_none() {
+            let ptr = percent_encoding::percent_decode_str(scope.fragment().unwrap_or_default())
+                .decode_utf8_lossy()
+                .to_string();
+            out.line = content.as_deref().and_then(|c| locate(c, &ptr));
+        }
+        if let (Some(content), Some(line)) = (&content, out.line) {
+            out.source = line
+                .checked_sub(1)
+                .and_then(|index| content.lines().nth(index))
+                .map(str::to_string);
+        }
+        out.file = Some(path.to_string_lossy().to_string());
+    }
+
+    out
+}
+
+// Name of the variant of an error enum, taken from its Debug representation.
+fn kind_of(err: &impl std::fmt::Debug) -> String {
+    let debug = format!("{err:?}");
+    debug
+        .split(|c: char| !c.is_alphanumeric() && c != '_')
+        .next()
+        .unwrap_or_default()
+        .to_string()
+}
+
+fn explain_validation(err: &validation::Error, out: &mut Explanation) {
+    use validation::Error as E;
+
+    let (constraint, suggestions): (&str, Vec<String>) = match err {
+        E::NameRegex { unmatched, .. } => (
+            "catalog name pattern",
+            vec![format!(
+                "remove or replace {unmatched:?}: names are made of letters, digits, and `-_.`, separated by `/`"
+            )],
+        ),
+        E::NameCollision { rhs_scope, .. } => (
+            "unique catalog names",
+            vec![format!("rename one of the entities, or remove the duplicate at {rhs_scope}")],
+        ),
+        E::NoSuchEntity {
+            ref_entity,
+            ref_name,
+            ..
+        } => (
+            "referential integrity",
+            vec![
+                format!("check the spelling of {ref_entity} {ref_name}"),
+                format!("define {ref_entity} {ref_name}, or import the file which defines it"),
+            ],
+        ),
+        E::NoSuchEntitySuggest {
+            suggest_name,
+            suggest_scope,
+            ..
+        } => (
+            "referential integrity",
+            vec![format!(
+                "did you mean {suggest_name}? It's defined at {suggest_scope}"
+            )],
+        ),
+        E::MissingImport { ref_scope, .. } => (
+            "imports",
+            vec![format!("add {ref_scope} to the `import` section of this file")],
+        ),
+        E::NoStorageMappingSuggest { suggest_name, .. } => (
+            "storage mappings",
+            vec![format!(
+                "use a name having the prefix {suggest_name}, or add a storage mapping which covers this name"
+            )],
+        ),
+        E::InvalidSchemaCombination { .. } => (
+            "collection schema",
+            vec!["use either `schema`, or both of `writeSchema` and `readSchema`".to_string()],
+        ),
+        E::NoSuchSchema { .. } => (
+            "$ref",
+            vec!["check the fragment of the schema URL, which must point to an existing location of its document".to_string()],
+        ),
+        E::CollectionKeyEmpty { .. } => (
+            "collection key",
+            vec!["add a `key` of one or more JSON pointers which uniquely identify a document".to_string()],
+        ),
+        E::CollectionSchemaNotObject { .. } => (
+            "type",
+            vec!["add `type: object` to the root of the collection schema".to_string()],
+        ),
+        E::PtrMissingLeadingSlash { ptr } => (
+            "JSON pointer",
+            vec![format!("use /{ptr}")],
+        ),
+        E::PtrRegexUnmatched { ptr, .. } => (
+            "JSON pointer",
+            vec![format!("check {ptr} for typos, or escape `/` as `~1` and `~` as `~0`")],
+        ),
+        E::PtrCannotExist { ptr, .. } => (
+            "additionalProperties",
+            vec![format!(
+                "declare {ptr} within `properties` of the schema, or relax `additionalProperties: false`"
+            )],
+        ),
+        E::PtrIsImplicit { ptr, .. } => (
+            "properties",
+            vec![
+                format!("check {ptr} for typos"),
+                format!("declare {ptr} within `properties` of the schema"),
+            ],
+        ),
+        E::KeyWrongType { ptr, .. } => (
+            "type",
+            vec![
+                format!("restrict the `type` of {ptr} to one of integer, string, or boolean (and optionally null)"),
+                "or, choose a different location as the key".to_string(),
+            ],
+        ),
+        E::KeyHasReduction { ptr, .. } => (
+            "reduce",
+            vec![format!("remove the `reduce` annotation of {ptr}")],
+        ),
+        E::NoSuchProjection {
+            field, collection, ..
+        } => (
+            "projections",
+            vec![format!(
+                "add a projection {field} to collection {collection}, or check the field for typos"
+            )],
+        ),
+        E::ProjectionNotPartitioned {
+            field, collection, ..
+        } => (
+            "partitions",
+            vec![format!(
+                "declare projection {field} of collection {collection} with `partition: true`"
+            )],
+        ),
+        E::ProjectionRemapsCanonicalField { field, .. } => (
+            "projections",
+            vec![format!("use a projection name other than {field}")],
+        ),
+        E::SelectorTypeMismatch { .. } | E::SelectorEmptyString { .. } => (
+            "partition selector",
+            vec!["use selector values which match the type of the partitioned projection".to_string()],
+        ),
+        E::ShuffleKeyCannotInfer {} => (
+            "shuffleKeyTypes",
+            vec!["add an explicit `shuffleKeyTypes` to the derivation".to_string()],
+        ),
+        E::ShuffleUnset { .. } => (
+            "shuffle",
+            vec!["add a `shuffle` to the transform, such as `shuffle: any`".to_string()],
+        ),
+        E::DeadLetterMissing { .. } => (
+            "onError",
+            vec!["add a `deadLetter` collection to the transform, or use another `onError` policy".to_string()],
+        ),
+        E::FieldUnsatisfiable { name, field, reason } => {
+            out.connector_message = Some(reason.clone());
+            (
+                "field selection",
+                vec![
+                    format!("exclude field {field} from the `fields` of materialization {name}"),
+                    "or, change the collection schema or destination so that the connector can satisfy the field".to_string(),
+                ],
+            )
+        }
+        E::LocationUnsatisfiable { location, .. } => (
+            "field selection",
+            vec![format!("include a projection of {location} in the materialization `fields`")],
+        ),
+        E::BindingDuplicatesResource { rhs_scope, .. } => (
+            "unique resources",
+            vec![format!("remove or change the resource of one binding; the other is at {rhs_scope}")],
+        ),
+        E::IngestDocInvalid(failed) => {
+            let keywords = failed_keywords(&failed.basic_output);
+            out.constraint = (!keywords.is_empty()).then(|| keywords.join("; "));
+
+            out.suggestions = vec![
+                "fix the test document, or update the collection schema to allow it".to_string(),
+            ];
+            return;
+        }
+        E::SchemaBuild(err) => {
+            out.constraint = schema_keyword(err).map(|k| format!("keyword `{k}`"));
+            out.suggestions =
+                vec!["correct the schema keyword, or remove it if it's unsupported".to_string()];
+            return;
+        }
+        E::Connector { detail } => {
+            out.connector_message = Some(detail.root_cause().to_string());
+            (
+                "connector validation",
+                vec![
+                    "check the endpoint configuration and the resource configuration of each binding".to_string(),
+                    "run `flowctl raw spec` to view the connector's configuration schemas".to_string(),
+                ],
+            )
+        }
+        E::WrongConnectorBindings { .. } => (
+            "connector validation",
+            vec!["this is a bug of the connector; please report it to its maintainer".to_string()],
+        ),
+        E::ControlPlane { .. } => (
+            "control plane",
+            vec!["check your authentication with `flowctl auth login`, and retry".to_string()],
+        ),
+        _ => return,
+    };
+
+    out.constraint = Some(constraint.to_string());
+    out.suggestions = suggestions;
+}
+
+fn explain_load(err: &sources::LoadError, out: &mut Explanation) {
+    use sources::LoadError as E;
+
+    match err {
+        E::Fetch { uri, detail, .. } => {
+            if let Some(inner) = detail.downcast_ref::<sources::LoadError>() {
+                explain_load(inner, out);
+            }
+            out.suggestions
+                .push(format!("check that {uri} exists and is readable"));
+        }
+        E::YAMLErr(err) => {
+            out.line = err.location().map(|l| l.line());
+            out.constraint = Some("YAML syntax".to_string());
+            out.suggestions = vec![
+                "check the indentation and quoting of the line, and of the lines before it"
+                    .to_string(),
+            ];
+        }
+        E::JsonErr(err) | E::DocumentFixturesParseErr(err) => {
+            out.line = Some(err.line()).filter(|l| *l != 0);
+            out.constraint = Some("JSON syntax".to_string());
+        }
+        E::SchemaBuild(err) => {
+            out.constraint = schema_keyword(err).map(|k| format!("keyword `{k}`"));
+            out.suggestions =
+                vec!["correct the schema keyword, or remove it if it's unsupported".to_string()];
+        }
+        E::ResourceWithFragment => {
+            out.suggestions = vec!["remove the `#` fragment of the import".to_string()];
+        }
+        _ => {}
+    }
+}
+
+// Find the innermost keyword of a schema build error.
+fn schema_keyword(err: &json::schema::BuildError) -> Option<String> {
+    use json::schema::BuildError as E;
+
+    match err {
+        E::AtKeyword {
+            keyword, detail, ..
+        } => schema_keyword(detail).or_else(|| Some(keyword.clone())),
+        E::AtSchema { detail, .. } => schema_keyword(detail),
+        E::UnknownKeyword(keyword) => Some(keyword.clone()),
+        _ => None,
+    }
+}
+
+// Map the errors of a basic validation output into "keyword at location: error".
+fn failed_keywords(basic_output: &serde_json::Value) -> Vec<String> {
+    let Some(errors) = basic_output.get("errors").and_then(|e| e.as_array()) else {
+        return Vec::new();
+    };
+    errors
+        .iter()
+        .map(|e| {
+            let field = |f: &str| e.get(f).and_then(|v| v.as_str()).unwrap_or_default();
+            format!(
+                "schema {} at document {:?}: {}",
+                field("keywordLocation"),
+                field("instanceLocation"),
+                field("error"),
+            )
+        })
+        .collect()
+}
+
+/// Locate the one-based line of JSON pointer `ptr` within YAML or JSON `content`.
+/// If `ptr` doesn't exist, the line of its deepest existing parent is returned.
+fn locate(content: &str, ptr: &str) -> Option<usize> {
+    let target = doc::Pointer::from_str(ptr)
+        .iter()
+        .map(|token| token.to_string())
+        .collect::<Vec<_>>();
+
+    let mut locator = Locator {
+        target,
+        path: Vec::new(),
+        stack: Vec::new(),
+        best: None,
+    };
+    // JSON is also YAML. Parse errors beyond the located line are ignored.
+    _ = yaml_rust::parser::Parser::new(content.chars()).load(&mut locator, false);
+
+    locator.best.map(|(_depth, line)| line)
+}
+
+struct Locator {
+    // Tokens of the pointer to locate.
+    target: Vec<String>,
+    // Tokens of the current location.
+    path: Vec<String>,
+    // Stack of current mappings and sequences.
+    stack: Vec<Frame>,
+    // Depth and line of the deepest visited parent of `target`.
+    best: Option<(usize, usize)>,
+}
+
+enum Frame {
+    // A mapping, which expects a key if `key` is true or a value otherwise.
+    Mapping { key: bool },
+    // A sequence, having the index of its next item.
+    Sequence { next: usize },
+}
+
+impl Locator {
+    // Mark the current path as visited at `line`.
+    fn visit(&mut self, line: usize) {
+        let depth = self.path.len();
+
+        if self.target.starts_with(&self.path) && self.best.map_or(true, |(d, _)| depth > d) {
+            self.best = Some((depth, line));
+        }
+    }
+
+    // Complete a value of the top-most frame.
+    fn complete(&mut self) {
+        match self.stack.last_mut() {
+            Some(Frame::Mapping { key }) => {
+                self.path.pop();
+                *key = true;
+            }
+            Some(Frame::Sequence { next }) => {
+                self.path.pop();
+                *next += 1;
+            }
+            None => {}
+        }
+    }
+}
+
+impl yaml_rust::parser::MarkedEventReceiver for Locator {
+    fn on_event(&mut self, event: yaml_rust::parser::Event, mark: yaml_rust::scanner::Marker) {
+        use yaml_rust::parser::Event;
+
+        let is_scalar = match &event {
+            Event::Scalar(..) | Event::Alias(..) => true,
+            Event::MappingStart(..) | Event::SequenceStart(..) => false,
+            Event::MappingEnd | Event::SequenceEnd => {
+                self.stack.pop();
+                self.complete();
+                return;
+            }
+            _ => return,
+        };
+
+        match self.stack.last_mut() {
+            // A key of a mapping, which locates its value.
+            Some(Frame::Mapping { key }) if *key => {
+                *key = false;
+                match &event {
+                    Event::Scalar(token, ..) => {
+                        self.path.push(token.clone());
+                        self.visit(mark.line());
+                        return;
+                    }
+                    // Aliased and complex keys are not addressable,
+                    // but are tracked so that the stack remains balanced.
+                    Event::Alias(..) => {
+                        self.path.push(String::new());
+                        return;
+                    }
+                    _ => self.path.push(String::new()),
+                }
+            }
+            Some(Frame::Mapping { .. }) => {}
+            Some(Frame::Sequence { next }) => {
+                let token = next.to_string();
+                self.path.push(token);
+                self.visit(mark.line());
+            }
+            None => self.visit(mark.line()),
+        }
+
+        if is_scalar {
+            self.complete();
+        } else if matches!(event, Event::MappingStart(..)) {
+            self.stack.push(Frame::Mapping { key: true });
+        } else {
+            self.stack.push(Frame::Sequence { next: 0 });
+        }
+    }
+}
+
+/// Write `explanations`, as JSON or YAML if that `output` is requested,
+/// and otherwise in a human-readable form.
+pub fn write(explanations: &[Explanation], output: Option<OutputType>) -> anyhow::Result<()> {
+    match output {
+        Some(OutputType::Json) => {
+            let mut stdout = std::io::stdout().lock();
+            for explanation in explanations {
+                serde_json::to_writer(&mut stdout, explanation)?;
+                stdout.write_all(b"\n")?;
+            }
+        }
+        Some(OutputType::Yaml) => {
+            serde_yaml::to_writer(std::io::stdout().lock(), explanations)?;
+        }
+        Some(OutputType::Table) | None => {
+            let mut stderr = std::io::stderr().lock();
+            for explanation in explanations {
+                write_human(&mut stderr, explanation)?;
+            }
+        }
+    }
+    Ok(())
+}
+
+fn write_human(w: &mut impl Write, e: &Explanation) -> std::io::Result<()> {
+    writeln!(w, "error[{}]: {}", e.kind, e.error)?;
+
+    match (&e.file, e.line) {
+        (Some(file), Some(line)) => writeln!(w, "  --> {file}:{line}")?,
+        (Some(file), None) => writeln!(w, "  --> {file}")?,
+        _ => writeln!(w, "  --> {}", e.scope)?,
+    }
+    if let (Some(source), Some(line)) = (&e.source, e.line) {
+        let width = line.to_string().len();
+        writeln!(w, "  {:width$} |", "")?;
+        writeln!(w, "  {line} | {source}")?;
+        writeln!(w, "  {:width$} |", "")?;
+    }
+    if let Some(constraint) = &e.constraint {
+        writeln!(w, "  = violates: {constraint}")?;
+    }
+    if let Some(message) = &e.connector_message {
+        writeln!(w, "  = connector: {message}")?;
+    }
+    for suggestion in &e.suggestions {
+        writeln!(w, "  = help: {suggestion}")?;
+    }
+    writeln!(w)
+}
+
+#[cfg(test)]
+mod test {
+    use super::locate;
+
+    #[test]
+    fn test_locate_pointers() {
+        let yaml = r#"
+import:
+  - other.yaml
+collections:
+  acmeCo/widgets:
+    schema:
+      type: object
+      properties:
+        id: { type: number }
+    key: [/id]
+"#;
+        assert_eq!(locate(yaml, ""), Some(2));
+        assert_eq!(locate(yaml, "/import/0"), Some(3));
+        assert_eq!(locate(yaml, "/collections/acmeCo~1widgets"), Some(5));
+        assert_eq!(
+            locate(yaml, "/collections/acmeCo~1widgets/schema/properties/id"),
+            Some(9)
+        );
+        assert_eq!(locate(yaml, "/collections/acmeCo~1widgets/key/0"), Some(10));
+        // Missing locations use their deepest parent.
+        assert_eq!(locate(yaml, "/collections/acmeCo~1widgets/derive"), Some(5));
+
+        let json = "{\n  \"collections\": {\n    \"acmeCo/widgets\": {\n      \"key\": [\"/id\"]\n    }\n  }\n}\n";
+        assert_eq!(locate(json, "/collections/acmeCo~1widgets/key"), Some(4));
+    }
+}
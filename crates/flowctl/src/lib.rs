@@ -11,6 +11,7 @@ mod config;
 mod controlplane;
 mod dataplane;
 mod draft;
+mod explain;
 mod generate;
 mod local_specs;
 mod ops;
@@ -42,6 +43,15 @@ pub struct Cli {
     #[clap(long, default_value = "default", env = "FLOWCTL_PROFILE")]
     profile: String,
 
+    /// Explain errors of catalog builds and validations.
+    ///
+    /// Each error is reported with its source file and line, the schema
+    /// keyword or constraint which it violates, any raw message of the
+    /// connector, and suggested fixes. Explanations are JSON or YAML if
+    /// requested by --output, and are otherwise human-readable.
+    #[clap(global = true, long)]
+    explain: bool,
+
     #[clap(subcommand)]
     cmd: Command,
 
@@ -172,7 +182,7 @@ impl Cli {
             controlplane_client: None,
         };
 
-        match &self.cmd {
+        let result = match &self.cmd {
             Command::Auth(auth) => auth.run(&mut context).await,
             Command::Catalog(catalog) => catalog.run(&mut context).await,
             Command::Collections(collection) => collection.run(&mut context).await,
@@ -181,7 +191,14 @@ impl Cli {
             Command::Draft(draft) => draft.run(&mut context).await,
             Command::Logs(logs) => logs.run(&mut context).await,
             Command::Raw(advanced) => advanced.run(&mut context).await,
-        }?;
+        };
+
+        if let (true, Err(err)) = (self.explain, &result) {
+            if let Some(explain::Failed(errors)) = err.downcast_ref::<explain::Failed>() {
+                explain::write(&explain::explain(errors), self.output.output)?;
+            }
+        }
+        result?;
 
         context.config().write(&self.profile)?;
 
@@ -132,7 +132,7 @@ pub(crate) fn surface_errors<T>(result: Result<T, tables::Errors>) -> anyhow::Re
             for tables::Error { scope, error } in errors.iter() {
                 tracing::error!(%scope, ?error);
             }
-            Err(anyhow::anyhow!("failed due to encountered errors"))
+            Err(crate::explain::Failed(errors).into())
         }
         Ok(ok) => return Ok(ok),
     }
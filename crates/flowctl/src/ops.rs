@@ -64,6 +64,7 @@ pub fn read_args(
         uncommitted,
         bounds: bounds.clone(),
         auth_prefixes: vec![task_name.to_string()],
+        ..Default::default()
     }
 }
 
@@ -308,11 +308,16 @@ async fn preview_derivation<L: runtime::LogHandler>(
                 max_clock,
                 key_packed,
                 partitions_packed,
+                dead_letter,
             } = internal.published.unwrap_or_default();
 
             tracing::trace!(?max_clock, ?key_packed, ?partitions_packed, "published");
 
-            print!("{doc_json}\n");
+            if !dead_letter.is_empty() {
+                tracing::warn!(%dead_letter, %doc_json, "dead-lettered a source document");
+            } else {
+                print!("{doc_json}\n");
+            }
         } else if let Some(derive::response::Flushed {}) = response.flushed {
             let proto_flow::runtime::derive_response_ext::Flushed { stats } =
                 internal.flushed.unwrap_or_default();
@@ -23,6 +23,32 @@ pub struct Discover {
     /// Docker network to run the connector.
     #[clap(long, default_value = "bridge")]
     network: String,
+    /// Glob pattern of recommended binding names to include, such as 'public/orders_*'.
+    /// May be repeated. If set, only bindings matching a pattern are kept.
+    #[clap(long)]
+    include_bindings: Vec<String>,
+    /// Glob pattern of recommended binding names to exclude.
+    /// May be repeated. Exclusions apply after inclusions.
+    #[clap(long)]
+    exclude_bindings: Vec<String>,
+    /// How to key discovered bindings for which the connector didn't provide a key.
+    /// By default, such collections have an empty key which must be edited before
+    /// the catalog will build.
+    #[clap(long, value_enum)]
+    keyless: Option<Keyless>,
+    /// Directory of sampled documents used by `--keyless infer`. Documents of a
+    /// binding are read as JSON lines from `<recommended-name>.jsonl` within the directory.
+    #[clap(long)]
+    key_samples: Option<std::path::PathBuf>,
+}
+
+#[derive(clap::ValueEnum, Debug, Copy, Clone, PartialEq)]
+pub enum Keyless {
+    /// Key on a synthetic UUID, which is generated for each captured document.
+    Uuid,
+    /// Infer a key from locations of sampled documents which are always present
+    /// and have unique values, falling back to a synthetic UUID key.
+    Infer,
 }
 
 pub async fn do_discover(
@@ -33,8 +59,18 @@ pub async fn do_discover(
         flat,
         network,
         emit_raw,
+        include_bindings,
+        exclude_bindings,
+        keyless,
+        key_samples,
     }: &Discover,
 ) -> anyhow::Result<()> {
+    if *keyless == Some(Keyless::Infer) && key_samples.is_none() {
+        anyhow::bail!("--keyless infer requires --key-samples");
+    }
+    let include = parse_binding_patterns(include_bindings)?;
+    let exclude = parse_binding_patterns(exclude_bindings)?;
+
     let source = build::arg_source_to_url(source, false)?;
     let mut sources = local_specs::surface_errors(local_specs::load(&source).await.into_result())?;
 
@@ -98,6 +134,8 @@ pub async fn do_discover(
     .discovered
     .context("connector didn't send expected Discovered response")?;
 
+    let bindings = filter_bindings(bindings, &include, &exclude);
+
     if *emit_raw {
         for binding in bindings {
             println!("{}", serde_json::to_string(&binding)?)
@@ -120,6 +158,23 @@ pub async fn do_discover(
         let collection_name = format!("{prefix}/{}", binding.recommended_name);
         let collection = models::Collection::new(collection_name);
 
+        let mut schema =
+            models::Schema::new(models::RawValue::from_string(binding.document_schema_json)?);
+        let mut key = binding
+            .key
+            .iter()
+            .map(models::JsonPointer::new)
+            .collect::<Vec<_>>();
+
+        if key.is_empty() && keyless.is_some() {
+            (schema, key) = key_keyless_binding(
+                &binding.recommended_name,
+                schema,
+                *keyless == Some(Keyless::Infer),
+                key_samples.as_deref(),
+            )?;
+        }
+
         capture.spec.bindings.push(models::CaptureBinding {
             target: collection.clone(),
             disable: false,
@@ -130,18 +185,10 @@ pub async fn do_discover(
         collections.insert(
             collection,
             models::CollectionDef {
-                schema: Some(models::Schema::new(models::RawValue::from_string(
-                    binding.document_schema_json,
-                )?)),
+                schema: Some(schema),
                 write_schema: None,
                 read_schema: None,
-                key: models::CompositeKey::new(
-                    binding
-                        .key
-                        .iter()
-                        .map(models::JsonPointer::new)
-                        .collect::<Vec<_>>(),
-                ),
+                key: models::CompositeKey::new(key),
                 derive: None,
                 projections: Default::default(),
                 journals: Default::default(),
@@ -166,3 +213,316 @@ pub async fn do_discover(
 
     Ok(())
 }
+
+fn parse_binding_patterns(patterns: &[String]) -> anyhow::Result<Vec<glob::Pattern>> {
+    patterns
+        .iter()
+        .map(|pattern| {
+            glob::Pattern::new(pattern)
+                .with_context(|| format!("invalid binding pattern {pattern:?}"))
+        })
+        .collect()
+}
+
+/// Filter `bindings` to those having a recommended name which matches any
+/// `include` pattern (or all bindings, if `include` is empty), and which
+/// don't match any `exclude` pattern. As with `flowctl-go api discover`,
+/// wildcards don't match across '/' separators.
+fn filter_bindings(
+    bindings: Vec<capture::response::discovered::Binding>,
+    include: &[glob::Pattern],
+    exclude: &[glob::Pattern],
+) -> Vec<capture::response::discovered::Binding> {
+    let options = glob::MatchOptions {
+        require_literal_separator: true,
+        ..Default::default()
+    };
+    let matches_any = |patterns: &[glob::Pattern], name: &str| {
+        patterns.iter().any(|p| p.matches_with(name, options))
+    };
+
+    let total = bindings.len();
+    let bindings: Vec<_> = bindings
+        .into_iter()
+        .filter(|b| include.is_empty() || matches_any(include, &b.recommended_name))
+        .filter(|b| !matches_any(exclude, &b.recommended_name))
+        .collect();
+
+    if bindings.len() != total {
+        tracing::info!(
+            bindings = bindings.len(),
+            total,
+            "filtered discovered bindings"
+        );
+    }
+    bindings
+}
+
+/// Key a binding for which the connector didn't provide a key.
+/// If `infer`, a key is inferred from the binding's sampled documents.
+/// Otherwise, or if a key cannot be inferred, the `schema` is extended
+/// with a synthetic UUID key which is generated for each captured document.
+fn key_keyless_binding(
+    recommended_name: &str,
+    schema: models::Schema,
+    infer: bool,
+    key_samples: Option<&std::path::Path>,
+) -> anyhow::Result<(models::Schema, Vec<models::JsonPointer>)> {
+    if let (true, Some(dir)) = (infer, key_samples) {
+        let path = dir.join(format!("{recommended_name}.jsonl"));
+
+        let samples = match std::fs::read_to_string(&path) {
+            Ok(samples) => samples,
+            Err(err) if err.kind() == std::io::ErrorKind::NotFound => String::new(),
+            Err(err) => {
+                return Err(err).with_context(|| format!("failed to read {}", path.display()))
+            }
+        };
+        let samples = samples
+            .lines()
+            .filter(|line| !line.trim().is_empty())
+            .map(serde_json::from_str::<serde_json::Value>)
+            .collect::<Result<Vec<_>, _>>()
+            .with_context(|| format!("failed to parse sampled documents of {}", path.display()))?;
+
+        if let Some(key) = infer_key(&schema, &samples)? {
+            tracing::info!(binding = %recommended_name, ?key, samples = samples.len(), "inferred key of keyless binding");
+            return Ok((
+                schema,
+                key.into_iter().map(models::JsonPointer::new).collect(),
+            ));
+        }
+        tracing::warn!(binding = %recommended_name, samples = samples.len(), "couldn't infer a key from sampled documents, and using a synthetic UUID key instead");
+    }
+
+    Ok((
+        schema.with_synthetic_key(),
+        vec![models::JsonPointer::new(models::Schema::SYNTHETIC_KEY_PTR)],
+    ))
+}
+
+/// Infer a candidate key of `schema` from its sampled documents.
+/// Candidates are locations which are keyable in the schema, and which have
+/// a non-null string, integer, or boolean value in every sample. The key is
+/// the first candidate, or pair of candidates, having values which are unique
+/// across all samples. Shallower locations are preferred, as are locations
+/// which are required by the schema.
+fn infer_key(
+    schema: &models::Schema,
+    samples: &[serde_json::Value],
+) -> anyhow::Result<Option<Vec<String>>> {
+    // Only consider the leading candidates when searching for pairs.
+    const MAX_PAIR_CANDIDATES: usize = 16;
+
+    if samples.is_empty() {
+        return Ok(None);
+    }
+    let bundle = doc::validation::build_bundle(schema.get())
+        .context("discovered document schema is invalid")?;
+    let validator = doc::Validator::new(bundle).context("discovered document schema is invalid")?;
+    let shape = doc::Shape::infer(&validator.schemas()[0], validator.schema_index());
+
+    // Map each candidate location into its value within each sample.
+    let mut values: BTreeMap<String, Vec<String>> = BTreeMap::new();
+    for (index, sample) in samples.iter().enumerate() {
+        let mut scalars = BTreeMap::new();
+        collect_scalars(sample, &mut String::new(), &mut scalars);
+
+        if index == 0 {
+            values = scalars.into_iter().map(|(ptr, v)| (ptr, vec![v])).collect();
+            continue;
+        }
+        // Retain only locations which are present in every sample.
+        values.retain(|ptr, values| match scalars.remove(ptr) {
+            Some(v) => {
+                values.push(v);
+                true
+            }
+            None => false,
+        });
+    }
+
+    let mut candidates = values
+        .into_iter()
+        .filter_map(|(ptr, values)| {
+            let (loc_shape, exists) = shape.locate(&doc::Pointer::from_str(&ptr));
+
+            if !matches!(
+                exists,
+                doc::shape::location::Exists::Must | doc::shape::location::Exists::May
+            ) || !loc_shape.type_.is_keyable_type()
+            {
+                return None;
+            }
+            let rank = (
+                ptr.matches('/').count(),
+                exists != doc::shape::location::Exists::Must,
+            );
+            Some((rank, ptr, values))
+        })
+        .collect::<Vec<_>>();
+    candidates
+        .sort_by(|(l_rank, l_ptr, _), (r_rank, r_ptr, _)| (l_rank, l_ptr).cmp(&(r_rank, r_ptr)));
+
+    let is_unique = |columns: &[&Vec<String>]| {
+        let mut seen = std::collections::BTreeSet::new();
+        (0..samples.len())
+            .all(|row| seen.insert(columns.iter().map(|c| &c[row]).collect::<Vec<_>>()))
+    };
+
+    for (_, ptr, values) in &candidates {
+        if is_unique(&[values]) {
+            return Ok(Some(vec![ptr.clone()]));
+        }
+    }
+    let candidates = &candidates[..candidates.len().min(MAX_PAIR_CANDIDATES)];
+
+    for (i, (_, l_ptr, l_values)) in candidates.iter().enumerate() {
+        for (_, r_ptr, r_values) in &candidates[i + 1..] {
+            if is_unique(&[l_values, r_values]) {
+                return Ok(Some(vec![l_ptr.clone(), r_ptr.clone()]));
+            }
+        }
+    }
+    Ok(None)
+}
+
+/// Collect the JSON pointers and encoded values of non-null, keyable scalars
+/// of `doc` into `out`. Arrays are not walked, as their items are poor keys.
+fn collect_scalars(doc: &serde_json::Value, ptr: &mut String, out: &mut BTreeMap<String, String>) {
+    use serde_json::Value;
+
+    match doc {
+        Value::Object(fields) => {
+            for (property, value) in fields {
+                let len = ptr.len();
+                ptr.push('/');
+                ptr.push_str(&property.replace('~', "~0").replace('/', "~1"));
+                collect_scalars(value, ptr, out);
+                ptr.truncate(len);
+            }
+        }
+        Value::String(_) | Value::Bool(_) => {
+            out.insert(ptr.clone(), doc.to_string());
+        }
+        Value::Number(n) if n.is_i64() || n.is_u64() => {
+            out.insert(ptr.clone(), doc.to_string());
+        }
+        _ => (),
+    }
+}
+
+#[cfg(test)]
+mod test {
+    use super::{filter_bindings, infer_key, parse_binding_patterns};
+    use proto_flow::capture::response::discovered::Binding;
+
+    #[test]
+    fn test_filter_bindings() {
+        let bindings = [
+            "public/orders",
+            "public/orders_2023",
+            "public/users",
+            "other/orders",
+        ]
+        .into_iter()
+        .map(|name| Binding {
+            recommended_name: name.to_string(),
+            ..Default::default()
+        })
+        .collect::<Vec<_>>();
+
+        let names = |include: &[&str], exclude: &[&str]| {
+            let include: Vec<String> = include.iter().map(|s| s.to_string()).collect();
+            let exclude: Vec<String> = exclude.iter().map(|s| s.to_string()).collect();
+
+            filter_bindings(
+                bindings.clone(),
+                &parse_binding_patterns(&include).unwrap(),
+                &parse_binding_patterns(&exclude).unwrap(),
+            )
+            .into_iter()
+            .map(|b| b.recommended_name)
+            .collect::<Vec<_>>()
+        };
+
+        assert_eq!(names(&[], &[]).len(), 4);
+        assert_eq!(
+            names(&["public/orders*"], &[]),
+            ["public/orders", "public/orders_2023"]
+        );
+        assert_eq!(
+            names(&["public/*"], &["*/*_2023"]),
+            ["public/orders", "public/users"]
+        );
+        // Wildcards don't match across separators.
+        assert_eq!(names(&["*"], &[]), Vec::<String>::new());
+        assert_eq!(names(&["*/orders"], &[]), ["public/orders", "other/orders"]);
+
+        assert!(parse_binding_patterns(&["[".to_string()]).is_err());
+    }
+
+    #[test]
+    fn test_infer_key() {
+        let schema = models::Schema::new(models::RawValue::from_value(&serde_json::json!({
+            "type": "object",
+            "properties": {
+                "id": {"type": "integer"},
+                "region": {"type": "string"},
+                "seq": {"type": "integer"},
+                "score": {"type": "number"},
+                "nested": {
+                    "type": "object",
+                    "properties": {"code": {"type": "string"}},
+                },
+            },
+            "required": ["region", "seq"],
+        })));
+
+        let samples = |docs: serde_json::Value| -> Vec<serde_json::Value> {
+            serde_json::from_value(docs).unwrap()
+        };
+
+        // Required `region` and `seq` aren't unique. `id` is, and is shallower than `/nested/code`.
+        assert_eq!(
+            infer_key(
+                &schema,
+                &samples(serde_json::json!([
+                    {"id": 1, "region": "a", "seq": 1, "nested": {"code": "x"}},
+                    {"id": 2, "region": "a", "seq": 1, "nested": {"code": "y"}},
+                ]))
+            )
+            .unwrap(),
+            Some(vec!["/id".to_string()])
+        );
+
+        // `id` is sometimes null, floats aren't keyable, and `/extra` isn't in the schema.
+        // Neither `region` nor `seq` are unique, but together they are.
+        assert_eq!(
+            infer_key(
+                &schema,
+                &samples(serde_json::json!([
+                    {"id": null, "region": "a", "seq": 1, "score": 1.5, "extra": "p"},
+                    {"id": 2, "region": "a", "seq": 2, "score": 2.5, "extra": "q"},
+                    {"id": 3, "region": "b", "seq": 1, "score": 3.5, "extra": "r"},
+                ]))
+            )
+            .unwrap(),
+            Some(vec!["/region".to_string(), "/seq".to_string()])
+        );
+
+        // No candidate key is unique.
+        assert_eq!(
+            infer_key(
+                &schema,
+                &samples(serde_json::json!([
+                    {"region": "a", "seq": 1},
+                    {"region": "a", "seq": 1},
+                ]))
+            )
+            .unwrap(),
+            None
+        );
+        assert_eq!(infer_key(&schema, &[]).unwrap(), None);
+    }
+}
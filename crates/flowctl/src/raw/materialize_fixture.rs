@@ -35,7 +35,7 @@ pub async fn do_materialize_fixture(
         Message::decode(spec).context("failed to parse MaterializationSpec")?;
 
     // Unwrap the connector configuration before passing it on.
-    let models::ConnectorConfig { image: _, config } =
+    let models::ConnectorConfig { config, .. } =
         serde_json::from_str(&spec.config_json).expect("materialization spec is a connector");
     spec.config_json = config.to_string();
 
@@ -9,7 +9,9 @@ use std::{
 mod discover;
 mod materialize_fixture;
 mod oauth;
+mod rekey;
 mod spec;
+mod type_mapping;
 
 #[derive(Debug, clap::Args)]
 #[clap(rename_all = "kebab-case")]
@@ -50,8 +52,25 @@ pub enum Command {
     MaterializeFixture(materialize_fixture::MaterializeFixture),
     /// Discover a connector and write catalog files
     Discover(discover::Discover),
+    /// Change the key of a collection, by creating a successor collection.
+    ///
+    /// The successor has the new key, and is a derivation which forwards all
+    /// historical and ongoing documents of the collection. Derivations and
+    /// materializations which read from the collection are rewired to read
+    /// from its successor, and materialization bindings are backfilled.
+    /// Captures continue to write into the original collection.
+    /// A derivation is re-keyed only with --force.
+    Rekey(rekey::Rekey),
     /// Get the spec output of a connector
     Spec(spec::Spec),
+    /// Report how the fields of a materialization map to destination column types.
+    ///
+    /// The report includes the JSON types of each projected field, how it's
+    /// selected, and the constraint the connector returns for it upon validation.
+    /// Destination column types and conversions of values which may be lossy or
+    /// may truncate are sourced from the connector's response to a TypeMapping
+    /// request, and are omitted if the connector doesn't support it.
+    TypeMapping(type_mapping::TypeMapping),
     /// Test a connector's OAuth config
     Oauth(oauth::Oauth),
     /// Emit the Flow specification JSON-Schema.
@@ -144,7 +163,9 @@ impl Advanced {
                 materialize_fixture::do_materialize_fixture(ctx, fixture).await
             }
             Command::Discover(args) => discover::do_discover(ctx, args).await,
+            Command::Rekey(args) => rekey::do_rekey(ctx, args).await,
             Command::Spec(args) => spec::do_spec(ctx, args).await,
+            Command::TypeMapping(args) => type_mapping::do_type_mapping(ctx, args).await,
             Command::Oauth(args) => oauth::do_oauth(ctx, args).await,
             Command::JsonSchema => {
                 let schema = models::Catalog::root_json_schema();
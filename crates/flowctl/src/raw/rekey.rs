@@ -0,0 +1,352 @@
+use crate::local_specs;
+use std::collections::BTreeMap;
+
+#[derive(Debug, clap::Args)]
+#[clap(rename_all = "kebab-case")]
+pub struct Rekey {
+    /// Path or URL to a Flow specification file.
+    #[clap(long)]
+    source: String,
+    /// Name of the collection having a key to change.
+    #[clap(long)]
+    collection: String,
+    /// Name of the successor collection, which has the new key.
+    #[clap(long)]
+    successor: String,
+    /// JSON pointer of a component of the new key. May be repeated,
+    /// in the order of the composite key.
+    #[clap(long = "key", required = true)]
+    key: Vec<String>,
+    /// Should specs be written to one specification file, instead of the canonical layout?
+    #[clap(long)]
+    flat: bool,
+    /// Re-key a collection which is a derivation. Its successor doesn't copy
+    /// the derivation, and instead forwards the documents it derives.
+    #[clap(long)]
+    force: bool,
+}
+
+/// Name of the transform of the successor collection,
+/// which forwards documents of the re-keyed collection.
+const FORWARD_TRANSFORM: &str = "fromPredecessor";
+
+pub async fn do_rekey(
+    _ctx: &mut crate::CliContext,
+    Rekey {
+        source,
+        collection,
+        successor,
+        key,
+        flat,
+        force,
+    }: &Rekey,
+) -> anyhow::Result<()> {
+    let source = build::arg_source_to_url(source, false)?;
+    let mut sources = local_specs::surface_errors(local_specs::load(&source).await.into_result())?;
+
+    let catalog = rekey(&mut sources, collection, successor, key, *force)?;
+
+    let count = local_specs::extend_from_catalog(
+        &mut sources,
+        catalog,
+        local_specs::pick_policy(false, *flat),
+    );
+
+    local_specs::indirect_and_write_resources(sources)?;
+    println!("Wrote {count} new specifications under {source}.");
+
+    Ok(())
+}
+
+// Re-key `collection` of `sources` to `key`, rewiring its readers to `successor`.
+// Returns a Catalog of the successor, which is to be added to `sources`.
+fn rekey(
+    sources: &mut tables::Sources,
+    collection: &str,
+    successor: &str,
+    key: &[String],
+    force: bool,
+) -> anyhow::Result<models::Catalog> {
+    for ptr in key {
+        if !ptr.starts_with('/') {
+            anyhow::bail!(
+                "key component {ptr:?} is not a JSON pointer (missing leading '/' slash)"
+            );
+        }
+    }
+    if models::Collection::regex()
+        .find(successor)
+        .map(|m| m.as_str())
+        != Some(successor)
+    {
+        anyhow::bail!("successor {successor:?} is not a valid collection name");
+    } else if successor == collection {
+        anyhow::bail!("successor must have a different name than {collection}");
+    }
+
+    if sources
+        .collections
+        .binary_search_by_key(&successor, |c| c.collection.as_str())
+        .is_ok()
+    {
+        anyhow::bail!("successor collection {successor} already exists");
+    }
+    let index = match sources
+        .collections
+        .binary_search_by_key(&collection, |c| c.collection.as_str())
+    {
+        Ok(index) => index,
+        Err(_) => anyhow::bail!("could not find the collection {collection}"),
+    };
+    if sources.collections[index].spec.derive.is_some() && !force {
+        anyhow::bail!(
+            "collection {collection} is a derivation, which its successor would not copy: \
+            the successor instead forwards the documents derived by {collection}. \
+            Use --force to re-key it anyway"
+        );
+    }
+    let predecessor = models::Collection::new(collection);
+    let successor = models::Collection::new(successor);
+
+    // The successor is an inline clone of the predecessor, having the new key.
+    let row = &sources.collections[index];
+    let mut spec = row.spec.clone();
+    ::sources::inline_collection(
+        &row.scope,
+        &mut spec,
+        &mut sources.imports,
+        &sources.resources,
+    );
+
+    let old_key = spec.key.iter().map(|p| p.to_string()).collect::<Vec<_>>();
+    spec.key =
+        models::CompositeKey::new(key.iter().map(models::JsonPointer::new).collect::<Vec<_>>());
+    // The successor derives from its predecessor, forwarding all of its
+    // historical and ongoing documents. Documents are re-keyed as they're
+    // combined into the successor.
+    spec.derive = Some(models::Derivation {
+        using: models::DeriveUsing::Sqlite(models::DeriveUsingSqlite {
+            migrations: Vec::new(),
+        }),
+        transforms: vec![models::TransformDef {
+            name: models::Transform::new(FORWARD_TRANSFORM),
+            source: models::Source::Collection(predecessor.clone()),
+            shuffle: models::Shuffle::Any,
+            priority: 0,
+            read_delay: None,
+            lambda: models::RawValue::from_value(&serde_json::json!(
+                "SELECT JSON($flow_document);"
+            )),
+            disable: false,
+            backfill: 0,
+            on_error: models::OnError::Halt,
+            error_budget: None,
+            dead_letter: None,
+        }],
+        shuffle_key_types: Vec::new(),
+        shards: Default::default(),
+    });
+
+    println!("Re-keying {predecessor} from {old_key:?} to {key:?}:");
+    println!("  * Created {successor}, which derives from {predecessor}.");
+
+    // Rewire derivations which read from the predecessor.
+    for row in sources.collections.iter_mut() {
+        let Some(derive) = &mut row.spec.derive else {
+            continue;
+        };
+        for transform in derive.transforms.iter_mut() {
+            if transform.source.collection() != &predecessor {
+                continue;
+            }
+            transform.source.set_collection(successor.clone());
+
+            println!(
+                "  * Derivation {} transform {} now reads from {successor}.",
+                row.collection, transform.name,
+            );
+            println!("    Historical documents will be re-processed by the transform.");
+        }
+    }
+    // Rewire materializations which read from the predecessor.
+    // Bindings are backfilled, because their endpoint resources
+    // must be rebuilt to reflect the new key.
+    for row in sources.materializations.iter_mut() {
+        for binding in row.spec.bindings.iter_mut() {
+            if binding.source.collection() != &predecessor {
+                continue;
+            }
+            binding.source.set_collection(successor.clone());
+            binding.backfill += 1;
+
+            println!(
+                "  * Materialization {} now reads from {successor}, and will backfill its resource.",
+                row.materialization,
+            );
+        }
+    }
+    for row in sources.captures.iter() {
+        if row
+            .spec
+            .bindings
+            .iter()
+            .any(|binding| binding.target == predecessor)
+        {
+            println!(
+                "  * Capture {} continues to write into {predecessor}, which is forwarded to {successor}.",
+                row.capture,
+            );
+        }
+    }
+
+    Ok(models::Catalog {
+        collections: BTreeMap::from([(successor, spec)]),
+        ..Default::default()
+    })
+}
+
+#[cfg(test)]
+mod test {
+    use super::{rekey, FORWARD_TRANSFORM};
+    use serde_json::json;
+
+    const FIXTURE: &str = r#"
+collections:
+  acmeCo/raw:
+    schema:
+      type: object
+      properties:
+        id: { type: integer }
+        region: { type: string }
+      required: [id, region]
+    key: [/id]
+  acmeCo/anvils:
+    schema:
+      type: object
+      properties:
+        id: { type: integer }
+        region: { type: string }
+      required: [id, region]
+    key: [/id]
+    derive:
+      using:
+        sqlite: {}
+      transforms:
+        - name: fromRaw
+          source: acmeCo/raw
+          shuffle: any
+          lambda: SELECT JSON($flow_document);
+  acmeCo/regions:
+    schema:
+      type: object
+      properties:
+        region: { type: string }
+      required: [region]
+    key: [/region]
+    derive:
+      using:
+        sqlite: {}
+      transforms:
+        - name: fromAnvils
+          source: acmeCo/anvils
+          shuffle: any
+          lambda: SELECT $region;
+materializations:
+  acmeCo/warehouse:
+    endpoint:
+      connector:
+        image: ghcr.io/estuary/materialize-sqlite:dev
+        config: {}
+    bindings:
+      - source: acmeCo/anvils
+        resource: { table: anvils }
+"#;
+
+    #[tokio::test]
+    async fn test_rekey_output() {
+        let dir = tempfile::tempdir().unwrap();
+        let path = dir.path().join("flow.yaml");
+        std::fs::write(&path, FIXTURE).unwrap();
+
+        let source = url::Url::from_file_path(&path).unwrap();
+        let load = || async {
+            crate::local_specs::surface_errors(
+                crate::local_specs::load(&source).await.into_result(),
+            )
+            .unwrap()
+        };
+        let key = vec!["/region".to_string(), "/id".to_string()];
+
+        // acmeCo/anvils is a derivation, which isn't re-keyed without --force.
+        let mut sources = load().await;
+        let err = rekey(
+            &mut sources,
+            "acmeCo/anvils",
+            "acmeCo/anvils-by-region",
+            &key,
+            false,
+        )
+        .unwrap_err();
+        assert!(err.to_string().contains("is a derivation"), "{err}");
+
+        let err = rekey(&mut sources, "acmeCo/anvils", "acmeCo/regions", &key, true).unwrap_err();
+        assert!(err.to_string().contains("already exists"), "{err}");
+
+        let catalog = rekey(
+            &mut sources,
+            "acmeCo/anvils",
+            "acmeCo/anvils-by-region",
+            &key,
+            true,
+        )
+        .unwrap();
+
+        // The successor has the new key, and forwards documents of its predecessor.
+        let successor = serde_json::to_value(&catalog.collections).unwrap();
+        let successor = &successor["acmeCo/anvils-by-region"];
+
+        assert_eq!(successor["key"], json!(["/region", "/id"]));
+        assert_eq!(
+            successor["schema"]["properties"],
+            json!({"id": {"type": "integer"}, "region": {"type": "string"}}),
+        );
+        assert_eq!(
+            successor["derive"]["transforms"],
+            json!([{
+                "name": FORWARD_TRANSFORM,
+                "source": "acmeCo/anvils",
+                "shuffle": "any",
+                "lambda": "SELECT JSON($flow_document);",
+            }]),
+        );
+
+        // Readers of the predecessor are rewired to the successor,
+        // and materialization bindings are backfilled.
+        let regions = &sources.collections[2];
+        assert_eq!(regions.collection.as_str(), "acmeCo/regions");
+        assert_eq!(
+            regions.spec.derive.as_ref().unwrap().transforms[0]
+                .source
+                .collection()
+                .as_str(),
+            "acmeCo/anvils-by-region",
+        );
+        let binding = &sources.materializations[0].spec.bindings[0];
+        assert_eq!(
+            binding.source.collection().as_str(),
+            "acmeCo/anvils-by-region"
+        );
+        assert_eq!(binding.backfill, 1);
+
+        // The deriving transform of the predecessor itself is unchanged.
+        let anvils = &sources.collections[0];
+        assert_eq!(anvils.collection.as_str(), "acmeCo/anvils");
+        assert_eq!(
+            anvils.spec.derive.as_ref().unwrap().transforms[0]
+                .source
+                .collection()
+                .as_str(),
+            "acmeCo/raw",
+        );
+    }
+}
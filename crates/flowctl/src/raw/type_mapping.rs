@@ -0,0 +1,439 @@
+use crate::local_specs;
+use anyhow::Context;
+use proto_flow::{
+    flow,
+    materialize::{self, response::type_mapped},
+};
+
+#[derive(Debug, clap::Args)]
+#[clap(rename_all = "kebab-case")]
+pub struct TypeMapping {
+    /// Path or URL to a Flow specification file.
+    #[clap(long)]
+    source: String,
+    /// Name of the materialization to report.
+    /// Materialization is required if there are multiple materializations in --source specifications.
+    #[clap(long)]
+    materialization: Option<String>,
+    /// Name of a collection. When set, only bindings of this collection are reported.
+    #[clap(long)]
+    collection: Option<String>,
+    /// Docker network to run the connector, if one exists
+    #[clap(long, default_value = "bridge")]
+    network: String,
+}
+
+/// Mapping of a single projection of a materialization binding.
+#[derive(Debug, serde::Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct Mapping {
+    /// Resource path of the binding, such as a schema and table name.
+    resource_path: Vec<String>,
+    /// Collection of the binding.
+    collection: String,
+    /// Projected field.
+    field: String,
+    /// Document location of the field.
+    ptr: String,
+    /// Possible JSON types of the field, with any string format.
+    json_type: String,
+    /// How the field is selected ("key", "value", or "document"),
+    /// or empty if it's not materialized.
+    selected: &'static str,
+    /// Constraint of the connector.
+    constraint: String,
+    /// Reason given by the connector for its constraint.
+    reason: String,
+    /// Destination column type of the field, as reported by the connector.
+    /// Empty if the field isn't materialized, or if the connector doesn't
+    /// support type mapping requests.
+    column_type: String,
+    /// Conversions of field values which may lose information, as reported
+    /// by the connector.
+    #[serde(skip_serializing_if = "Vec::is_empty")]
+    conversions: Vec<String>,
+}
+
+impl crate::output::CliOutput for Mapping {
+    type TableAlt = ();
+    type CellValue = String;
+
+    fn table_headers(_alt: Self::TableAlt) -> Vec<&'static str> {
+        vec![
+            "Resource",
+            "Field",
+            "JSON Type",
+            "Selected",
+            "Constraint",
+            "Reason",
+            "Column Type",
+            "Conversions",
+        ]
+    }
+
+    fn into_table_row(self, _alt: Self::TableAlt) -> Vec<Self::CellValue> {
+        vec![
+            self.resource_path.join("."),
+            self.field,
+            self.json_type,
+            self.selected.to_string(),
+            self.constraint,
+            self.reason,
+            self.column_type,
+            self.conversions.join("; "),
+        ]
+    }
+}
+
+pub async fn do_type_mapping(
+    ctx: &mut crate::CliContext,
+    TypeMapping {
+        source,
+        materialization,
+        collection,
+        network,
+    }: &TypeMapping,
+) -> anyhow::Result<()> {
+    let (_sources, validations) =
+        local_specs::load_and_validate_full(ctx.controlplane_client().await?, source, network)
+            .await?;
+
+    // Identify the materialization to report.
+    let built = &validations.built_materializations;
+    let needle = if let Some(needle) = materialization {
+        needle.as_str()
+    } else if built.len() == 1 {
+        built.first().unwrap().materialization.as_str()
+    } else if built.is_empty() {
+        anyhow::bail!("sourced specification files do not contain any materializations");
+    } else {
+        anyhow::bail!("sourced specification files contain multiple materializations. Use --materialization to identify a specific one");
+    };
+
+    let built = match built.binary_search_by_key(&needle, |m| m.materialization.as_str()) {
+        Ok(index) => &built[index],
+        Err(_) => anyhow::bail!("could not find the materialization {needle}"),
+    };
+
+    let mapped = match type_mapping(&built.spec, network).await {
+        Ok(mapped) => Some(mapped),
+        Err(err) => {
+            tracing::warn!(
+                ?err,
+                "connector did not report its type mapping; column types and conversions are omitted"
+            );
+            None
+        }
+    };
+
+    ctx.write_all(
+        mapping_rows(
+            &built.spec,
+            &built.validated,
+            mapped.as_ref(),
+            collection.as_deref(),
+        ),
+        (),
+    )
+}
+
+// Request the type mapping of the bindings of `spec` from its connector.
+async fn type_mapping(
+    spec: &flow::MaterializationSpec,
+    network: &str,
+) -> anyhow::Result<materialize::response::TypeMapped> {
+    let validate = materialize::request::Validate {
+        name: spec.name.clone(),
+        connector_type: spec.connector_type,
+        config_json: spec.config_json.clone(),
+        bindings: spec
+            .bindings
+            .iter()
+            .map(|binding| materialize::request::validate::Binding {
+                resource_config_json: binding.resource_config_json.clone(),
+                collection: binding.collection.clone(),
+                field_config_json_map: binding
+                    .field_selection
+                    .as_ref()
+                    .map(|selection| selection.field_config_json_map.clone())
+                    .unwrap_or_default(),
+                backfill: binding.backfill,
+            })
+            .collect(),
+        last_materialization: None,
+        last_version: String::new(),
+    };
+
+    let mapped = runtime::Runtime::new(
+        true, // All local.
+        network.to_string(),
+        ops::tracing_log_handler,
+        None,
+        format!("type-mapping/{}", spec.name),
+    )
+    .unary_materialize(
+        materialize::Request {
+            type_mapping: Some(materialize::request::TypeMapping {
+                validate: Some(validate),
+            }),
+            ..Default::default()
+        },
+        build::CONNECTOR_TIMEOUT,
+    )
+    .await?
+    .type_mapped
+    .context("connector didn't send expected TypeMapped response")?;
+
+    if mapped.bindings.len() != spec.bindings.len() {
+        anyhow::bail!(
+            "connector sent {} TypeMapped bindings, but there are {} bindings",
+            mapped.bindings.len(),
+            spec.bindings.len()
+        );
+    }
+    Ok(mapped)
+}
+
+// Build a Mapping of each projection of the bindings of `spec`, optionally
+// filtered to bindings of `collection`. Validated and TypeMapped bindings are
+// in the order of the built spec's bindings.
+fn mapping_rows(
+    spec: &flow::MaterializationSpec,
+    validated: &materialize::response::Validated,
+    mapped: Option<&materialize::response::TypeMapped>,
+    collection: Option<&str>,
+) -> Vec<Mapping> {
+    let mut rows = Vec::new();
+
+    for (index, (binding, validated)) in spec
+        .bindings
+        .iter()
+        .zip(validated.bindings.iter())
+        .enumerate()
+    {
+        let Some(spec) = binding.collection.as_ref() else {
+            continue;
+        };
+        if matches!(collection, Some(c) if c != spec.name) {
+            continue;
+        }
+        let selection = binding.field_selection.clone().unwrap_or_default();
+        let mapped = mapped.and_then(|mapped| mapped.bindings.get(index));
+
+        for projection in &spec.projections {
+            let constraint = validated
+                .constraints
+                .get(&projection.field)
+                .cloned()
+                .unwrap_or_default();
+
+            let selected = if selection.keys.contains(&projection.field) {
+                "key"
+            } else if selection.values.contains(&projection.field) {
+                "value"
+            } else if selection.document == projection.field {
+                "document"
+            } else {
+                ""
+            };
+
+            let type_mapped::Mapping {
+                column_type,
+                conversions,
+            } = match mapped.and_then(|mapped| mapped.mappings.get(&projection.field)) {
+                Some(mapping) if !selected.is_empty() => mapping.clone(),
+                _ => Default::default(),
+            };
+
+            rows.push(Mapping {
+                resource_path: binding.resource_path.clone(),
+                collection: spec.name.clone(),
+                field: projection.field.clone(),
+                ptr: projection.ptr.clone(),
+                json_type: json_type(projection),
+                selected,
+                constraint: constraint.r#type().as_str_name().to_string(),
+                reason: constraint.reason.clone(),
+                column_type,
+                conversions,
+            });
+        }
+    }
+    rows
+}
+
+// Describe the possible JSON types of a projection, with a string format.
+fn json_type(projection: &flow::Projection) -> String {
+    let Some(inference) = &projection.inference else {
+        return String::new();
+    };
+    let mut out = inference.types.join("|");
+
+    match &inference.string {
+        Some(flow::inference::String { format, .. }) if !format.is_empty() => {
+            out.push_str(&format!(" ({format})"));
+        }
+        _ => (),
+    }
+    out
+}
+
+#[cfg(test)]
+mod test {
+    use super::mapping_rows;
+    use proto_flow::{
+        flow,
+        materialize::response::{type_mapped, validated, TypeMapped, Validated},
+    };
+    use serde_json::json;
+
+    #[test]
+    fn test_mapping_rows() {
+        let projection = |field: &str, types: &[&str], format: &str| flow::Projection {
+            field: field.to_string(),
+            ptr: format!("/{field}"),
+            inference: Some(flow::Inference {
+                types: types.iter().map(|t| t.to_string()).collect(),
+                string: (!format.is_empty()).then(|| flow::inference::String {
+                    format: format.to_string(),
+                    ..Default::default()
+                }),
+                ..Default::default()
+            }),
+            ..Default::default()
+        };
+        let binding = |collection: &str, table: &str| flow::materialization_spec::Binding {
+            resource_path: vec!["public".to_string(), table.to_string()],
+            collection: Some(flow::CollectionSpec {
+                name: collection.to_string(),
+                projections: vec![
+                    projection("id", &["integer"], ""),
+                    projection("ts", &["string"], "date-time"),
+                    projection("extra", &["object", "null"], ""),
+                ],
+                ..Default::default()
+            }),
+            field_selection: Some(flow::FieldSelection {
+                keys: vec!["id".to_string()],
+                values: vec!["ts".to_string()],
+                ..Default::default()
+            }),
+            ..Default::default()
+        };
+        let spec = flow::MaterializationSpec {
+            name: "acme/materialization".to_string(),
+            bindings: vec![binding("acme/one", "one"), binding("acme/two", "two")],
+            ..Default::default()
+        };
+
+        let constraint = |ty: validated::constraint::Type, reason: &str| validated::Constraint {
+            r#type: ty as i32,
+            reason: reason.to_string(),
+        };
+        let validated_binding = validated::Binding {
+            constraints: [
+                (
+                    "id".to_string(),
+                    constraint(validated::constraint::Type::LocationRequired, "key"),
+                ),
+                (
+                    "ts".to_string(),
+                    constraint(
+                        validated::constraint::Type::FieldOptional,
+                        "may be materialized",
+                    ),
+                ),
+                (
+                    "extra".to_string(),
+                    constraint(validated::constraint::Type::FieldForbidden, "not supported"),
+                ),
+            ]
+            .into_iter()
+            .collect(),
+            ..Default::default()
+        };
+        let validated = Validated {
+            bindings: vec![validated_binding.clone(), validated_binding],
+        };
+
+        let mapping = |column_type: &str, conversions: &[&str]| type_mapped::Mapping {
+            column_type: column_type.to_string(),
+            conversions: conversions.iter().map(|c| c.to_string()).collect(),
+        };
+        let mapped = TypeMapped {
+            bindings: vec![
+                type_mapped::Binding {
+                    mappings: [
+                        ("id".to_string(), mapping("BIGINT", &[])),
+                        (
+                            "ts".to_string(),
+                            mapping("TIMESTAMP(3)", &["sub-millisecond precision is truncated"]),
+                        ),
+                        ("extra".to_string(), mapping("JSON", &[])),
+                    ]
+                    .into_iter()
+                    .collect(),
+                },
+                type_mapped::Binding::default(),
+            ],
+        };
+
+        let rows = |mapped: Option<&TypeMapped>, collection: Option<&str>| {
+            serde_json::to_value(mapping_rows(&spec, &validated, mapped, collection)).unwrap()
+        };
+
+        // Connector-reported column types and conversions are attached to
+        // selected fields, and are omitted from fields which aren't selected.
+        // The second binding has no reported mappings.
+        assert_eq!(
+            rows(Some(&mapped), Some("acme/one")),
+            json!([
+                {
+                    "resourcePath": ["public", "one"],
+                    "collection": "acme/one",
+                    "field": "id",
+                    "ptr": "/id",
+                    "jsonType": "integer",
+                    "selected": "key",
+                    "constraint": "LOCATION_REQUIRED",
+                    "reason": "key",
+                    "columnType": "BIGINT",
+                },
+                {
+                    "resourcePath": ["public", "one"],
+                    "collection": "acme/one",
+                    "field": "ts",
+                    "ptr": "/ts",
+                    "jsonType": "string (date-time)",
+                    "selected": "value",
+                    "constraint": "FIELD_OPTIONAL",
+                    "reason": "may be materialized",
+                    "columnType": "TIMESTAMP(3)",
+                    "conversions": ["sub-millisecond precision is truncated"],
+                },
+                {
+                    "resourcePath": ["public", "one"],
+                    "collection": "acme/one",
+                    "field": "extra",
+                    "ptr": "/extra",
+                    "jsonType": "object|null",
+                    "selected": "",
+                    "constraint": "FIELD_FORBIDDEN",
+                    "reason": "not supported",
+                    "columnType": "",
+                },
+            ]),
+        );
+
+        let all = rows(Some(&mapped), None);
+        assert_eq!(all.as_array().unwrap().len(), 6);
+        assert_eq!(all[3]["collection"], "acme/two");
+        assert_eq!(all[3]["columnType"], "");
+
+        // Without a TypeMapped response, column types and conversions are empty.
+        let unmapped = rows(None, Some("acme/one"));
+        assert_eq!(unmapped[1]["columnType"], "");
+        assert!(unmapped[1].get("conversions").is_none());
+        assert_eq!(unmapped[1]["constraint"], "FIELD_OPTIONAL");
+    }
+}
@@ -20,6 +20,15 @@ pub enum Error {
 
     #[error("protocol error: {0}")]
     ProtocolError(Cow<'static, str>),
+
+    #[error("fragment {begin}-{end} of journal {journal} has SHA1 sum {actual}, but its expected sum is {expected}")]
+    ChecksumMismatch {
+        journal: String,
+        begin: i64,
+        end: i64,
+        expected: String,
+        actual: String,
+    },
 }
 
 fn io_err<T: Into<Error>>(inner: T) -> io::Error {
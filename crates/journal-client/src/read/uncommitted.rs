@@ -84,6 +84,7 @@ pub struct JournalRead {
     fetch_fragments: bool,
     block: bool,
     begin_mod_time: i64,
+    verify_checksums: bool,
 }
 
 impl JournalRead {
@@ -95,6 +96,7 @@ impl JournalRead {
             fetch_fragments: true,
             block: false,
             begin_mod_time: 0,
+            verify_checksums: false,
         }
     }
 
@@ -119,6 +121,13 @@ impl JournalRead {
         self
     }
 
+    /// Verify the SHA1 sums of fragment files which are read directly from cloud storage,
+    /// failing the read (which may then be retried) if a fragment's content doesn't match.
+    pub fn verify_fragment_checksums(mut self, enabled: bool) -> Self {
+        self.verify_checksums = enabled;
+        self
+    }
+
     fn to_read_request(&self, needs_direct_read: bool) -> broker::ReadRequest {
         broker::ReadRequest {
             header: None,
@@ -147,7 +156,11 @@ pub struct Reader<R: Retry> {
 
 impl<R: Retry> Reader<R> {
     pub fn start_read(client: Client, req: JournalRead, retry: R) -> Reader<R> {
-        let start = start_new_read(client.clone(), req.to_read_request(false));
+        let start = start_new_read(
+            client.clone(),
+            req.to_read_request(false),
+            req.verify_checksums,
+        );
         Reader {
             client,
             read: req,
@@ -184,7 +197,11 @@ impl<R: Retry> Reader<R> {
         }
 
         self.retry.reset();
-        let fut = start_new_read(self.client.clone(), self.read_req());
+        let fut = start_new_read(
+            self.client.clone(),
+            self.read_req(),
+            self.read.verify_checksums,
+        );
         self.inner = State::StartReq(fut);
         Ok(())
     }
@@ -252,6 +269,7 @@ impl<R: Retry> Reader<R> {
                     State::StartReq(start_new_read(
                         client.clone(),
                         req.to_read_request(*needs_direct_read),
+                        req.verify_checksums,
                     ))
                 }
             };
@@ -305,7 +323,11 @@ impl<R: Retry> futures::io::AsyncRead for Reader<R> {
                 if let Some(backoff) = next_backoff {
                     // If backoff is zero, then don't both asking the runtime to sleep
                     if backoff.is_zero() {
-                        let fut = start_new_read(self.client.clone(), self.read_req());
+                        let fut = start_new_read(
+                            self.client.clone(),
+                            self.read_req(),
+                            self.read.verify_checksums,
+                        );
                         self.inner = State::StartReq(fut);
                     } else {
                         self.inner = State::Backoff(Box::pin(tokio::time::sleep(backoff)));
@@ -360,9 +382,10 @@ fn read_err(err: &io::Error) -> Option<&Error> {
 fn start_new_read(
     client: Client,
     req: broker::ReadRequest,
+    verify_checksums: bool,
 ) -> BoxFuture<'static, Result<raw::Reader, Error>> {
     Box::pin(async move {
         let mut c = client;
-        raw::start_read(&mut c, req).await
+        raw::start_read(&mut c, req, verify_checksums).await
     })
 }
@@ -18,10 +18,11 @@ lazy_static::lazy_static! {
 
 /// Reads a single fragment file from cloud storage, using a pre-signed URL. Performs decompression
 /// as necessary.
-#[derive(Debug)]
 pub struct FragmentReader {
     fragment: broker::Fragment,
     state: FragmentReadState,
+    /// SHA1 sum of the content read so far, if the checksum is being verified.
+    sum: Option<sha1::Sha1>,
 }
 
 impl FragmentReader {
@@ -45,13 +46,47 @@ impl FragmentReader {
         FragmentReader {
             fragment,
             state: FragmentReadState::PendingResponse(Box::pin(get.send())),
+            sum: None,
         }
     }
-}
 
-impl AsyncRead for FragmentReader {
-    fn poll_read(
-        mut self: Pin<&mut Self>,
+    /// Verify the SHA1 sum of the fragment content against that of its metadata,
+    /// returning an error upon reading to the end of a fragment whose sum doesn't match.
+    /// Fragments having no sum in their metadata cannot be verified.
+    pub fn with_checksum_verification(mut self, enabled: bool) -> FragmentReader {
+        let has_sum = matches!(&self.fragment.sum, Some(sum) if *sum != Default::default());
+        self.sum = (enabled && has_sum).then(sha1::Sha1::default);
+        self
+    }
+
+    fn verify_sum(&self, sum: sha1::Sha1) -> Result<(), Error> {
+        use sha1::Digest;
+
+        let digest = sum.finalize();
+        let actual = broker::Sha1Sum {
+            part1: u64::from_be_bytes(digest[0..8].try_into().unwrap()),
+            part2: u64::from_be_bytes(digest[8..16].try_into().unwrap()),
+            part3: u32::from_be_bytes(digest[16..20].try_into().unwrap()),
+        };
+        let expected = self.fragment.sum.clone().unwrap_or_default();
+
+        if actual == expected {
+            tracing::debug!(fragment = ?self.fragment, "verified fragment checksum");
+            return Ok(());
+        }
+        let hex = |s: &broker::Sha1Sum| format!("{:016x}{:016x}{:08x}", s.part1, s.part2, s.part3);
+
+        Err(Error::ChecksumMismatch {
+            journal: self.fragment.journal.clone(),
+            begin: self.fragment.begin,
+            end: self.fragment.end,
+            expected: hex(&expected),
+            actual: hex(&actual),
+        })
+    }
+
+    fn poll_read_content(
+        &mut self,
         cx: &mut std::task::Context<'_>,
         buf: &mut [u8],
     ) -> Poll<io::Result<usize>> {
@@ -82,6 +117,39 @@ impl AsyncRead for FragmentReader {
     }
 }
 
+impl AsyncRead for FragmentReader {
+    fn poll_read(
+        mut self: Pin<&mut Self>,
+        cx: &mut std::task::Context<'_>,
+        buf: &mut [u8],
+    ) -> Poll<io::Result<usize>> {
+        use sha1::Digest;
+
+        let n = ready!(self.poll_read_content(cx, buf))?;
+
+        if n != 0 {
+            if let Some(sum) = self.sum.as_mut() {
+                sum.update(&buf[..n]);
+            }
+        } else if let Some(sum) = self.sum.take() {
+            if let Err(err) = self.verify_sum(sum) {
+                return Poll::Ready(Err(io::Error::new(io::ErrorKind::InvalidData, err)));
+            }
+        }
+        Poll::Ready(Ok(n))
+    }
+}
+
+impl Debug for FragmentReader {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        f.debug_struct("FragmentReader")
+            .field("fragment", &self.fragment)
+            .field("state", &self.state)
+            .field("verify_checksum", &self.sum.is_some())
+            .finish()
+    }
+}
+
 fn new_fragment_response_reader(
     compression: broker::CompressionCodec,
     resp: reqwest::Response,
@@ -130,3 +198,62 @@ impl Debug for FragmentReadState {
         }
     }
 }
+
+#[cfg(test)]
+mod test {
+    use super::*;
+    use futures::io::AsyncReadExt;
+
+    const CONTENT: &[u8] = b"{\"hello\":\"world\"}\n";
+
+    fn reader(sum: broker::Sha1Sum) -> FragmentReader {
+        FragmentReader {
+            fragment: broker::Fragment {
+                journal: "a/journal".to_string(),
+                begin: 100,
+                end: 100 + CONTENT.len() as i64,
+                sum: Some(sum),
+                ..Default::default()
+            },
+            state: FragmentReadState::Reading(Box::new(futures::io::Cursor::new(CONTENT))),
+            sum: None,
+        }
+        .with_checksum_verification(true)
+    }
+
+    #[test]
+    fn test_checksum_verification() {
+        // SHA1 of CONTENT is 231f18733da35a9d6cc1e85debef593a29e41d04.
+        let expected = broker::Sha1Sum {
+            part1: 0x231f18733da35a9d,
+            part2: 0x6cc1e85debef593a,
+            part3: 0x29e41d04,
+        };
+        let mut content = Vec::new();
+        futures::executor::block_on(reader(expected.clone()).read_to_end(&mut content)).unwrap();
+        assert_eq!(content, CONTENT);
+
+        let wrong = broker::Sha1Sum {
+            part3: 0x29e41d05,
+            ..expected
+        };
+        let err =
+            futures::executor::block_on(reader(wrong).read_to_end(&mut Vec::new())).unwrap_err();
+
+        match err.get_ref().and_then(|e| e.downcast_ref::<Error>()) {
+            Some(Error::ChecksumMismatch {
+                journal,
+                begin,
+                end,
+                expected,
+                actual,
+            }) => {
+                assert_eq!(journal, "a/journal");
+                assert_eq!((*begin, *end), (100, 118));
+                assert_eq!(expected, "231f18733da35a9d6cc1e85debef593a29e41d05");
+                assert_eq!(actual, "231f18733da35a9d6cc1e85debef593a29e41d04");
+            }
+            other => panic!("expected ChecksumMismatch, got {other:?}"),
+        }
+    }
+}
@@ -9,13 +9,17 @@ use std::pin::Pin;
 use std::task::Poll;
 use tonic::codec::Streaming;
 
-pub async fn start_read(client: &mut Client, req: broker::ReadRequest) -> Result<Reader, Error> {
+pub async fn start_read(
+    client: &mut Client,
+    req: broker::ReadRequest,
+    verify_checksums: bool,
+) -> Result<Reader, Error> {
     let offset = req.offset;
     let journal = req.journal.clone();
     tracing::debug!(?req, "starting new read of journal");
     let response = client.read(req).await?;
     // TODO: see if there's anything in the response we should check or log before proceeding to read
-    Ok(Reader::new(journal, offset, response.into_inner()))
+    Ok(Reader::new(journal, offset, response.into_inner()).verify_checksums(verify_checksums))
 }
 
 /// A basic reader that doesn't do any sort of reties, but implements `futures::io::AsyncRead`.
@@ -31,6 +35,7 @@ pub struct Reader {
     response_stream: Option<Streaming<broker::ReadResponse>>,
     current_content: Option<Content>,
     current_fragment_metadata: Option<broker::Fragment>,
+    verify_checksums: bool,
 }
 
 impl Reader {
@@ -46,9 +51,16 @@ impl Reader {
             response_stream: Some(stream),
             current_content: None,
             current_fragment_metadata: None,
+            verify_checksums: false,
         }
     }
 
+    /// Verify the checksums of fragment files which are read directly from cloud storage.
+    pub(crate) fn verify_checksums(mut self, enabled: bool) -> Reader {
+        self.verify_checksums = enabled;
+        self
+    }
+
     /// Returns metadata on the fragment that's currently being read by the reader.
     pub fn current_fragment(&self) -> Option<&broker::Fragment> {
         self.current_fragment_metadata.as_ref()
@@ -180,7 +192,8 @@ impl AsyncRead for Reader {
                     }
                     let content = Content::Fragment {
                         discard_bytes,
-                        fragment: FragmentReader::new(resp.fragment_url, fragment.clone()),
+                        fragment: FragmentReader::new(resp.fragment_url, fragment.clone())
+                            .with_checksum_verification(self.verify_checksums),
                     };
                     self.current_content = Some(content);
                 } else {
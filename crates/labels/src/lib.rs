@@ -32,6 +32,10 @@ pub const HOSTNAME: &str = "estuary.dev/hostname";
 pub const EXPOSE_PORT: &str = "estuary.dev/expose-port";
 pub const PORT_PROTO_PREFIX: &str = "estuary.dev/port-proto/";
 pub const PORT_PUBLIC_PREFIX: &str = "estuary.dev/port-public/";
+// Shard labels related to runtime resource limits.
+pub const MEMORY_LIMIT: &str = "estuary.dev/memory-limit";
+// Shard labels related to background re-discovery of captures.
+pub const REDISCOVER_INTERVAL: &str = "estuary.dev/rediscover-interval";
 
 // A used subset of Gazette labels, defined in go.gazette.dev/core/labels/labels.go.
 pub const CONTENT_TYPE: &str = "content-type";
@@ -10,6 +10,19 @@ pub struct ConnectorConfig {
     pub image: String,
     /// # Configuration of the connector.
     pub config: RawValue,
+    /// # Environment variables of the connector container.
+    /// Variables must be permitted by the allowlist of the data plane.
+    #[serde(default, skip_serializing_if = "BTreeMap::is_empty")]
+    pub env: BTreeMap<String, String>,
+    /// # Read-only files or directories mounted into the connector container.
+    /// Such as CA bundles or Kerberos keytabs. Mount sources must be
+    /// permitted by the allowlist of the data plane.
+    #[serde(default, skip_serializing_if = "Vec::is_empty")]
+    pub mounts: Vec<ConnectorMount>,
+    /// # In-memory temporary filesystems of the connector container.
+    /// Their total size is limited by the data plane.
+    #[serde(default, skip_serializing_if = "Vec::is_empty")]
+    pub tmpfs: Vec<ConnectorTmpfs>,
 }
 
 impl ConnectorConfig {
@@ -17,6 +30,47 @@ impl ConnectorConfig {
         Self {
             image: "connector/image:tag".to_string(),
             config: serde_json::from_str("\"connector-config.yaml\"").unwrap(),
+            env: BTreeMap::new(),
+            mounts: Vec::new(),
+            tmpfs: Vec::new(),
+        }
+    }
+}
+
+/// A read-only mount of a host file or directory into a connector container.
+#[derive(Serialize, Deserialize, Clone, Debug, JsonSchema, PartialEq)]
+#[schemars(example = "ConnectorMount::example")]
+pub struct ConnectorMount {
+    /// # Absolute path of the host file or directory.
+    pub source: String,
+    /// # Absolute path at which the source is mounted within the container.
+    pub target: String,
+}
+
+impl ConnectorMount {
+    pub fn example() -> Self {
+        Self {
+            source: "/etc/flow/krb5.keytab".to_string(),
+            target: "/etc/krb5.keytab".to_string(),
+        }
+    }
+}
+
+/// An in-memory temporary filesystem of a connector container.
+#[derive(Serialize, Deserialize, Clone, Debug, JsonSchema, PartialEq)]
+#[schemars(example = "ConnectorTmpfs::example")]
+pub struct ConnectorTmpfs {
+    /// # Absolute path of the filesystem within the container.
+    pub target: String,
+    /// # Size of the filesystem, in megabytes.
+    pub size: u32,
+}
+
+impl ConnectorTmpfs {
+    pub fn example() -> Self {
+        Self {
+            target: "/scratch".to_string(),
+            size: 64,
         }
     }
 }
@@ -1,6 +1,6 @@
 use super::{
-    CompositeKey, ConnectorConfig, DeriveUsingSqlite, DeriveUsingTypescript, LocalConfig, RawValue,
-    ShardTemplate, Source, Transform,
+    Collection, CompositeKey, ConnectorConfig, DeriveUsingSqlite, DeriveUsingTypescript,
+    LocalConfig, RawValue, ShardTemplate, Source, Transform,
 };
 use schemars::{schema::Schema, JsonSchema};
 use serde::{Deserialize, Serialize};
@@ -99,6 +99,50 @@ pub struct TransformDef {
     /// of a preceding backfill.
     #[serde(default, skip_serializing_if = "super::is_u32_zero")]
     pub backfill: u32,
+    /// # Policy for source documents which this transform fails to process.
+    /// By default, a document which fails halts the derivation.
+    /// Documents which are skipped or dead-lettered are counted in the
+    /// `skipped` and `deadLettered` stats of the transform.
+    /// Policies other than `halt` are supported only by SQLite derivations.
+    #[serde(default, skip_serializing_if = "OnError::is_halt")]
+    pub on_error: OnError,
+    /// # Maximum number of failed documents of this transform.
+    /// Failed documents are counted across all transactions of the derivation.
+    /// If more documents fail, the derivation halts.
+    /// When unset, the number of skipped or dead-lettered documents is unbounded.
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub error_budget: Option<u32>,
+    /// # Dead-letter collection of documents which fail.
+    /// Required when `onError` is `deadLetter`. Each failed document is
+    /// published into this collection as a document having the `transform`,
+    /// its `error`, and the source `document`, which must be valid against
+    /// the collection's schema. The collection may not have logical partitions.
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub dead_letter: Option<Collection>,
+}
+
+/// OnError is the handling of a source document which a transform fails to process.
+#[derive(Serialize, Deserialize, Clone, Copy, Debug, PartialEq, JsonSchema)]
+#[serde(deny_unknown_fields, rename_all = "camelCase")]
+pub enum OnError {
+    /// # Skip the failed document, and continue.
+    Skip,
+    /// # Route the failed document to the `deadLetter` collection, and continue.
+    DeadLetter,
+    /// # Halt the derivation.
+    Halt,
+}
+
+impl OnError {
+    pub fn is_halt(&self) -> bool {
+        matches!(self, Self::Halt)
+    }
+}
+
+impl Default for OnError {
+    fn default() -> Self {
+        Self::Halt
+    }
 }
 
 /// A Shuffle specifies how a shuffling key is to be extracted from
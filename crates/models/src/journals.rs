@@ -270,6 +270,16 @@ pub struct FragmentTemplate {
     )]
     #[schemars(schema_with = "super::duration_schema")]
     pub flush_interval: Option<std::time::Duration>,
+    /// # AWS KMS key for S3 server-side encryption (SSE-KMS) of fragments.
+    /// A customer-managed key ID or ARN. When set, fragments persisted to S3
+    /// are written with SSE-KMS using this key, and S3 encrypts them at rest.
+    /// This is S3 only: collections mapped to GCS, Azure, or custom
+    /// S3-compatible stores may not set a key.
+    /// Keys are rotated by updating this value: new fragments are encrypted
+    /// with the updated key, while prior fragments remain readable so long as
+    /// their key remains enabled.
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub kms_key: Option<String>,
 }
 
 impl FragmentTemplate {
@@ -286,9 +296,10 @@ impl FragmentTemplate {
             compression_codec: o2,
             retention: o3,
             flush_interval: o4,
+            kms_key: o5,
         } = self;
 
-        o1.is_none() && o2.is_none() && o3.is_none() && o4.is_none()
+        o1.is_none() && o2.is_none() && o3.is_none() && o4.is_none() && o5.is_none()
     }
 }
 
@@ -21,8 +21,8 @@ pub use crate::labels::{Label, LabelSelector, LabelSet};
 pub use captures::{AutoDiscover, CaptureBinding, CaptureDef, CaptureEndpoint};
 pub use catalogs::Catalog;
 pub use collections::{CollectionDef, Projection};
-pub use connector::{ConnectorConfig, LocalConfig};
-pub use derivation::{Derivation, DeriveUsing, Shuffle, ShuffleType, TransformDef};
+pub use connector::{ConnectorConfig, ConnectorMount, ConnectorTmpfs, LocalConfig};
+pub use derivation::{Derivation, DeriveUsing, OnError, Shuffle, ShuffleType, TransformDef};
 pub use derive_sqlite::DeriveUsingSqlite;
 pub use derive_typescript::DeriveUsingTypescript;
 pub use journals::{
@@ -46,6 +46,51 @@ impl Schema {
     // URL for referencing the write schema of a collection, which may be used within a read schema.
     pub const REF_WRITE_SCHEMA_URL: &'static str = "flow://write-schema";
 
+    // JSON pointer of a synthetic collection key, which may be used by collections
+    // having no natural key. Captures populate it with a random UUID for every
+    // captured document which doesn't already have one.
+    pub const SYNTHETIC_KEY_PTR: &'static str = "/_meta/synthetic_key";
+
+    /// Extend this bundled Schema to require the SYNTHETIC_KEY_PTR location
+    /// as a string, so that it may be used as a collection key.
+    /// The requirement is added as an `allOf` of the root schema,
+    /// so that relative references of the schema are unaffected.
+    pub fn with_synthetic_key(&self) -> Self {
+        const KEYWORD_ALL_OF: &str = "allOf";
+
+        use serde_json::value::to_raw_value;
+        type Skim = BTreeMap<String, RawValue>;
+
+        let mut schema: Skim = serde_json::from_str(self.get()).unwrap();
+        let mut all_of: Vec<RawValue> = schema
+            .get(KEYWORD_ALL_OF)
+            .map(|a| serde_json::from_str(a.get()).unwrap())
+            .unwrap_or_default();
+
+        all_of.push(RawValue::from_value(&json!({
+            "properties": {
+                "_meta": {
+                    "type": "object",
+                    "properties": {
+                        "synthetic_key": {
+                            "type": "string",
+                            "format": "uuid",
+                            "description": "Synthetic key of this document, generated upon its capture."
+                        }
+                    },
+                    "required": ["synthetic_key"]
+                }
+            },
+            "required": ["_meta"]
+        })));
+
+        _ = schema.insert(
+            KEYWORD_ALL_OF.to_string(),
+            to_raw_value(&all_of).unwrap().into(),
+        );
+        Self(to_raw_value(&schema).unwrap().into())
+    }
+
     /// Returns true if this Schema references the canonical inferred schema URL.
     pub fn references_inferred_schema(&self) -> bool {
         REF_INFERRED_SCHEMA_RE.is_match(self.get())
@@ -321,4 +366,23 @@ mod test {
         }
         "###);
     }
+
+    #[test]
+    fn test_with_synthetic_key() {
+        let schema = Schema::new(RawValue::from_value(&json!({
+            "type": "object",
+            "allOf": [{"$ref": "#/$defs/row"}],
+            "$defs": {"row": {"required": ["a"]}},
+        })));
+        let schema = schema.with_synthetic_key().to_value();
+
+        assert_eq!(schema["type"], json!("object"));
+        assert_eq!(schema["$defs"], json!({"row": {"required": ["a"]}}));
+        assert_eq!(schema["allOf"][0], json!({"$ref": "#/$defs/row"}));
+        assert_eq!(schema["allOf"][1]["required"], json!(["_meta"]));
+        assert_eq!(
+            schema["allOf"][1]["properties"]["_meta"]["required"],
+            json!(["synthetic_key"])
+        );
+    }
 }
@@ -73,6 +73,26 @@ pub struct ShardTemplate {
     // we'll introduce a modular logging capability.
     #[serde(default, skip_serializing_if = "Option::is_none")]
     pub log_level: Option<String>,
+    /// # Interval at which a capture re-discovers its endpoint in the background.
+    /// Bindings which are stale with respect to the discovered resources are
+    /// reported in the capture's logs. Intervals shorter than the minimum of
+    /// the data-plane are raised to that minimum.
+    /// Applies only to captures. If not set, background re-discovery is disabled.
+    #[serde(
+        default,
+        with = "humantime_serde",
+        skip_serializing_if = "Option::is_none"
+    )]
+    #[schemars(schema_with = "super::duration_schema")]
+    pub rediscover_interval: Option<std::time::Duration>,
+    /// # Memory limit of each of this task's shards, in bytes.
+    /// Shards which exceed their limit are back-pressured, by closing their
+    /// current transaction and pausing the reading of further documents
+    /// until accounted memory is released.
+    /// If not set, the default limit of the data-plane is used.
+    /// EXPERIMENTAL: this field MAY be removed.
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub memory_limit: Option<u64>,
 }
 
 impl ShardTemplate {
@@ -92,6 +112,8 @@ impl ShardTemplate {
             ring_buffer_size: o4,
             read_channel_size: o5,
             log_level: o6,
+            rediscover_interval: o7,
+            memory_limit: o8,
         } = self;
 
         !disable
@@ -101,5 +123,7 @@ impl ShardTemplate {
             && o4.is_none()
             && o5.is_none()
             && o6.is_none()
+            && o7.is_none()
+            && o8.is_none()
     }
 }
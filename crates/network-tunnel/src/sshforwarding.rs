@@ -14,6 +14,10 @@ use serde::{Deserialize, Serialize};
 
 pub const ENDPOINT_ADDRESS_KEY: &str = "address";
 
+// Environment variable which selects the address family used to reach the SSH
+// endpoint: one of "any", "ipv4", or "ipv6". It's set by the Flow runtime.
+pub const ADDRESS_FAMILY_ENV: &str = "FLOW_CONNECTOR_ADDRESS_FAMILY";
+
 #[derive(Debug, Serialize, Deserialize, Clone, JsonSchema, PartialEq)]
 #[serde(rename_all = "camelCase")]
 #[schemars(
@@ -65,11 +69,39 @@ pub struct SshForwarding {
     process: Option<async_process::Child>,
 }
 
+// Split a `host:port` address, where an IPv6 host is enclosed in brackets
+// as `[2001:db8::1]:5432`. The returned host is unbracketed.
 fn split_host_port(hostport: String) -> Option<(String, u16)> {
-    let mut splits = hostport.as_str().splitn(2, ':');
-    let host = splits.next()?.to_string();
-    let port: u16 = splits.next()?.parse().ok()?;
-    Some((host, port))
+    let (host, port) = hostport.rsplit_once(':')?;
+    let port: u16 = port.parse().ok()?;
+
+    let host = match host.strip_prefix('[') {
+        Some(host) => host.strip_suffix(']')?,
+        None if host.contains(':') => return None, // Unbracketed IPv6.
+        None => host,
+    };
+    if host.is_empty() {
+        return None;
+    }
+    Some((host.to_string(), port))
+}
+
+// Map the address family selected by the runtime into an OpenSSH AddressFamily.
+fn ssh_address_family(family: Option<&str>) -> &'static str {
+    match family {
+        Some("ipv4") => "inet",
+        Some("ipv6") => "inet6",
+        _ => "any",
+    }
+}
+
+// Format a host of an OpenSSH forwarding stanza, which requires IPv6 hosts be bracketed.
+fn forwarding_host(host: &str) -> String {
+    if host.contains(':') && !host.starts_with('[') {
+        format!("[{host}]")
+    } else {
+        host.to_string()
+    }
 }
 
 impl SshForwarding {
@@ -162,10 +194,14 @@ impl NetworkTunnel for SshForwarding {
             // This is necessary unless we also ask for the public key from users
             "-o".to_string(),
             "StrictHostKeyChecking no".to_string(),
-            // Indicate that ssh is to use ipv4 only. Otherwise sometimes it tries to bind to [::1] instead of 127.0.0.1,
-            // which breaks things when the runtime environment isn't set up to support ipv6
+            // Address family used to connect to the SSH endpoint. The local forwarding
+            // port is always bound to 127.0.0.1 (below), which is present even in
+            // IPv6-only environments, so that ssh doesn't try to bind [::1] instead.
             "-o".to_string(),
-            "AddressFamily inet".to_string(),
+            format!(
+                "AddressFamily {}",
+                ssh_address_family(std::env::var(ADDRESS_FAMILY_ENV).ok().as_deref())
+            ),
             // Ask the client to time out after 5 seconds
             "-o".to_string(),
             "ConnectTimeout=5".to_string(),
@@ -176,7 +212,10 @@ impl NetworkTunnel for SshForwarding {
             "-N".to_string(),
             // Port forwarding stanza
             "-L".to_string(),
-            format!("{local_port}:{forward_host}:{forward_port}"),
+            format!(
+                "127.0.0.1:{local_port}:{}:{forward_port}",
+                forwarding_host(forward_host)
+            ),
             ssh_endpoint,
         ];
 
@@ -250,7 +289,41 @@ impl NetworkTunnel for SshForwarding {
 
 #[cfg(test)]
 mod test {
-    use crate::sshforwarding::SshForwarding;
+    use crate::sshforwarding::{
+        forwarding_host, split_host_port, ssh_address_family, SshForwarding,
+    };
+
+    #[test]
+    fn test_split_host_port() {
+        let split = |s: &str| split_host_port(s.to_string());
+
+        assert_eq!(
+            split("db.internal:5432"),
+            Some(("db.internal".to_string(), 5432))
+        );
+        assert_eq!(split("10.0.0.1:5432"), Some(("10.0.0.1".to_string(), 5432)));
+        assert_eq!(
+            split("[2001:db8::1]:5432"),
+            Some(("2001:db8::1".to_string(), 5432))
+        );
+
+        assert_eq!(split("db.internal"), None);
+        assert_eq!(split("2001:db8::1:5432"), None);
+        assert_eq!(split("[2001:db8::1:5432"), None);
+        assert_eq!(split(":5432"), None);
+    }
+
+    #[test]
+    fn test_address_family_and_forwarding_host() {
+        assert_eq!(ssh_address_family(None), "any");
+        assert_eq!(ssh_address_family(Some("any")), "any");
+        assert_eq!(ssh_address_family(Some("ipv4")), "inet");
+        assert_eq!(ssh_address_family(Some("ipv6")), "inet6");
+
+        assert_eq!(forwarding_host("db.internal"), "db.internal");
+        assert_eq!(forwarding_host("2001:db8::1"), "[2001:db8::1]");
+        assert_eq!(forwarding_host("[2001:db8::1]"), "[2001:db8::1]");
+    }
 
     #[test]
     fn test_backward_compatible_ssh_endpoint() {
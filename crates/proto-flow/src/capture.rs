@@ -214,6 +214,9 @@ pub mod response {
         >,
     }
     /// Discovered responds to Request.Discover.
+    /// A large response may be sent as a sequence of Discovered messages,
+    /// each having a chunk of the discovered bindings, which are concatenated.
+    /// The response is complete when the connector closes its stream.
     #[allow(clippy::derive_partial_eq_without_eq)]
     #[derive(Clone, PartialEq, ::prost::Message)]
     pub struct Discovered {
@@ -19,6 +19,8 @@ pub struct Request {
     pub start_commit: ::core::option::Option<request::StartCommit>,
     #[prost(message, optional, tag = "9")]
     pub acknowledge: ::core::option::Option<request::Acknowledge>,
+    #[prost(message, optional, tag = "10")]
+    pub type_mapping: ::core::option::Option<request::TypeMapping>,
     /// Reserved for internal use.
     #[prost(bytes = "bytes", tag = "100")]
     pub internal: ::prost::bytes::Bytes,
@@ -233,6 +235,17 @@ pub mod request {
     #[allow(clippy::derive_partial_eq_without_eq)]
     #[derive(Clone, PartialEq, ::prost::Message)]
     pub struct Acknowledge {}
+    /// TypeMapping requests the destination column types of the projections of
+    /// proposed bindings, and the conversions of their values into those types.
+    /// TypeMapping is run out-of-band with ongoing connector invocations,
+    /// and is used to report the type mapping of a materialization to users.
+    #[allow(clippy::derive_partial_eq_without_eq)]
+    #[derive(Clone, PartialEq, ::prost::Message)]
+    pub struct TypeMapping {
+        /// Validate request of the proposed configuration and bindings.
+        #[prost(message, optional, tag = "1")]
+        pub validate: ::core::option::Option<Validate>,
+    }
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -253,6 +266,8 @@ pub struct Response {
     pub started_commit: ::core::option::Option<response::StartedCommit>,
     #[prost(message, optional, tag = "8")]
     pub acknowledged: ::core::option::Option<response::Acknowledged>,
+    #[prost(message, optional, tag = "9")]
+    pub type_mapped: ::core::option::Option<response::TypeMapped>,
     /// Reserved for internal use.
     #[prost(bytes = "bytes", tag = "100")]
     pub internal: ::prost::bytes::Bytes,
@@ -484,6 +499,41 @@ pub mod response {
         #[prost(message, optional, tag = "1")]
         pub state: ::core::option::Option<super::super::flow::ConnectorState>,
     }
+    /// TypeMapped responds to Request.TypeMapping.
+    #[allow(clippy::derive_partial_eq_without_eq)]
+    #[derive(Clone, PartialEq, ::prost::Message)]
+    pub struct TypeMapped {
+        #[prost(message, repeated, tag = "1")]
+        pub bindings: ::prost::alloc::vec::Vec<type_mapped::Binding>,
+    }
+    /// Nested message and enum types in `TypeMapped`.
+    pub mod type_mapped {
+        /// Mapping of a projection into its destination column.
+        #[allow(clippy::derive_partial_eq_without_eq)]
+        #[derive(Clone, PartialEq, ::prost::Message)]
+        pub struct Mapping {
+            /// Destination column type of the projection, such as "VARCHAR(256)".
+            #[prost(string, tag = "1")]
+            pub column_type: ::prost::alloc::string::String,
+            /// Conversions of the projection's values into the column type which may
+            /// lose information, such as truncations or a loss of numeric precision.
+            #[prost(string, repeated, tag = "2")]
+            pub conversions: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+        }
+        /// Type mappings of each binding of the request, and matching the request
+        /// ordering.
+        #[allow(clippy::derive_partial_eq_without_eq)]
+        #[derive(Clone, PartialEq, ::prost::Message)]
+        pub struct Binding {
+            /// Mappings of collection projections, keyed by the projection field name.
+            /// Projections which the connector cannot materialize are omitted.
+            #[prost(btree_map = "string, message", tag = "1")]
+            pub mappings: ::prost::alloc::collections::BTreeMap<
+                ::prost::alloc::string::String,
+                Mapping,
+            >,
+        }
+    }
 }
 /// Extra messages used by connectors
 /// TODO(johnny): Do we still need this?
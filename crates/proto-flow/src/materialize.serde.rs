@@ -324,6 +324,9 @@ impl serde::Serialize for Request {
         if self.acknowledge.is_some() {
             len += 1;
         }
+        if self.type_mapping.is_some() {
+            len += 1;
+        }
         if !self.internal.is_empty() {
             len += 1;
         }
@@ -355,6 +358,9 @@ impl serde::Serialize for Request {
         if let Some(v) = self.acknowledge.as_ref() {
             struct_ser.serialize_field("acknowledge", v)?;
         }
+        if let Some(v) = self.type_mapping.as_ref() {
+            struct_ser.serialize_field("typeMapping", v)?;
+        }
         if !self.internal.is_empty() {
             #[allow(clippy::needless_borrow)]
             struct_ser.serialize_field("$internal", pbjson::private::base64::encode(&self.internal).as_str())?;
@@ -379,6 +385,8 @@ impl<'de> serde::Deserialize<'de> for Request {
             "start_commit",
             "startCommit",
             "acknowledge",
+            "type_mapping",
+            "typeMapping",
             "internal",
             "$internal",
         ];
@@ -394,6 +402,7 @@ impl<'de> serde::Deserialize<'de> for Request {
             Store,
             StartCommit,
             Acknowledge,
+            TypeMapping,
             Internal,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -425,6 +434,7 @@ impl<'de> serde::Deserialize<'de> for Request {
                             "store" => Ok(GeneratedField::Store),
                             "startCommit" | "start_commit" => Ok(GeneratedField::StartCommit),
                             "acknowledge" => Ok(GeneratedField::Acknowledge),
+                            "typeMapping" | "type_mapping" => Ok(GeneratedField::TypeMapping),
                             "$internal" | "internal" => Ok(GeneratedField::Internal),
                             _ => Err(serde::de::Error::unknown_field(value, FIELDS)),
                         }
@@ -454,6 +464,7 @@ impl<'de> serde::Deserialize<'de> for Request {
                 let mut store__ = None;
                 let mut start_commit__ = None;
                 let mut acknowledge__ = None;
+                let mut type_mapping__ = None;
                 let mut internal__ = None;
                 while let Some(k) = map_.next_key()? {
                     match k {
@@ -511,6 +522,12 @@ impl<'de> serde::Deserialize<'de> for Request {
                             }
                             acknowledge__ = map_.next_value()?;
                         }
+                        GeneratedField::TypeMapping => {
+                            if type_mapping__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("typeMapping"));
+                            }
+                            type_mapping__ = map_.next_value()?;
+                        }
                         GeneratedField::Internal => {
                             if internal__.is_some() {
                                 return Err(serde::de::Error::duplicate_field("$internal"));
@@ -531,6 +548,7 @@ impl<'de> serde::Deserialize<'de> for Request {
                     store: store__,
                     start_commit: start_commit__,
                     acknowledge: acknowledge__,
+                    type_mapping: type_mapping__,
                     internal: internal__.unwrap_or_default(),
                 })
             }
@@ -1526,6 +1544,97 @@ impl<'de> serde::Deserialize<'de> for request::Store {
         deserializer.deserialize_struct("materialize.Request.Store", FIELDS, GeneratedVisitor)
     }
 }
+impl serde::Serialize for request::TypeMapping {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if self.validate.is_some() {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("materialize.Request.TypeMapping", len)?;
+        if let Some(v) = self.validate.as_ref() {
+            struct_ser.serialize_field("validate", v)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for request::TypeMapping {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "validate",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            Validate,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "validate" => Ok(GeneratedField::Validate),
+                            _ => Err(serde::de::Error::unknown_field(value, FIELDS)),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = request::TypeMapping;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct materialize.Request.TypeMapping")
+            }
+
+            fn visit_map<V>(self, mut map_: V) -> std::result::Result<request::TypeMapping, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut validate__ = None;
+                while let Some(k) = map_.next_key()? {
+                    match k {
+                        GeneratedField::Validate => {
+                            if validate__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("validate"));
+                            }
+                            validate__ = map_.next_value()?;
+                        }
+                    }
+                }
+                Ok(request::TypeMapping {
+                    validate: validate__,
+                })
+            }
+        }
+        deserializer.deserialize_struct("materialize.Request.TypeMapping", FIELDS, GeneratedVisitor)
+    }
+}
 impl serde::Serialize for request::Validate {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
@@ -1888,6 +1997,9 @@ impl serde::Serialize for Response {
         if self.acknowledged.is_some() {
             len += 1;
         }
+        if self.type_mapped.is_some() {
+            len += 1;
+        }
         if !self.internal.is_empty() {
             len += 1;
         }
@@ -1916,6 +2028,9 @@ impl serde::Serialize for Response {
         if let Some(v) = self.acknowledged.as_ref() {
             struct_ser.serialize_field("acknowledged", v)?;
         }
+        if let Some(v) = self.type_mapped.as_ref() {
+            struct_ser.serialize_field("typeMapped", v)?;
+        }
         if !self.internal.is_empty() {
             #[allow(clippy::needless_borrow)]
             struct_ser.serialize_field("$internal", pbjson::private::base64::encode(&self.internal).as_str())?;
@@ -1939,6 +2054,8 @@ impl<'de> serde::Deserialize<'de> for Response {
             "started_commit",
             "startedCommit",
             "acknowledged",
+            "type_mapped",
+            "typeMapped",
             "internal",
             "$internal",
         ];
@@ -1953,6 +2070,7 @@ impl<'de> serde::Deserialize<'de> for Response {
             Flushed,
             StartedCommit,
             Acknowledged,
+            TypeMapped,
             Internal,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -1983,6 +2101,7 @@ impl<'de> serde::Deserialize<'de> for Response {
                             "flushed" => Ok(GeneratedField::Flushed),
                             "startedCommit" | "started_commit" => Ok(GeneratedField::StartedCommit),
                             "acknowledged" => Ok(GeneratedField::Acknowledged),
+                            "typeMapped" | "type_mapped" => Ok(GeneratedField::TypeMapped),
                             "$internal" | "internal" => Ok(GeneratedField::Internal),
                             _ => Err(serde::de::Error::unknown_field(value, FIELDS)),
                         }
@@ -2011,6 +2130,7 @@ impl<'de> serde::Deserialize<'de> for Response {
                 let mut flushed__ = None;
                 let mut started_commit__ = None;
                 let mut acknowledged__ = None;
+                let mut type_mapped__ = None;
                 let mut internal__ = None;
                 while let Some(k) = map_.next_key()? {
                     match k {
@@ -2062,6 +2182,12 @@ impl<'de> serde::Deserialize<'de> for Response {
                             }
                             acknowledged__ = map_.next_value()?;
                         }
+                        GeneratedField::TypeMapped => {
+                            if type_mapped__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("typeMapped"));
+                            }
+                            type_mapped__ = map_.next_value()?;
+                        }
                         GeneratedField::Internal => {
                             if internal__.is_some() {
                                 return Err(serde::de::Error::duplicate_field("$internal"));
@@ -2081,6 +2207,7 @@ impl<'de> serde::Deserialize<'de> for Response {
                     flushed: flushed__,
                     started_commit: started_commit__,
                     acknowledged: acknowledged__,
+                    type_mapped: type_mapped__,
                     internal: internal__.unwrap_or_default(),
                 })
             }
@@ -2820,6 +2947,299 @@ impl<'de> serde::Deserialize<'de> for response::StartedCommit {
         deserializer.deserialize_struct("materialize.Response.StartedCommit", FIELDS, GeneratedVisitor)
     }
 }
+impl serde::Serialize for response::TypeMapped {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if !self.bindings.is_empty() {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("materialize.Response.TypeMapped", len)?;
+        if !self.bindings.is_empty() {
+            struct_ser.serialize_field("bindings", &self.bindings)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for response::TypeMapped {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "bindings",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            Bindings,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "bindings" => Ok(GeneratedField::Bindings),
+                            _ => Err(serde::de::Error::unknown_field(value, FIELDS)),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = response::TypeMapped;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct materialize.Response.TypeMapped")
+            }
+
+            fn visit_map<V>(self, mut map_: V) -> std::result::Result<response::TypeMapped, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut bindings__ = None;
+                while let Some(k) = map_.next_key()? {
+                    match k {
+                        GeneratedField::Bindings => {
+                            if bindings__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("bindings"));
+                            }
+                            bindings__ = Some(map_.next_value()?);
+                        }
+                    }
+                }
+                Ok(response::TypeMapped {
+                    bindings: bindings__.unwrap_or_default(),
+                })
+            }
+        }
+        deserializer.deserialize_struct("materialize.Response.TypeMapped", FIELDS, GeneratedVisitor)
+    }
+}
+impl serde::Serialize for response::type_mapped::Binding {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if !self.mappings.is_empty() {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("materialize.Response.TypeMapped.Binding", len)?;
+        if !self.mappings.is_empty() {
+            struct_ser.serialize_field("mappings", &self.mappings)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for response::type_mapped::Binding {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "mappings",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            Mappings,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "mappings" => Ok(GeneratedField::Mappings),
+                            _ => Err(serde::de::Error::unknown_field(value, FIELDS)),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = response::type_mapped::Binding;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct materialize.Response.TypeMapped.Binding")
+            }
+
+            fn visit_map<V>(self, mut map_: V) -> std::result::Result<response::type_mapped::Binding, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut mappings__ = None;
+                while let Some(k) = map_.next_key()? {
+                    match k {
+                        GeneratedField::Mappings => {
+                            if mappings__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("mappings"));
+                            }
+                            mappings__ = Some(
+                                map_.next_value::<std::collections::BTreeMap<_, _>>()?
+                            );
+                        }
+                    }
+                }
+                Ok(response::type_mapped::Binding {
+                    mappings: mappings__.unwrap_or_default(),
+                })
+            }
+        }
+        deserializer.deserialize_struct("materialize.Response.TypeMapped.Binding", FIELDS, GeneratedVisitor)
+    }
+}
+impl serde::Serialize for response::type_mapped::Mapping {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if !self.column_type.is_empty() {
+            len += 1;
+        }
+        if !self.conversions.is_empty() {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("materialize.Response.TypeMapped.Mapping", len)?;
+        if !self.column_type.is_empty() {
+            struct_ser.serialize_field("columnType", &self.column_type)?;
+        }
+        if !self.conversions.is_empty() {
+            struct_ser.serialize_field("conversions", &self.conversions)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for response::type_mapped::Mapping {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "column_type",
+            "columnType",
+            "conversions",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            ColumnType,
+            Conversions,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "columnType" | "column_type" => Ok(GeneratedField::ColumnType),
+                            "conversions" => Ok(GeneratedField::Conversions),
+                            _ => Err(serde::de::Error::unknown_field(value, FIELDS)),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = response::type_mapped::Mapping;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct materialize.Response.TypeMapped.Mapping")
+            }
+
+            fn visit_map<V>(self, mut map_: V) -> std::result::Result<response::type_mapped::Mapping, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut column_type__ = None;
+                let mut conversions__ = None;
+                while let Some(k) = map_.next_key()? {
+                    match k {
+                        GeneratedField::ColumnType => {
+                            if column_type__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("columnType"));
+                            }
+                            column_type__ = Some(map_.next_value()?);
+                        }
+                        GeneratedField::Conversions => {
+                            if conversions__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("conversions"));
+                            }
+                            conversions__ = Some(map_.next_value()?);
+                        }
+                    }
+                }
+                Ok(response::type_mapped::Mapping {
+                    column_type: column_type__.unwrap_or_default(),
+                    conversions: conversions__.unwrap_or_default(),
+                })
+            }
+        }
+        deserializer.deserialize_struct("materialize.Response.TypeMapped.Mapping", FIELDS, GeneratedVisitor)
+    }
+}
 impl serde::Serialize for response::Validated {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
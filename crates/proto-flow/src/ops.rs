@@ -176,6 +176,15 @@ pub struct Stats {
     >,
     #[prost(message, optional, tag = "9")]
     pub interval: ::core::option::Option<stats::Interval>,
+    /// Per-binding metrics of a capture or materialization, keyed on the
+    /// state key of each binding. Unlike `capture` and `materialize`, which
+    /// are keyed on collection name, bindings of the same collection are
+    /// reported separately.
+    #[prost(btree_map = "string, message", tag = "10")]
+    pub bindings: ::prost::alloc::collections::BTreeMap<
+        ::prost::alloc::string::String,
+        stats::Binding,
+    >,
 }
 /// Nested message and enum types in `Stats`.
 pub mod stats {
@@ -230,6 +239,13 @@ pub mod stats {
             /// Input documents that were read by this transform.
             #[prost(message, optional, tag = "2")]
             pub input: ::core::option::Option<super::DocsAndBytes>,
+            /// Input documents which this transform failed to process, and skipped.
+            #[prost(message, optional, tag = "3")]
+            pub skipped: ::core::option::Option<super::DocsAndBytes>,
+            /// Input documents which this transform failed to process,
+            /// and published to its dead-letter collection.
+            #[prost(message, optional, tag = "4")]
+            pub dead_lettered: ::core::option::Option<super::DocsAndBytes>,
         }
     }
     /// Interval metrics are emitted at regular intervals.
@@ -250,6 +266,18 @@ pub mod stats {
         /// The choice of `usage_rate` MAY have more critera in the future.
         #[prost(float, tag = "2")]
         pub usage_rate: f32,
+        /// Bytes of accounted memory held by the task shard at the time of the
+        /// interval. Accounted memory includes combine buffers, read-ahead queues,
+        /// and connector network proxy buffers.
+        #[prost(uint64, tag = "3")]
+        pub memory_usage_bytes: u64,
+        /// Peak bytes of accounted memory held by the task shard over the interval.
+        #[prost(uint64, tag = "4")]
+        pub memory_peak_bytes: u64,
+        /// Limit of the task shard's accounted memory, in bytes.
+        /// Zero if the task shard's memory is unlimited.
+        #[prost(uint64, tag = "5")]
+        pub memory_limit_bytes: u64,
     }
 }
 /// The type of a catalog task.
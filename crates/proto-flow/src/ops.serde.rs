@@ -772,6 +772,9 @@ impl serde::Serialize for Stats {
         if self.interval.is_some() {
             len += 1;
         }
+        if !self.bindings.is_empty() {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("ops.Stats", len)?;
         if let Some(v) = self.meta.as_ref() {
             struct_ser.serialize_field("_meta", v)?;
@@ -800,6 +803,9 @@ impl serde::Serialize for Stats {
         if let Some(v) = self.interval.as_ref() {
             struct_ser.serialize_field("interval", v)?;
         }
+        if !self.bindings.is_empty() {
+            struct_ser.serialize_field("bindings", &self.bindings)?;
+        }
         struct_ser.end()
     }
 }
@@ -823,6 +829,7 @@ impl<'de> serde::Deserialize<'de> for Stats {
             "derive",
             "materialize",
             "interval",
+            "bindings",
         ];
 
         #[allow(clippy::enum_variant_names)]
@@ -836,6 +843,7 @@ impl<'de> serde::Deserialize<'de> for Stats {
             Derive,
             Materialize,
             Interval,
+            Bindings,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
             fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
@@ -866,6 +874,7 @@ impl<'de> serde::Deserialize<'de> for Stats {
                             "derive" => Ok(GeneratedField::Derive),
                             "materialize" => Ok(GeneratedField::Materialize),
                             "interval" => Ok(GeneratedField::Interval),
+                            "bindings" => Ok(GeneratedField::Bindings),
                             _ => Err(serde::de::Error::unknown_field(value, FIELDS)),
                         }
                     }
@@ -894,6 +903,7 @@ impl<'de> serde::Deserialize<'de> for Stats {
                 let mut derive__ = None;
                 let mut materialize__ = None;
                 let mut interval__ = None;
+                let mut bindings__ = None;
                 while let Some(k) = map_.next_key()? {
                     match k {
                         GeneratedField::Meta => {
@@ -958,6 +968,14 @@ impl<'de> serde::Deserialize<'de> for Stats {
                             }
                             interval__ = map_.next_value()?;
                         }
+                        GeneratedField::Bindings => {
+                            if bindings__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("bindings"));
+                            }
+                            bindings__ = Some(
+                                map_.next_value::<std::collections::BTreeMap<_, _>>()?
+                            );
+                        }
                     }
                 }
                 Ok(Stats {
@@ -970,6 +988,7 @@ impl<'de> serde::Deserialize<'de> for Stats {
                     derive: derive__,
                     materialize: materialize__.unwrap_or_default(),
                     interval: interval__,
+                    bindings: bindings__.unwrap_or_default(),
                 })
             }
         }
@@ -1242,6 +1261,12 @@ impl serde::Serialize for stats::derive::Transform {
         if self.input.is_some() {
             len += 1;
         }
+        if self.skipped.is_some() {
+            len += 1;
+        }
+        if self.dead_lettered.is_some() {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("ops.Stats.Derive.Transform", len)?;
         if !self.source.is_empty() {
             struct_ser.serialize_field("source", &self.source)?;
@@ -1249,6 +1274,12 @@ impl serde::Serialize for stats::derive::Transform {
         if let Some(v) = self.input.as_ref() {
             struct_ser.serialize_field("input", v)?;
         }
+        if let Some(v) = self.skipped.as_ref() {
+            struct_ser.serialize_field("skipped", v)?;
+        }
+        if let Some(v) = self.dead_lettered.as_ref() {
+            struct_ser.serialize_field("deadLettered", v)?;
+        }
         struct_ser.end()
     }
 }
@@ -1261,12 +1292,17 @@ impl<'de> serde::Deserialize<'de> for stats::derive::Transform {
         const FIELDS: &[&str] = &[
             "source",
             "input",
+            "skipped",
+            "dead_lettered",
+            "deadLettered",
         ];
 
         #[allow(clippy::enum_variant_names)]
         enum GeneratedField {
             Source,
             Input,
+            Skipped,
+            DeadLettered,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
             fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
@@ -1290,6 +1326,8 @@ impl<'de> serde::Deserialize<'de> for stats::derive::Transform {
                         match value {
                             "source" => Ok(GeneratedField::Source),
                             "input" => Ok(GeneratedField::Input),
+                            "skipped" => Ok(GeneratedField::Skipped),
+                            "deadLettered" | "dead_lettered" => Ok(GeneratedField::DeadLettered),
                             _ => Err(serde::de::Error::unknown_field(value, FIELDS)),
                         }
                     }
@@ -1311,6 +1349,8 @@ impl<'de> serde::Deserialize<'de> for stats::derive::Transform {
             {
                 let mut source__ = None;
                 let mut input__ = None;
+                let mut skipped__ = None;
+                let mut dead_lettered__ = None;
                 while let Some(k) = map_.next_key()? {
                     match k {
                         GeneratedField::Source => {
@@ -1325,11 +1365,25 @@ impl<'de> serde::Deserialize<'de> for stats::derive::Transform {
                             }
                             input__ = map_.next_value()?;
                         }
+                        GeneratedField::Skipped => {
+                            if skipped__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("skipped"));
+                            }
+                            skipped__ = map_.next_value()?;
+                        }
+                        GeneratedField::DeadLettered => {
+                            if dead_lettered__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("deadLettered"));
+                            }
+                            dead_lettered__ = map_.next_value()?;
+                        }
                     }
                 }
                 Ok(stats::derive::Transform {
                     source: source__.unwrap_or_default(),
                     input: input__,
+                    skipped: skipped__,
+                    dead_lettered: dead_lettered__,
                 })
             }
         }
@@ -1465,6 +1519,15 @@ impl serde::Serialize for stats::Interval {
         if self.usage_rate != 0. {
             len += 1;
         }
+        if self.memory_usage_bytes != 0 {
+            len += 1;
+        }
+        if self.memory_peak_bytes != 0 {
+            len += 1;
+        }
+        if self.memory_limit_bytes != 0 {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("ops.Stats.Interval", len)?;
         if self.uptime_seconds != 0 {
             struct_ser.serialize_field("uptimeSeconds", &self.uptime_seconds)?;
@@ -1472,6 +1535,18 @@ impl serde::Serialize for stats::Interval {
         if self.usage_rate != 0. {
             struct_ser.serialize_field("usageRate", &self.usage_rate)?;
         }
+        if self.memory_usage_bytes != 0 {
+            #[allow(clippy::needless_borrow)]
+            struct_ser.serialize_field("memoryUsageBytes", &self.memory_usage_bytes)?;
+        }
+        if self.memory_peak_bytes != 0 {
+            #[allow(clippy::needless_borrow)]
+            struct_ser.serialize_field("memoryPeakBytes", &self.memory_peak_bytes)?;
+        }
+        if self.memory_limit_bytes != 0 {
+            #[allow(clippy::needless_borrow)]
+            struct_ser.serialize_field("memoryLimitBytes", &self.memory_limit_bytes)?;
+        }
         struct_ser.end()
     }
 }
@@ -1486,12 +1561,21 @@ impl<'de> serde::Deserialize<'de> for stats::Interval {
             "uptimeSeconds",
             "usage_rate",
             "usageRate",
+            "memory_usage_bytes",
+            "memoryUsageBytes",
+            "memory_peak_bytes",
+            "memoryPeakBytes",
+            "memory_limit_bytes",
+            "memoryLimitBytes",
         ];
 
         #[allow(clippy::enum_variant_names)]
         enum GeneratedField {
             UptimeSeconds,
             UsageRate,
+            MemoryUsageBytes,
+            MemoryPeakBytes,
+            MemoryLimitBytes,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
             fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
@@ -1515,6 +1599,9 @@ impl<'de> serde::Deserialize<'de> for stats::Interval {
                         match value {
                             "uptimeSeconds" | "uptime_seconds" => Ok(GeneratedField::UptimeSeconds),
                             "usageRate" | "usage_rate" => Ok(GeneratedField::UsageRate),
+                            "memoryUsageBytes" | "memory_usage_bytes" => Ok(GeneratedField::MemoryUsageBytes),
+                            "memoryPeakBytes" | "memory_peak_bytes" => Ok(GeneratedField::MemoryPeakBytes),
+                            "memoryLimitBytes" | "memory_limit_bytes" => Ok(GeneratedField::MemoryLimitBytes),
                             _ => Err(serde::de::Error::unknown_field(value, FIELDS)),
                         }
                     }
@@ -1536,6 +1623,9 @@ impl<'de> serde::Deserialize<'de> for stats::Interval {
             {
                 let mut uptime_seconds__ = None;
                 let mut usage_rate__ = None;
+                let mut memory_usage_bytes__ = None;
+                let mut memory_peak_bytes__ = None;
+                let mut memory_limit_bytes__ = None;
                 while let Some(k) = map_.next_key()? {
                     match k {
                         GeneratedField::UptimeSeconds => {
@@ -1554,11 +1644,38 @@ impl<'de> serde::Deserialize<'de> for stats::Interval {
                                 Some(map_.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
                             ;
                         }
+                        GeneratedField::MemoryUsageBytes => {
+                            if memory_usage_bytes__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("memoryUsageBytes"));
+                            }
+                            memory_usage_bytes__ = 
+                                Some(map_.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::MemoryPeakBytes => {
+                            if memory_peak_bytes__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("memoryPeakBytes"));
+                            }
+                            memory_peak_bytes__ = 
+                                Some(map_.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::MemoryLimitBytes => {
+                            if memory_limit_bytes__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("memoryLimitBytes"));
+                            }
+                            memory_limit_bytes__ = 
+                                Some(map_.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
                     }
                 }
                 Ok(stats::Interval {
                     uptime_seconds: uptime_seconds__.unwrap_or_default(),
                     usage_rate: usage_rate__.unwrap_or_default(),
+                    memory_usage_bytes: memory_usage_bytes__.unwrap_or_default(),
+                    memory_peak_bytes: memory_peak_bytes__.unwrap_or_default(),
+                    memory_limit_bytes: memory_limit_bytes__.unwrap_or_default(),
                 })
             }
         }
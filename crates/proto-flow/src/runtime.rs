@@ -182,6 +182,8 @@ pub struct CaptureResponseExt {
     pub captured: ::core::option::Option<capture_response_ext::Captured>,
     #[prost(message, optional, tag = "4")]
     pub checkpoint: ::core::option::Option<capture_response_ext::Checkpoint>,
+    #[prost(message, optional, tag = "5")]
+    pub keepalive: ::core::option::Option<capture_response_ext::Keepalive>,
 }
 /// Nested message and enum types in `CaptureResponseExt`.
 pub mod capture_response_ext {
@@ -211,6 +213,11 @@ pub mod capture_response_ext {
         #[prost(enumeration = "PollResult", tag = "2")]
         pub poll_result: i32,
     }
+    /// Keepalive is sent by connector-init at the interval requested by the
+    /// runtime, and is not forwarded to the client of the runtime.
+    #[allow(clippy::derive_partial_eq_without_eq)]
+    #[derive(Clone, PartialEq, ::prost::Message)]
+    pub struct Keepalive {}
     #[derive(
         Clone,
         Copy,
@@ -273,6 +280,8 @@ pub struct DeriveRequestExt {
     pub rocksdb_descriptor: ::core::option::Option<RocksDbDescriptor>,
     #[prost(message, optional, tag = "3")]
     pub open: ::core::option::Option<derive_request_ext::Open>,
+    #[prost(message, optional, tag = "4")]
+    pub query: ::core::option::Option<derive_request_ext::Query>,
 }
 /// Nested message and enum types in `DeriveRequestExt`.
 pub mod derive_request_ext {
@@ -283,6 +292,25 @@ pub mod derive_request_ext {
         #[prost(string, tag = "1")]
         pub sqlite_vfs_uri: ::prost::alloc::string::String,
     }
+    /// Query is a read-only query of the SQLite state of the derivation,
+    /// which is sent between transactions. The connector runs the query
+    /// and responds with DeriveResponseExt.Queried.
+    #[allow(clippy::derive_partial_eq_without_eq)]
+    #[derive(Clone, PartialEq, ::prost::Message)]
+    pub struct Query {
+        /// SQL query to execute.
+        #[prost(string, tag = "1")]
+        pub sql: ::prost::alloc::string::String,
+        /// JSON-encoded array of parameters bound to the query.
+        #[prost(string, tag = "2")]
+        pub params_json: ::prost::alloc::string::String,
+        /// Duration, in milliseconds, after which the query is interrupted.
+        #[prost(uint32, tag = "3")]
+        pub timeout_ms: u32,
+        /// Maximum number of rows which the query may return.
+        #[prost(uint32, tag = "4")]
+        pub max_rows: u32,
+    }
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -295,6 +323,8 @@ pub struct DeriveResponseExt {
     pub published: ::core::option::Option<derive_response_ext::Published>,
     #[prost(message, optional, tag = "4")]
     pub flushed: ::core::option::Option<derive_response_ext::Flushed>,
+    #[prost(message, optional, tag = "5")]
+    pub queried: ::core::option::Option<derive_response_ext::Queried>,
 }
 /// Nested message and enum types in `DeriveResponseExt`.
 pub mod derive_response_ext {
@@ -318,6 +348,11 @@ pub mod derive_response_ext {
         /// Packed partition values extracted from the published document.
         #[prost(bytes = "bytes", tag = "3")]
         pub partitions_packed: ::prost::bytes::Bytes,
+        /// Dead-letter collection of a source document which a transform failed
+        /// to process. When set, this document is published to the dead-letter
+        /// collection rather than the derived collection.
+        #[prost(string, tag = "4")]
+        pub dead_letter: ::prost::alloc::string::String,
     }
     #[allow(clippy::derive_partial_eq_without_eq)]
     #[derive(Clone, PartialEq, ::prost::Message)]
@@ -325,6 +360,20 @@ pub mod derive_response_ext {
         #[prost(message, optional, tag = "1")]
         pub stats: ::core::option::Option<super::super::ops::Stats>,
     }
+    #[allow(clippy::derive_partial_eq_without_eq)]
+    #[derive(Clone, PartialEq, ::prost::Message)]
+    pub struct Queried {
+        /// Columns of the query result.
+        #[prost(string, repeated, tag = "1")]
+        pub columns: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+        /// JSON-encoded array of result rows, each an array of column values.
+        #[prost(string, tag = "2")]
+        pub rows_json: ::prost::alloc::string::String,
+        /// Error of the query, if it failed.
+        /// A failed query doesn't fail the derivation.
+        #[prost(string, tag = "3")]
+        pub error: ::prost::alloc::string::String,
+    }
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -343,6 +392,8 @@ pub struct MaterializeResponseExt {
     pub container: ::core::option::Option<Container>,
     #[prost(message, optional, tag = "2")]
     pub flushed: ::core::option::Option<materialize_response_ext::Flushed>,
+    #[prost(message, optional, tag = "3")]
+    pub keepalive: ::core::option::Option<materialize_response_ext::Keepalive>,
 }
 /// Nested message and enum types in `MaterializeResponseExt`.
 pub mod materialize_response_ext {
@@ -352,6 +403,11 @@ pub mod materialize_response_ext {
         #[prost(message, optional, tag = "1")]
         pub stats: ::core::option::Option<super::super::ops::Stats>,
     }
+    /// Keepalive is sent by connector-init at the interval requested by the
+    /// runtime, and is not forwarded to the client of the runtime.
+    #[allow(clippy::derive_partial_eq_without_eq)]
+    #[derive(Clone, PartialEq, ::prost::Message)]
+    pub struct Keepalive {}
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
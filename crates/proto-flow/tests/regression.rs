@@ -723,6 +723,8 @@ fn ex_stats() -> ops::Stats {
                             docs_total: 12,
                             bytes_total: 369,
                         }),
+                        skipped: None,
+                        dead_lettered: None,
                     },
                 ),
                 (
@@ -733,6 +735,8 @@ fn ex_stats() -> ops::Stats {
                             docs_total: 52,
                             bytes_total: 2389,
                         }),
+                        skipped: None,
+                        dead_lettered: None,
                     },
                 ),
             ]
@@ -767,7 +771,25 @@ fn ex_stats() -> ops::Stats {
         interval: Some(ops::stats::Interval {
             uptime_seconds: 300,
             usage_rate: 1.5,
+            memory_usage_bytes: 1 << 20,
+            memory_peak_bytes: 2 << 20,
+            memory_limit_bytes: 4 << 20,
         }),
+        bindings: [(
+            "my-table.v1".to_string(),
+            ops::stats::Binding {
+                left: None,
+                right: Some(ops::stats::DocsAndBytes {
+                    docs_total: 2,
+                    bytes_total: 20,
+                }),
+                out: Some(ops::stats::DocsAndBytes {
+                    docs_total: 1,
+                    bytes_total: 10,
+                }),
+            },
+        )]
+        .into(),
     }
 }
 
@@ -25,13 +25,20 @@ pub async fn start<L: LogHandler>(
         });
     }
 
+    fn is_keepalive(response: &Response) -> bool {
+        matches!(
+            response.get_internal(),
+            Ok(ext) if ext.keepalive.is_some()
+        )
+    }
+
     fn start_rpc(
         channel: tonic::transport::Channel,
-        rx: mpsc::Receiver<Request>,
+        rx: tonic::Request<mpsc::Receiver<Request>>,
     ) -> crate::image_connector::StartRpcFuture<Response> {
         async move {
             proto_grpc::capture::connector_client::ConnectorClient::new(channel)
-                .max_decoding_message_size(crate::MAX_MESSAGE_SIZE)
+                .max_decoding_message_size(crate::max_message_size())
                 .max_encoding_message_size(usize::MAX)
                 .capture(rx)
                 .await
@@ -43,6 +50,9 @@ pub async fn start<L: LogHandler>(
         models::CaptureEndpoint::Connector(models::ConnectorConfig {
             image,
             config: sealed_config,
+            env,
+            mounts,
+            tmpfs,
         }) => {
             *config_json = unseal::decrypt_sops(&sealed_config).await?.to_string();
             connector_tx.try_send(initial).unwrap();
@@ -50,6 +60,8 @@ pub async fn start<L: LogHandler>(
             crate::image_connector::serve(
                 attach_container,
                 image,
+                crate::container::Injections { env, mounts, tmpfs },
+                Some(is_keepalive),
                 runtime.log_handler.clone(),
                 log_level,
                 &runtime.container_network,
@@ -91,6 +103,19 @@ pub async fn start<L: LogHandler>(
     Ok((connector_tx, connector_rx))
 }
 
+// Determine whether the connector of the `request` advertises range splitting.
+// Only image connectors may advertise it, through a label of their image.
+pub async fn range_splitting(request: &Request) -> anyhow::Result<bool> {
+    let mut request = request.clone();
+
+    match extract_endpoint(&mut request)?.0 {
+        models::CaptureEndpoint::Connector(models::ConnectorConfig { image, .. }) => {
+            crate::container::range_splitting(&image).await
+        }
+        models::CaptureEndpoint::Local(_) => Ok(false),
+    }
+}
+
 fn extract_endpoint<'r>(
     request: &'r mut Request,
 ) -> anyhow::Result<(models::CaptureEndpoint, &'r mut String)> {
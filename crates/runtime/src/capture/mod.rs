@@ -5,6 +5,7 @@ use std::collections::{BTreeMap, BTreeSet};
 
 mod connector;
 mod protocol;
+mod ranges;
 mod serve;
 mod task;
 
@@ -24,6 +25,8 @@ pub struct Task {
     restart: tokio::time::Instant,
     // ShardRef of this task.
     shard_ref: ops::ShardRef,
+    // Scope of connector states, if the connector advertises range splitting.
+    state_scope: Option<String>,
 }
 
 #[derive(Debug, Clone)]
@@ -40,6 +43,11 @@ struct Binding {
     resource_path: Vec<String>,
     // Serialization policy for the Target collection.
     ser_policy: doc::SerPolicy,
+    // State key of this binding, which keys its stats.
+    state_key: String,
+    // JSON pointer of a synthetic key which is populated with a random UUID,
+    // if the collection is keyed on models::Schema::SYNTHETIC_KEY_PTR.
+    synthetic_key_ptr: Option<doc::Pointer>,
     // Write schema of the target collection.
     write_schema_json: String,
 }
@@ -1,4 +1,4 @@
-use super::{Task, Transaction};
+use super::{ranges, Binding, Task, Transaction};
 use crate::{rocksdb::RocksDB, verify};
 use anyhow::Context;
 use prost::Message;
@@ -82,21 +82,53 @@ pub fn recv_connector_unary(request: Request, response: Response) -> anyhow::Res
     }
 }
 
-pub async fn recv_client_open(open: &mut Request, db: &RocksDB) -> anyhow::Result<()> {
+pub async fn recv_client_open(
+    open: &mut Request,
+    db: &RocksDB,
+    range_splitting: bool,
+) -> anyhow::Result<()> {
     let Some(open) = open.open.as_mut() else {
         return verify("client", "Open").fail(open);
     };
+    let Some(range) = open.range.clone() else {
+        return verify("client", "Open.Range").fail(open);
+    };
     let Some(capture) = open.capture.as_mut() else {
         return verify("client", "Open.Capture").fail(open);
     };
 
-    open.state_json = db
+    let state = db
         .load_connector_state(
             models::RawValue::from_str(&open.state_json)
                 .context("failed to parse initial open connector state")?,
         )
-        .await?
-        .into();
+        .await?;
+
+    if range_splitting {
+        // Open the connector with the state of the shard's key range.
+        let scope = ranges::scope(&range);
+        let (selected, seeded) = ranges::select(state.get(), &scope)?;
+
+        if let Some(seeded) = seeded {
+            let mut wb = rocksdb::WriteBatch::default();
+            wb.put(RocksDB::CONNECTOR_STATE_KEY, &seeded);
+
+            db.write_opt(wb, Default::default())
+                .await
+                .context("failed to seed connector state of the shard key range")?;
+
+            tracing::info!(%scope, "seeded connector state of the shard key range");
+        }
+        open.state_json = selected;
+    } else {
+        if ranges::is_partial(&range) {
+            tracing::warn!(
+                ?range,
+                "capture shard covers a portion of the key range, but its connector doesn't advertise range splitting",
+            );
+        }
+        open.state_json = state.into();
+    }
 
     // TODO(johnny): Switch to erroring if `state_key` is not already populated.
     for binding in capture.bindings.iter_mut() {
@@ -110,6 +142,7 @@ pub async fn recv_connector_opened(
     db: &RocksDB,
     open: Request,
     opened: Option<Response>,
+    range_splitting: bool,
     shapes_by_key: &mut BTreeMap<String, doc::Shape>,
 ) -> anyhow::Result<(
     Task,
@@ -121,7 +154,7 @@ pub async fn recv_connector_opened(
 )> {
     let mut opened = verify("connecter", "Opened").not_eof(opened)?;
 
-    let task = Task::new(&open, &opened)?;
+    let task = Task::new(&open, &opened, range_splitting)?;
     // Inferred document shapes, indexed by binding offset.
     let shapes = task.binding_shapes_by_index(std::mem::take(shapes_by_key));
 
@@ -256,18 +289,22 @@ pub fn send_client_final_checkpoint(
     txn: &Transaction,
 ) -> Response {
     let mut capture = BTreeMap::<String, ops::stats::Binding>::new();
+    let mut bindings = BTreeMap::<String, ops::stats::Binding>::new();
 
     for (index, binding_stats) in txn.stats.iter() {
-        let index = *index as usize;
-        let entry = capture
-            .entry(task.bindings[index].collection_name.clone())
-            .or_default();
-
-        ops::merge_docs_and_bytes(&binding_stats.0, &mut entry.right);
-        ops::merge_docs_and_bytes(&binding_stats.1, &mut entry.out);
+        let binding = &task.bindings[*index as usize];
+
+        for entry in [
+            capture.entry(binding.collection_name.clone()).or_default(),
+            bindings.entry(binding.state_key.clone()).or_default(),
+        ] {
+            ops::merge_docs_and_bytes(&binding_stats.0, &mut entry.right);
+            ops::merge_docs_and_bytes(&binding_stats.1, &mut entry.out);
+        }
     }
 
     let stats = ops::Stats {
+        bindings,
         capture,
         derive: None,
         interval: None,
@@ -368,17 +405,30 @@ pub fn recv_connector_captured(
         .parse_json_str(&doc_json)
         .context("couldn't parse captured document as JSON")?;
 
-    let uuid_ptr = &task
+    let Binding {
+        document_uuid_ptr: uuid_ptr,
+        synthetic_key_ptr,
+        ..
+    } = task
         .bindings
         .get(binding as usize)
-        .with_context(|| "invalid captured binding {binding}")?
-        .document_uuid_ptr;
+        .with_context(|| "invalid captured binding {binding}")?;
 
     if !uuid_ptr.0.is_empty() {
         if let Some(node) = uuid_ptr.create_heap_node(&mut doc, alloc) {
             *node = doc::HeapNode::String(doc::BumpStr::from_str(crate::UUID_PLACEHOLDER, alloc));
         }
     }
+    // Generate a synthetic key, unless the connector provided one.
+    if let Some(key_ptr) = synthetic_key_ptr {
+        match key_ptr.create_heap_node(&mut doc, alloc) {
+            Some(node) if matches!(node, doc::HeapNode::Null) => {
+                let key = uuid::Uuid::new_v4().to_string();
+                *node = doc::HeapNode::String(doc::BumpStr::from_str(&key, alloc));
+            }
+            _ => (),
+        }
+    }
     memtable.add(binding, doc, false)?;
 
     let stats = txn.stats.entry(binding).or_default();
@@ -404,6 +454,12 @@ pub fn recv_connector_checkpoint(
         merge_patch,
     } = state;
 
+    // Scope the update to the state of the shard's key range.
+    let updated_json = match &task.state_scope {
+        Some(scope) => ranges::wrap(scope, &updated_json),
+        None => updated_json,
+    };
+
     let memtable = accumulator.memtable()?;
     let doc = memtable
         .parse_json_str(&updated_json)
@@ -411,7 +467,13 @@ pub fn recv_connector_checkpoint(
 
     // Combine over the checkpoint state.
     if !merge_patch {
-        memtable.add(task.bindings.len() as u32, doc::HeapNode::Null, false)?;
+        let reset = match &task.state_scope {
+            Some(scope) => memtable
+                .parse_json_str(&ranges::wrap(scope, "null"))
+                .context("couldn't parse connector state reset as JSON")?,
+            None => doc::HeapNode::Null,
+        };
+        memtable.add(task.bindings.len() as u32, reset, false)?;
     }
     memtable.add(task.bindings.len() as u32, doc, false)?;
 
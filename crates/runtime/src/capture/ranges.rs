@@ -0,0 +1,182 @@
+//! Range-splitting connectors capture only the portion of each binding which
+//! falls within the key range of their shard. A capture of such a connector may
+//! be split on key into many shards, which then backfill a large binding in
+//! parallel rather than through one serial stream. Each shard begins from the
+//! connector state of its parent, as it was at the time of the split.
+//!
+//! Connector states of range-splitting connectors are scoped by the key range
+//! of their shard: the state of a range is persisted under
+//! `/flowRangeStates/{key-begin}-{key-end}` of the connector state, and
+//! connector checkpoints patch only the state of their own range. States of
+//! other ranges, such as that of a parent prior to its split, are left as-is.
+//! This keeps checkpoints of sibling ranges from clobbering one another when
+//! their states are composed under JSON merge-patch.
+use anyhow::Context;
+use proto_flow::flow;
+
+// Property of the persisted connector state under which range states are keyed.
+const RANGE_STATES: &str = "flowRangeStates";
+
+/// Scope of the connector state of a shard having `range`.
+pub fn scope(range: &flow::RangeSpec) -> String {
+    format!("{:08x}-{:08x}", range.key_begin, range.key_end)
+}
+
+/// Returns true if `range` covers only a portion of the full key range.
+pub fn is_partial(range: &flow::RangeSpec) -> bool {
+    range.key_begin != 0 || range.key_end != u32::MAX
+}
+
+/// Select the connector state of `scope` from a persisted connector `state`.
+///
+/// If `state` has no state of `scope`, then one is inherited from the narrowest
+/// range which covers `scope` or, if there is none, from the unscoped remainder
+/// of `state`. An inherited state is also returned as an updated persisted state,
+/// which seeds the state of `scope` so that later merge-patch checkpoints of the
+/// connector apply to the state it was opened with.
+pub fn select(state: &str, scope: &str) -> anyhow::Result<(String, Option<String>)> {
+    let mut state: serde_json::Value =
+        serde_json::from_str(state).context("parsing persisted connector state")?;
+    let (begin, end) = parse_scope(scope).context("invalid connector state scope")?;
+
+    let covering = match state.get(RANGE_STATES).and_then(|v| v.as_object()) {
+        Some(states) => {
+            if let Some(own) = states.get(scope) {
+                return Ok((own.to_string(), None));
+            }
+            states
+                .iter()
+                .filter_map(|(key, state)| parse_scope(key).map(|range| (range, state)))
+                .filter(|((b, e), _)| *b <= begin && end <= *e)
+                .min_by_key(|((b, e), _)| e - b)
+                .map(|(_, state)| state.clone())
+        }
+        None => None,
+    };
+
+    let inherited = match covering {
+        Some(inherited) => inherited,
+        None => {
+            let mut remainder = state.clone();
+            if let Some(remainder) = remainder.as_object_mut() {
+                remainder.remove(RANGE_STATES);
+            }
+            remainder
+        }
+    };
+
+    if !state.is_object() {
+        state = serde_json::Value::Object(Default::default());
+    }
+    let root = state.as_object_mut().unwrap();
+
+    let states = root
+        .entry(RANGE_STATES)
+        .or_insert_with(|| serde_json::Value::Object(Default::default()));
+    if !states.is_object() {
+        *states = serde_json::Value::Object(Default::default());
+    }
+    states
+        .as_object_mut()
+        .unwrap()
+        .insert(scope.to_string(), inherited.clone());
+
+    Ok((inherited.to_string(), Some(state.to_string())))
+}
+
+/// Wrap a connector state update `doc_json` into an update of the state of `scope`.
+pub fn wrap(scope: &str, doc_json: &str) -> String {
+    format!(r#"{{"{RANGE_STATES}":{{"{scope}":{doc_json}}}}}"#)
+}
+
+fn parse_scope(scope: &str) -> Option<(u32, u32)> {
+    let (begin, end) = scope.split_once('-')?;
+    Some((
+        u32::from_str_radix(begin, 16).ok()?,
+        u32::from_str_radix(end, 16).ok()?,
+    ))
+}
+
+#[cfg(test)]
+mod test {
+    use super::*;
+    use serde_json::json;
+
+    #[test]
+    fn test_scoping_range_states() {
+        let full = scope(&flow::RangeSpec {
+            key_begin: 0,
+            key_end: u32::MAX,
+            ..Default::default()
+        });
+        let lhs = scope(&flow::RangeSpec {
+            key_begin: 0,
+            key_end: 0x7fffffff,
+            ..Default::default()
+        });
+        let rhs = scope(&flow::RangeSpec {
+            key_begin: 0x80000000,
+            key_end: u32::MAX,
+            ..Default::default()
+        });
+        assert_eq!(lhs, "00000000-7fffffff");
+
+        let select = |state: serde_json::Value, scope: &str| {
+            let (selected, seeded) = select(&state.to_string(), scope).unwrap();
+            (
+                serde_json::from_str::<serde_json::Value>(&selected).unwrap(),
+                seeded.map(|s| serde_json::from_str::<serde_json::Value>(&s).unwrap()),
+            )
+        };
+
+        // An unscoped state is inherited and seeded.
+        let legacy = json!({"cursor": 42});
+        assert_eq!(
+            select(legacy.clone(), &full),
+            (
+                json!({"cursor": 42}),
+                Some(json!({"cursor": 42, "flowRangeStates": {full.clone(): {"cursor": 42}}})),
+            )
+        );
+
+        // A state of the exact scope is selected as-is.
+        let scoped = json!({
+            "cursor": 42,
+            "flowRangeStates": {full.clone(): {"cursor": 52}},
+        });
+        assert_eq!(select(scoped.clone(), &full), (json!({"cursor": 52}), None));
+
+        // Children of a split inherit from their parent.
+        let (selected, seeded) = select(scoped.clone(), &rhs);
+        assert_eq!(selected, json!({"cursor": 52}));
+        assert_eq!(
+            seeded.unwrap(),
+            json!({
+                "cursor": 42,
+                "flowRangeStates": {full.clone(): {"cursor": 52}, rhs.clone(): {"cursor": 52}},
+            })
+        );
+
+        // The narrowest covering range is preferred.
+        let nested = json!({
+            "flowRangeStates": {
+                full.clone(): {"cursor": 52},
+                lhs.clone(): {"cursor": 62},
+            },
+        });
+        let (selected, _) = select(nested.clone(), "00000000-3fffffff");
+        assert_eq!(selected, json!({"cursor": 62}));
+
+        // Ranges which don't cover the scope aren't inherited.
+        let (selected, _) = select(
+            json!({"flowRangeStates": {lhs.clone(): {"cursor": 62}}}),
+            &rhs,
+        );
+        assert_eq!(selected, json!({}));
+
+        assert_eq!(
+            wrap(&lhs, r#"{"cursor":72}"#),
+            r#"{"flowRangeStates":{"00000000-7fffffff":{"cursor":72}}}"#
+        );
+    }
+}
@@ -70,9 +70,22 @@ async fn serve_unary<L: LogHandler>(
     std::mem::drop(connector_tx); // Send EOF.
 
     let verify = verify("connector", "unary response");
-    let response = verify.not_eof(connector_rx.try_next().await?)?;
-    () = co.yield_(recv_connector_unary(request, response)?).await;
-    () = verify.is_eof(connector_rx.try_next().await?)?;
+    let mut response = verify.not_eof(connector_rx.try_next().await?)?;
+
+    if request.discover.is_some() {
+        // Discovered may be sent as a sequence of responses, each having a
+        // chunk of bindings, which are forwarded as they're received.
+        while let Some(next) = connector_rx.try_next().await? {
+            () = co
+                .yield_(recv_connector_unary(request.clone(), response)?)
+                .await;
+            response = next;
+        }
+        () = co.yield_(recv_connector_unary(request, response)?).await;
+    } else {
+        () = co.yield_(recv_connector_unary(request, response)?).await;
+        () = verify.is_eof(connector_rx.try_next().await?)?;
+    }
 
     if !wb.is_empty() {
         db.write_opt(wb, Default::default()).await?;
@@ -89,14 +102,15 @@ async fn serve_session<L: LogHandler>(
     runtime: &Runtime<L>,
     shapes_by_key: &mut BTreeMap<String, doc::Shape>,
 ) -> anyhow::Result<Option<Request>> {
-    recv_client_open(&mut open, &db).await?;
+    let range_splitting = connector::range_splitting(&open).await?;
+    recv_client_open(&mut open, &db, range_splitting).await?;
 
     // Start connector stream and read Opened.
     let (mut connector_tx, mut connector_rx) = connector::start(runtime, open.clone()).await?;
     let opened = TryStreamExt::try_next(&mut connector_rx).await?;
 
     let (task, task_clone, mut shapes, accumulator, mut next_accumulator, opened) =
-        recv_connector_opened(db, open, opened, shapes_by_key).await?;
+        recv_connector_opened(db, open, opened, range_splitting, shapes_by_key).await?;
 
     () = co.yield_(opened).await;
 
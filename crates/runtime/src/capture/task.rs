@@ -5,7 +5,7 @@ use proto_flow::flow;
 use std::collections::BTreeMap;
 
 impl Task {
-    pub fn new(open: &Request, opened: &Response) -> anyhow::Result<Self> {
+    pub fn new(open: &Request, opened: &Response, range_splitting: bool) -> anyhow::Result<Self> {
         let request::Open {
             capture: spec,
             range,
@@ -51,11 +51,14 @@ impl Task {
             r_clock_begin: format!("{:08x}", range.r_clock_begin),
         };
 
+        let state_scope = range_splitting.then(|| super::ranges::scope(&range));
+
         Ok(Self {
             bindings,
             explicit_acknowledgements,
             restart,
             shard_ref,
+            state_scope,
         })
     }
 
@@ -121,7 +124,7 @@ impl Binding {
             collection,
             resource_config_json: _,
             resource_path,
-            state_key: _,
+            state_key,
         } = spec;
 
         let flow::CollectionSpec {
@@ -138,6 +141,10 @@ impl Binding {
         } = collection.as_ref().context("missing collection")?;
 
         let document_uuid_ptr = doc::Pointer::from(uuid_ptr);
+        let synthetic_key_ptr = key
+            .iter()
+            .any(|k| k == models::Schema::SYNTHETIC_KEY_PTR)
+            .then(|| doc::Pointer::from(models::Schema::SYNTHETIC_KEY_PTR));
         let key_extractors = extractors::for_key(&key, &projections, &ser_policy)?;
         let partition_extractors =
             extractors::for_fields(&partition_fields, &projections, &ser_policy)?;
@@ -149,6 +156,8 @@ impl Binding {
             partition_extractors,
             resource_path: resource_path.clone(),
             ser_policy,
+            state_key: state_key.clone(),
+            synthetic_key_ptr,
             write_schema_json: write_schema_json.clone(),
         })
     }
@@ -12,6 +12,326 @@ use tokio::io::AsyncBufReadExt;
 // connectors.
 const CONNECTOR_INIT_PORT: u16 = 49092;
 
+// Environment variables which configure keepalives of connector channels,
+// in integer milliseconds. They're set by the Go runtime from its flags.
+const KEEPALIVE_INTERVAL_ENV: &str = "FLOW_RUNTIME_CONNECTOR_KEEPALIVE_MS";
+const KEEPALIVE_TIMEOUT_ENV: &str = "FLOW_RUNTIME_CONNECTOR_KEEPALIVE_TIMEOUT_MS";
+
+// Environment variable which overrides the host directory of temporary files
+// which are bind-mounted into connector containers. It must be a directory
+// which the Docker daemon is able to share with containers.
+const TEMP_DIR_ENV: &str = "FLOW_RUNTIME_CONNECTOR_TMPDIR";
+
+// Environment variables which configure the allowlist of environment variables,
+// mounts, and tmpfs filesystems which tasks may inject into connector containers.
+// They're set by the Go runtime from its flags, or by the caller's environment.
+const ALLOW_ENV_ENV: &str = "FLOW_CONNECTOR_ALLOW_ENV";
+const ALLOW_MOUNTS_ENV: &str = "FLOW_CONNECTOR_ALLOW_MOUNTS";
+const MAX_TMPFS_ENV: &str = "FLOW_CONNECTOR_MAX_TMPFS";
+
+// Environment variable which selects the address family of connector networking.
+// It's set by the Go runtime from its flags, and is also passed through to the
+// container, where it's read by flow-network-tunnel.
+const ADDRESS_FAMILY_ENV: &str = "FLOW_CONNECTOR_ADDRESS_FAMILY";
+
+// Image label through which a capture connector advertises that it splits
+// its capture across the key range of its shard.
+const RANGE_SPLITTING_LABEL: &str = "dev.estuary.range-splitting";
+
+// Paths within the container which are used by the runtime itself.
+const RESERVED_TARGETS: &[&str] = &["/flow-connector-init", "/image-inspect.json"];
+// Environment variables of the container which are set by the runtime itself.
+const RESERVED_ENV: &[&str] = &["LOG_FORMAT", "LOG_LEVEL", ADDRESS_FAMILY_ENV];
+
+/// Injections are environment variables, read-only mounts, and tmpfs
+/// filesystems which a task declares for its connector container.
+#[derive(Debug, Default, Clone)]
+pub struct Injections {
+    pub env: BTreeMap<String, String>,
+    pub mounts: Vec<models::ConnectorMount>,
+    pub tmpfs: Vec<models::ConnectorTmpfs>,
+}
+
+/// Allowlist of the Injections which are permitted by the operator of this process.
+/// By default, nothing may be injected.
+#[derive(Debug, Default, Clone, PartialEq)]
+pub struct Allowlist {
+    /// Names of permitted environment variables.
+    /// A name ending in '*' permits all variables having its prefix.
+    pub env: Vec<String>,
+    /// Host directories within which mount sources are permitted.
+    pub mounts: Vec<std::path::PathBuf>,
+    /// Maximum total size of tmpfs filesystems, in megabytes.
+    pub max_tmpfs: u64,
+}
+
+impl Allowlist {
+    /// Build an Allowlist from the process environment.
+    pub fn from_env() -> Self {
+        Self::parse(
+            std::env::var(ALLOW_ENV_ENV).ok().as_deref(),
+            std::env::var(ALLOW_MOUNTS_ENV).ok().as_deref(),
+            std::env::var(MAX_TMPFS_ENV).ok().as_deref(),
+        )
+    }
+
+    fn parse(env: Option<&str>, mounts: Option<&str>, max_tmpfs: Option<&str>) -> Self {
+        let split = |s: Option<&str>| {
+            s.unwrap_or_default()
+                .split(',')
+                .map(str::trim)
+                .filter(|s| !s.is_empty())
+                .map(str::to_string)
+                .collect::<Vec<_>>()
+        };
+        let max_tmpfs = match max_tmpfs.map(str::parse::<u64>) {
+            Some(Ok(max)) => max,
+            Some(Err(error)) => {
+                tracing::warn!(%error, "invalid {MAX_TMPFS_ENV} (tmpfs is not permitted)");
+                0
+            }
+            None => 0,
+        };
+
+        Self {
+            env: split(env),
+            mounts: split(mounts)
+                .into_iter()
+                .map(std::path::PathBuf::from)
+                .collect(),
+            max_tmpfs,
+        }
+    }
+
+    /// Verify that `injections` are permitted, and map them into `docker run` arguments.
+    pub fn docker_args(&self, injections: &Injections) -> anyhow::Result<Vec<String>> {
+        let Injections { env, mounts, tmpfs } = injections;
+        let mut args = Vec::new();
+
+        for (name, value) in env {
+            if name.is_empty()
+                || name.starts_with(|c: char| c.is_ascii_digit())
+                || !name.chars().all(|c| c.is_ascii_alphanumeric() || c == '_')
+            {
+                anyhow::bail!("connector environment variable {name:?} is not a valid name");
+            } else if RESERVED_ENV.contains(&name.as_str()) {
+                anyhow::bail!("connector environment variable {name} is reserved by the runtime");
+            } else if !self.env.iter().any(|allow| match allow.strip_suffix('*') {
+                Some(prefix) => name.starts_with(prefix),
+                None => name == allow,
+            }) {
+                anyhow::bail!(
+                    "connector environment variable {name} is not permitted by the allowlist of this data plane (see {ALLOW_ENV_ENV})"
+                );
+            }
+            args.push(format!("--env={name}={value}"));
+        }
+
+        // Mount sources and allowlisted directories are compared only after
+        // resolving symlinks and `..` components, so that a source can't
+        // escape its directory. Directories which don't exist permit nothing.
+        let allowed: Vec<_> = if mounts.is_empty() {
+            Vec::new()
+        } else {
+            self.mounts
+                .iter()
+                .filter_map(|allow| std::fs::canonicalize(allow).ok())
+                .collect()
+        };
+
+        for models::ConnectorMount { source, target } in mounts {
+            let source_path = std::path::Path::new(source);
+            verify_target(target)?;
+
+            if !source_path.is_absolute() {
+                anyhow::bail!("connector mount source {source:?} must be an absolute path");
+            }
+            let resolved = std::fs::canonicalize(source_path)
+                .with_context(|| format!("failed to resolve connector mount source {source}"))?;
+
+            if !allowed.iter().any(|allow| resolved.starts_with(allow)) {
+                anyhow::bail!(
+                    "connector mount source {source} is not permitted by the allowlist of this data plane (see {ALLOW_MOUNTS_ENV})"
+                );
+            } else if resolved.to_string_lossy().contains(',') {
+                anyhow::bail!("connector mount source {source} resolves to a path having a comma");
+            }
+            args.push(format!(
+                "--mount=type=bind,source={},target={target},readonly",
+                mount_source(&resolved),
+            ));
+        }
+
+        let mut total = 0;
+        for models::ConnectorTmpfs { target, size } in tmpfs {
+            verify_target(target)?;
+
+            if *size == 0 {
+                anyhow::bail!("connector tmpfs {target} must have a non-zero size");
+            }
+            total += *size as u64;
+
+            args.push(format!(
+                "--mount=type=tmpfs,target={target},tmpfs-size={}",
+                *size as u64 * 1024 * 1024
+            ));
+        }
+        if total > self.max_tmpfs {
+            anyhow::bail!(
+                "connector tmpfs filesystems total {total}MB, which is more than the {}MB permitted by this data plane (see {MAX_TMPFS_ENV})",
+                self.max_tmpfs,
+            );
+        }
+
+        Ok(args)
+    }
+}
+
+// Verify the `target` of a mount or tmpfs within the container.
+fn verify_target(target: &str) -> anyhow::Result<()> {
+    // Container paths are always POSIX paths, including on Windows hosts.
+    if !target.starts_with('/')
+        || target.contains(',')
+        || target.split('/').any(|c| c == "." || c == "..")
+    {
+        anyhow::bail!("connector mount target {target:?} must be a normalized absolute path");
+    } else if target == "/" || RESERVED_TARGETS.contains(&target) {
+        anyhow::bail!("connector mount target {target} is reserved by the runtime");
+    }
+    Ok(())
+}
+
+/// Keepalive configuration of connector containers.
+///
+/// HTTP/2 keepalive pings are sent at `interval`, even while the channel is idle,
+/// and a connector which doesn't acknowledge a ping within `timeout` is considered
+/// dead, which fails the channel and its streams.
+///
+/// Capture and materialize RPCs also request application-level keepalives,
+/// which connector-init sends whenever `interval` passes without a response.
+/// An RPC which receives neither a response nor a keepalive within `interval`
+/// plus `timeout` is failed, which detects a stream that's stuck even though
+/// its channel is not.
+///
+/// Together, these detect half-open connections (for example, due to a NAT timeout
+/// or a paused container) within seconds, rather than relying on TCP defaults.
+#[derive(Debug, Clone, Copy, PartialEq)]
+pub struct Keepalive {
+    /// Interval of keepalive pings, or None if keepalives are disabled.
+    pub interval: Option<std::time::Duration>,
+    /// Timeout after which an un-acknowledged ping fails the channel.
+    pub timeout: std::time::Duration,
+}
+
+impl Default for Keepalive {
+    fn default() -> Self {
+        Self {
+            interval: Some(std::time::Duration::from_secs(10)),
+            timeout: std::time::Duration::from_secs(20),
+        }
+    }
+}
+
+impl Keepalive {
+    /// Build a Keepalive from the process environment,
+    /// using defaults for variables which are absent or malformed.
+    pub fn from_env() -> Self {
+        Self::parse(
+            std::env::var(KEEPALIVE_INTERVAL_ENV).ok().as_deref(),
+            std::env::var(KEEPALIVE_TIMEOUT_ENV).ok().as_deref(),
+        )
+    }
+
+    fn parse(interval: Option<&str>, timeout: Option<&str>) -> Self {
+        let mut out = Self::default();
+
+        match interval.map(str::parse::<u64>) {
+            Some(Ok(0)) => out.interval = None,
+            Some(Ok(ms)) => out.interval = Some(std::time::Duration::from_millis(ms)),
+            Some(Err(error)) => {
+                tracing::warn!(%error, "invalid {KEEPALIVE_INTERVAL_ENV} (using default)")
+            }
+            None => {}
+        }
+        match timeout.map(str::parse::<u64>) {
+            Some(Ok(ms)) if ms != 0 => out.timeout = std::time::Duration::from_millis(ms),
+            Some(_) => tracing::warn!("invalid {KEEPALIVE_TIMEOUT_ENV} (using default)"),
+            None => {}
+        }
+        out
+    }
+
+    /// Duration within which an RPC having keepalives must receive
+    /// a response or keepalive, or None if keepalives are disabled.
+    pub fn deadline(&self) -> Option<std::time::Duration> {
+        self.interval.map(|interval| interval + self.timeout)
+    }
+
+    // Apply this Keepalive to a tonic Endpoint.
+    fn apply(&self, endpoint: tonic::transport::Endpoint) -> tonic::transport::Endpoint {
+        let Some(interval) = self.interval else {
+            return endpoint;
+        };
+        endpoint
+            .http2_keep_alive_interval(interval)
+            .keep_alive_timeout(self.timeout)
+            .keep_alive_while_idle(true)
+    }
+}
+
+/// AddressFamily of the addresses used to reach connector containers.
+#[derive(Debug, Default, Clone, Copy, PartialEq, Eq)]
+pub enum AddressFamily {
+    /// Prefer IPv4 addresses, and use IPv6 addresses where IPv4 is unavailable.
+    #[default]
+    Any,
+    /// Use only IPv4 addresses.
+    Ipv4,
+    /// Use only IPv6 addresses.
+    Ipv6,
+}
+
+impl AddressFamily {
+    /// Build an AddressFamily from the process environment,
+    /// using the default if the variable is absent or malformed.
+    pub fn from_env() -> Self {
+        Self::parse(std::env::var(ADDRESS_FAMILY_ENV).ok().as_deref())
+    }
+
+    fn parse(family: Option<&str>) -> Self {
+        match family {
+            None | Some("") | Some("any") => Self::Any,
+            Some("ipv4") => Self::Ipv4,
+            Some("ipv6") => Self::Ipv6,
+            Some(other) => {
+                tracing::warn!(%other, "invalid {ADDRESS_FAMILY_ENV} (using default)");
+                Self::Any
+            }
+        }
+    }
+
+    pub fn as_str(&self) -> &'static str {
+        match self {
+            Self::Any => "any",
+            Self::Ipv4 => "ipv4",
+            Self::Ipv6 => "ipv6",
+        }
+    }
+
+    // Select the address to use from a candidate IPv4 and IPv6 address.
+    fn select(
+        &self,
+        ipv4: Option<std::net::IpAddr>,
+        ipv6: Option<std::net::IpAddr>,
+    ) -> Option<std::net::IpAddr> {
+        match self {
+            Self::Any => ipv4.or(ipv6),
+            Self::Ipv4 => ipv4,
+            Self::Ipv6 => ipv6,
+        }
+    }
+}
+
 /// Determines the protocol of an image. If the image has a `FLOW_RUNTIME_PROTOCOL` label,
 /// then it's value is used. Otherwise, this will apply a simple heuristic based on the image name,
 /// for backward compatibility purposes. An error will be returned if it fails to inspect the image
@@ -38,17 +358,57 @@ pub async fn flow_runtime_protocol(image: &str) -> anyhow::Result<RuntimeProtoco
     }
 }
 
+/// Determines whether an image advertises range splitting through its
+/// `dev.estuary.range-splitting` label. A connector which splits its capture
+/// across the key range of its shard, such as by partitioning the backfill of
+/// each binding, may be split across many shards which capture in parallel.
+/// The image is pulled if it's not already present, and its result is cached
+/// for the lifetime of the process.
+pub async fn range_splitting(image: &str) -> anyhow::Result<bool> {
+    // Results of prior inspections, keyed on image.
+    static CACHE: std::sync::Mutex<BTreeMap<String, bool>> = std::sync::Mutex::new(BTreeMap::new());
+
+    if let Some(cached) = CACHE.lock().unwrap().get(image) {
+        return Ok(*cached);
+    }
+    let splitting = inspect_range_splitting(image).await?;
+    CACHE.lock().unwrap().insert(image.to_string(), splitting);
+
+    Ok(splitting)
+}
+
+async fn inspect_range_splitting(image: &str) -> anyhow::Result<bool> {
+    let inspect_output = match docker_cmd(&["inspect", image]).await {
+        Ok(output) => output,
+        Err(_) if !image.ends_with(":local") && !crate::airgapped() => {
+            _ = docker_cmd(&["pull", image, "--quiet"]).await?;
+            docker_cmd(&["inspect", image])
+                .await
+                .context("inspecting image")?
+        }
+        Err(err) => return Err(err.context("inspecting image")),
+    };
+
+    parse_range_splitting(&inspect_output)
+}
+
 /// Start an image connector container, returning its description and a dialed tonic Channel.
 /// The container is attached to the given `network`, and its logs are dispatched to `log_handler`.
+/// `injections` of the task are verified against the Allowlist of this process.
 /// `task_name` and `task_type` are used only to label the container.
 pub async fn start(
     image: &str,
+    injections: &Injections,
     log_handler: impl crate::LogHandler,
     log_level: ops::LogLevel,
     network: &str,
     task_name: &str,
     task_type: ops::TaskType,
 ) -> anyhow::Result<(runtime::Container, tonic::transport::Channel, Guard)> {
+    // Verify injections before doing any other work.
+    let injected = Allowlist::from_env().docker_args(injections)?;
+    let family = AddressFamily::from_env();
+
     // Many operational contexts only allow for docker volume mounts
     // from certain locations:
     //  * Docker for Mac restricts file shares to /User, /tmp, and a couple others.
@@ -56,10 +416,11 @@ pub async fn start(
     //    within the pod, having a common /tmp tempdir volume.
     //
     // So, we use temporaries to ensure that files are readable within the container.
-    let tmp_connector_init =
-        tempfile::NamedTempFile::new().context("creating temp for flow-connector-init")?;
-    let mut tmp_docker_inspect =
-        tempfile::NamedTempFile::new().context("creating temp for docker inspect output")?;
+    let tmp_dir = connector_temp_dir();
+    let tmp_connector_init = tempfile::NamedTempFile::new_in(&tmp_dir)
+        .context("creating temp for flow-connector-init")?;
+    let mut tmp_docker_inspect = tempfile::NamedTempFile::new_in(&tmp_dir)
+        .context("creating temp for docker inspect output")?;
 
     // Change mode of `docker_inspect` to be readable by all users.
     // This is required because the effective container user may have a different UID.
@@ -71,6 +432,16 @@ pub async fn start(
         tmp_docker_inspect.as_file_mut().set_permissions(perms)?;
     }
 
+    // When air-gapped, images cannot be pulled. Check up-front that all required
+    // images are present, so that we may report every image which is missing.
+    if crate::airgapped() {
+        let mut images = vec![image];
+        if installed_connector_init().is_none() {
+            images.push(CONNECTOR_INIT_IMAGE);
+        }
+        require_local_images(&images).await?;
+    }
+
     // Concurrently 1) find or fetch a copy of `flow-connector-init`, copying it
     // into a temp path, and 2) inspect the image, also copying into a temp path,
     // and parsing its advertised network ports.
@@ -85,14 +456,30 @@ pub async fn start(
 
     // This is default `docker run` behavior if --network is not provided.
     let network = if network == "" { "bridge" } else { network };
+
+    // Docker Desktop runs containers within a VM, and its "host" network is
+    // that of the VM rather than of this host. Containers attached to it are
+    // not reachable through published ports, so use the default bridge instead.
+    // Connectors may still reach services of this host at `host.docker.internal`.
+    #[cfg(not(target_os = "linux"))]
+    let network = if network == "host" {
+        tracing::warn!(
+            "host networking isn't supported by Docker Desktop; using the bridge network instead"
+        );
+        "bridge"
+    } else {
+        network
+    };
     let log_level = log_level.or(ops::LogLevel::Warn);
 
     // Generate a unique name for this container instance.
     let name = unique_container_name();
 
-    let mut process: async_process::Child = async_process::Command::new("docker")
+    let mut process: async_process::Child = docker_command()
+        .arg("run")
+        // Environment variables and mounts which are declared by the task.
+        .args(&injected)
         .args([
-            "run",
             // Remove the docker container upon its exit.
             "--rm",
             // Addressable name of this connector.
@@ -105,15 +492,17 @@ pub async fn start(
             // Mount the flow-connector-init binary and `docker inspect` output.
             &format!(
                 "--mount=type=bind,source={},target=/flow-connector-init",
-                tmp_connector_init.to_string_lossy()
+                mount_source(&tmp_connector_init),
             ),
             &format!(
                 "--mount=type=bind,source={},target=/image-inspect.json",
-                tmp_docker_inspect.to_string_lossy(),
+                mount_source(&tmp_docker_inspect),
             ),
             // Thread-through the logging configuration of the connector.
             "--env=LOG_FORMAT=json",
             &format!("--env=LOG_LEVEL={}", log_level.as_str_name()),
+            // Thread-through the address family, for use by network tunnels.
+            &format!("--env={ADDRESS_FAMILY_ENV}={}", family.as_str()),
             // Cgroup memory / CPU resource limits.
             // TODO(johnny): we intend to tighten these down further, over time.
             "--memory=1g",
@@ -129,7 +518,11 @@ pub async fn start(
             // we ask Docker to provide mapped host ports that are then advertised
             // in the attached runtime::Container description.
             #[cfg(not(target_os = "linux"))]
-            &format!("--publish=0.0.0.0:0:{CONNECTOR_INIT_PORT}"),
+            &if family == AddressFamily::Ipv6 {
+                format!("--publish=[::]:0:{CONNECTOR_INIT_PORT}")
+            } else {
+                format!("--publish=0.0.0.0:0:{CONNECTOR_INIT_PORT}")
+            },
             #[cfg(not(target_os = "linux"))]
             "--publish-all",
             // Image to run.
@@ -196,27 +589,32 @@ pub async fn start(
     }
 
     // Ask docker for network configuration that it assigned to the container.
-    let (ip_addr, mapped_host_ports) = inspect_container_network(&name).await?;
+    let (ip_addr, mapped_host_ports) = inspect_container_network(&name, family).await?;
 
     // Dial the gRPC endpoint hosted by `flow-connector-init` within the container context.
     let init_address = if let Some(addr) = mapped_host_ports.get(&(CONNECTOR_INIT_PORT as u32)) {
         format!("http://{addr}")
     } else {
-        format!("http://{ip_addr}:{CONNECTOR_INIT_PORT}")
+        format!(
+            "http://{}",
+            std::net::SocketAddr::new(ip_addr, CONNECTOR_INIT_PORT)
+        )
     };
-    let channel = tonic::transport::Endpoint::new(init_address.clone())
+    let keepalive = Keepalive::from_env();
+    let endpoint = tonic::transport::Endpoint::new(init_address.clone())
         .expect("formatting endpoint address")
-        .connect_timeout(std::time::Duration::from_secs(5))
-        .connect()
-        .await
-        .with_context(|| {
-            format!("failed to connect to container connector-init at {init_address}")
-        })?;
+        .connect_timeout(std::time::Duration::from_secs(5));
+
+    let channel = keepalive.apply(endpoint).connect().await.with_context(|| {
+        format!("failed to connect to container connector-init at {init_address}")
+    })?;
 
     tracing::info!(
         %image,
         %init_address,
         %ip_addr,
+        ?family,
+        ?keepalive,
         mapped_host_ports = ?ops::DebugJson(&mapped_host_ports),
         %name,
         network_ports = ?ops::DebugJson(&network_ports),
@@ -257,11 +655,95 @@ fn unique_container_name() -> String {
     format!("fc_{:x}", n as u32)
 }
 
+/// Build a `docker` Command which is configured to reach the Docker daemon.
+fn docker_command() -> async_process::Command {
+    let mut cmd = async_process::Command::new("docker");
+
+    if let Some(host) = desktop_docker_host() {
+        cmd.env("DOCKER_HOST", host);
+    }
+    cmd
+}
+
+/// Locate the daemon socket of a Docker Desktop-like installation, returning
+/// a DOCKER_HOST to use when the docker CLI wouldn't otherwise find it.
+///
+/// Recent versions of Docker Desktop for macOS (and alternatives like Colima
+/// or Rancher Desktop) don't install a socket at the default /var/run/docker.sock,
+/// and rely instead on a docker CLI context. We look for their sockets only
+/// if the user hasn't otherwise configured a host or context.
+///
+/// On Windows the docker CLI defaults to the Docker Desktop named pipe
+/// (`npipe:////./pipe/docker_engine`), and no detection is required.
+fn desktop_docker_host() -> Option<String> {
+    if cfg!(windows)
+        || std::env::var_os("DOCKER_HOST").is_some()
+        || std::env::var_os("DOCKER_CONTEXT").is_some()
+        || std::path::Path::new("/var/run/docker.sock").exists()
+    {
+        return None;
+    }
+    let home = std::path::PathBuf::from(std::env::var_os("HOME")?);
+
+    // If a non-default CLI context is selected, then the CLI will use it.
+    if let Ok(config) = std::fs::read(home.join(".docker/config.json")) {
+        if let Ok(config) = serde_json::from_slice::<serde_json::Value>(&config) {
+            match config.get("currentContext").and_then(|v| v.as_str()) {
+                None | Some("") | Some("default") => (),
+                Some(_) => return None,
+            }
+        }
+    }
+
+    [
+        ".docker/run/docker.sock",     // Docker Desktop for macOS.
+        ".docker/desktop/docker.sock", // Docker Desktop for Linux.
+        ".colima/default/docker.sock", // Colima.
+        ".rd/docker.sock",             // Rancher Desktop.
+    ]
+    .iter()
+    .map(|socket| home.join(socket))
+    .find(|socket| socket.exists())
+    .map(|socket| format!("unix://{}", socket.display()))
+}
+
+/// Host directory within which temporaries mounted into containers are created.
+fn connector_temp_dir() -> std::path::PathBuf {
+    if let Some(dir) = std::env::var_os(TEMP_DIR_ENV) {
+        return dir.into();
+    }
+    // On macOS, $TMPDIR is a per-user directory under /var/folders which
+    // Docker Desktop alternatives may not share. /tmp is shared by all of them.
+    if cfg!(target_os = "macos") {
+        return "/tmp".into();
+    }
+    std::env::temp_dir()
+}
+
+/// Map a host `path` into the `source` of a docker bind mount.
+fn mount_source(path: &std::path::Path) -> String {
+    let path = path.to_string_lossy();
+
+    if cfg!(windows) {
+        windows_mount_source(&path)
+    } else {
+        path.into_owned()
+    }
+}
+
+/// Docker Desktop for Windows accepts drive-letter paths as mount sources, but
+/// not verbatim (`\\?\`) path prefixes, and backslashes must be written as
+/// forward slashes within `--mount` options.
+fn windows_mount_source(path: &str) -> String {
+    let path = path.strip_prefix(r"\\?\").unwrap_or(path);
+    path.replace('\\', "/")
+}
+
 async fn docker_cmd<S>(args: &[S]) -> anyhow::Result<Vec<u8>>
 where
     S: AsRef<std::ffi::OsStr> + std::fmt::Debug,
 {
-    let output = async_process::output(async_process::Command::new("docker").args(args))
+    let output = async_process::output(docker_command().args(args))
         .await
         .with_context(|| format!("failed to run docker command {args:?}"))?;
 
@@ -276,6 +758,7 @@ where
 
 async fn inspect_container_network(
     name: &str,
+    family: AddressFamily,
 ) -> anyhow::Result<(std::net::IpAddr, BTreeMap<u32, String>)> {
     #[derive(serde::Deserialize)]
     #[serde(rename_all = "PascalCase", deny_unknown_fields)]
@@ -287,15 +770,20 @@ async fn inspect_container_network(
     #[derive(serde::Deserialize)]
     struct Output {
         status: String,
-        ip: std::net::IpAddr,
+        ipv4: String,
+        ipv6: String,
         ports: BTreeMap<String, Option<Vec<HostPort>>>,
     }
 
+    // Addresses of each attached network are separated by whitespace.
+    // IPv6-only networks have an empty IPAddress, and networks without
+    // IPv6 have an empty GlobalIPv6Address.
     let output = docker_cmd(&[
         "inspect",
         "--format",
         r#"{
-            "ip": "{{range.NetworkSettings.Networks}}{{.IPAddress}}{{end}}",
+            "ipv4": "{{range.NetworkSettings.Networks}}{{.IPAddress}} {{end}}",
+            "ipv6": "{{range.NetworkSettings.Networks}}{{.GlobalIPv6Address}} {{end}}",
             "ports": {{json .NetworkSettings.Ports}},
             "status": {{json .State.Status}}
         }"#,
@@ -305,14 +793,36 @@ async fn inspect_container_network(
     .context("failed to inspect a started docker container (did it crash?)")?;
 
     let output = String::from_utf8_lossy(&output);
-    let Output { status, ip, ports } = serde_json::from_str(&output)
+    let Output {
+        status,
+        ipv4,
+        ipv6,
+        ports,
+    } = serde_json::from_str(&output)
         .with_context(|| format!("malformed docker container inspection output: {output}"))?;
 
     if status != "running" {
         anyhow::bail!("container failed to start; did it crash? (docker status is {status:?})");
     }
 
-    let mut mapped_host_ports = BTreeMap::new();
+    let first_addr = |addrs: &str| -> anyhow::Result<Option<std::net::IpAddr>> {
+        match addrs.split_whitespace().next() {
+            Some(addr) => Ok(Some(addr.parse().with_context(|| {
+                format!("invalid address in inspected NetworkSettings.Networks '{addr}'")
+            })?)),
+            None => Ok(None),
+        }
+    };
+    let Some(ip) = family.select(first_addr(&ipv4)?, first_addr(&ipv6)?) else {
+        anyhow::bail!(
+            "container has no {} address (docker inspected IPv4 {:?} and IPv6 {:?}; see {ADDRESS_FAMILY_ENV})",
+            family.as_str(),
+            ipv4.trim(),
+            ipv6.trim(),
+        );
+    };
+
+    let mut mapped_host_ports = BTreeMap::<u32, std::net::SocketAddr>::new();
 
     for (container_port, mappings) in ports {
         let Some(mappings) = mappings else { continue };
@@ -334,17 +844,49 @@ async fn inspect_container_network(
                 format!("invalid port in inspected NetworkSettings.Ports.*.HostPort '{host_port}'")
             })?;
 
-            _ = mapped_host_ports.insert(
-                container_port as u32,
-                if host_ip.is_ipv6() {
-                    format!("[{host_ip}]:{host_port}")
-                } else {
-                    format!("{host_ip}:{host_port}")
-                },
-            );
+            // Ports published on an unspecified address are bound on all
+            // interfaces, but an unspecified address isn't dialable on all
+            // platforms (notably Windows). Map it to loopback.
+            let host_ip = match host_ip {
+                std::net::IpAddr::V4(ip) if ip.is_unspecified() => {
+                    std::net::IpAddr::V4(std::net::Ipv4Addr::LOCALHOST)
+                }
+                std::net::IpAddr::V6(ip) if ip.is_unspecified() => {
+                    std::net::IpAddr::V6(std::net::Ipv6Addr::LOCALHOST)
+                }
+                ip => ip,
+            };
+
+            // Ports may be published on both IPv4 and IPv6 addresses.
+            // Use only mappings of the selected family, preferring IPv4.
+            let (v4, v6) = if host_ip.is_ipv6() {
+                (None, Some(host_ip))
+            } else {
+                (Some(host_ip), None)
+            };
+            if family.select(v4, v6).is_none() {
+                continue;
+            }
+            let mapped = std::net::SocketAddr::new(host_ip, host_port);
+
+            match mapped_host_ports.entry(container_port as u32) {
+                std::collections::btree_map::Entry::Occupied(mut entry) => {
+                    if family == AddressFamily::Any && entry.get().is_ipv6() && mapped.is_ipv4() {
+                        entry.insert(mapped);
+                    }
+                }
+                std::collections::btree_map::Entry::Vacant(entry) => {
+                    entry.insert(mapped);
+                }
+            }
         }
     }
 
+    let mapped_host_ports = mapped_host_ports
+        .into_iter()
+        .map(|(port, addr)| (port, addr.to_string()))
+        .collect();
+
     Ok((ip, mapped_host_ports))
 }
 
@@ -416,10 +958,28 @@ fn parse_network_ports(content: &[u8]) -> anyhow::Result<Vec<flow::NetworkPort>>
     Ok(ports)
 }
 
+fn parse_range_splitting(content: &[u8]) -> anyhow::Result<bool> {
+    let inspect_json: serde_json::Value = serde_json::from_slice(content).with_context(|| {
+        format!(
+            "failed to parse `docker inspect` output: {}",
+            String::from_utf8_lossy(&content)
+        )
+    })?;
+
+    let Some(value) = inspect_json
+        .pointer(&format!("/0/Config/Labels/{RANGE_SPLITTING_LABEL}"))
+        .and_then(|v| v.as_str())
+    else {
+        return Ok(false);
+    };
+
+    value.parse::<bool>().with_context(|| {
+        format!("invalid '{RANGE_SPLITTING_LABEL}' label value: '{value}', must be either 'true' or 'false'")
+    })
+}
+
 async fn find_connector_init_and_copy(tmp_path: &std::path::Path) -> anyhow::Result<()> {
-    // If we can locate an installed flow-connector-init, use that.
-    // This is common when developing or within a container workspace.
-    if let Ok(connector_init) = locate_bin::locate("flow-connector-init") {
+    if let Some(connector_init) = installed_connector_init() {
         tokio::fs::copy(connector_init, tmp_path).await?;
         return Ok(());
     }
@@ -448,11 +1008,47 @@ async fn find_connector_init_and_copy(tmp_path: &std::path::Path) -> anyhow::Res
     Ok(())
 }
 
+/// Locate an installed `flow-connector-init` which may be mounted into containers.
+/// This is common when developing or within a container workspace.
+///
+/// An installed binary can only be used on Linux: on other platforms it's
+/// not executable within the linux/amd64 container.
+fn installed_connector_init() -> Option<std::path::PathBuf> {
+    if cfg!(target_os = "linux") {
+        locate_bin::locate("flow-connector-init").ok()
+    } else {
+        None
+    }
+}
+
+/// Verify that all `images` are present in the local docker image store,
+/// returning an error which lists each image which is missing.
+async fn require_local_images(images: &[&str]) -> anyhow::Result<()> {
+    let mut missing = Vec::new();
+
+    for &image in images {
+        if docker_cmd(&["image", "inspect", "--format={{.Id}}", image])
+            .await
+            .is_err()
+        {
+            missing.push(image);
+        }
+    }
+
+    if !missing.is_empty() {
+        anyhow::bail!(
+            "air-gapped mode requires that connector images are pre-loaded (for example, using `docker load`), but these images are missing: {}",
+            missing.join(", ")
+        );
+    }
+    Ok(())
+}
+
 async fn inspect_image_and_copy(
     image: &str,
     tmp_path: &std::path::Path,
 ) -> anyhow::Result<Vec<flow::NetworkPort>> {
-    if !image.ends_with(":local") {
+    if !image.ends_with(":local") && !crate::airgapped() {
         _ = docker_cmd(&["pull", &image, "--quiet"]).await?;
     }
     let inspect_content = docker_cmd(&["inspect", &image]).await?;
@@ -470,7 +1066,10 @@ const CONNECTOR_INIT_IMAGE_PATH: &str = "/usr/local/bin/flow-connector-init";
 
 #[cfg(test)]
 mod test {
-    use super::{parse_network_ports, start};
+    use super::{
+        parse_network_ports, parse_range_splitting, start, windows_mount_source, Allowlist,
+        Injections, Keepalive,
+    };
     use futures::stream::StreamExt;
     use proto_flow::flow;
     use serde_json::json;
@@ -486,6 +1085,7 @@ mod test {
 
         let (container, channel, _guard) = start(
             "ghcr.io/estuary/source-http-ingest:dev",
+            &Default::default(),
             ops::tracing_log_handler,
             ops::LogLevel::Debug,
             "",
@@ -540,6 +1140,7 @@ mod test {
 
         let Err(err) = start(
             "alpine", // Not a connector.
+            &Default::default(),
             ops::tracing_log_handler,
             ops::LogLevel::Debug,
             "",
@@ -554,6 +1155,206 @@ mod test {
         println!("{err:#}")
     }
 
+    #[test]
+    fn test_parsing_keepalive() {
+        use std::time::Duration;
+
+        assert_eq!(Keepalive::parse(None, None), Keepalive::default());
+        assert_eq!(
+            Keepalive::parse(Some("2500"), Some("5000")),
+            Keepalive {
+                interval: Some(Duration::from_millis(2500)),
+                timeout: Duration::from_secs(5),
+            }
+        );
+        // A zero interval disables keepalives.
+        assert_eq!(Keepalive::parse(Some("0"), None).interval, None);
+        // Malformed values use defaults.
+        assert_eq!(
+            Keepalive::parse(Some("10s"), Some("0")),
+            Keepalive::default()
+        );
+    }
+
+    #[test]
+    fn test_parsing_address_family() {
+        use std::net::IpAddr;
+
+        assert_eq!(AddressFamily::parse(None), AddressFamily::Any);
+        assert_eq!(AddressFamily::parse(Some("ipv4")), AddressFamily::Ipv4);
+        assert_eq!(AddressFamily::parse(Some("ipv6")), AddressFamily::Ipv6);
+        // Malformed values use the default.
+        assert_eq!(AddressFamily::parse(Some("inet6")), AddressFamily::Any);
+
+        let v4: Option<IpAddr> = Some("172.17.0.2".parse().unwrap());
+        let v6: Option<IpAddr> = Some("2001:db8::2".parse().unwrap());
+
+        assert_eq!(AddressFamily::Any.select(v4, v6), v4);
+        assert_eq!(AddressFamily::Any.select(None, v6), v6);
+        assert_eq!(AddressFamily::Ipv4.select(None, v6), None);
+        assert_eq!(AddressFamily::Ipv6.select(v4, v6), v6);
+        assert_eq!(AddressFamily::Ipv6.select(v4, None), None);
+    }
+
+    #[test]
+    fn test_windows_mount_source() {
+        assert_eq!(
+            windows_mount_source(r"C:\Users\someone\AppData\Local\Temp\.tmpAbc123"),
+            "C:/Users/someone/AppData/Local/Temp/.tmpAbc123"
+        );
+        assert_eq!(
+            windows_mount_source(r"\\?\D:\tmp\.tmpAbc123"),
+            "D:/tmp/.tmpAbc123"
+        );
+    }
+
+    #[test]
+    #[cfg(unix)]
+    fn test_allowlist_injections() {
+        let allow = Allowlist::parse(
+            Some("KRB5_*, SSL_CERT_FILE"),
+            Some("/etc/flow,/opt/certs"),
+            Some("128"),
+        );
+        assert_eq!(
+            allow,
+            Allowlist {
+                env: vec!["KRB5_*".to_string(), "SSL_CERT_FILE".to_string()],
+                mounts: vec!["/etc/flow".into(), "/opt/certs".into()],
+                max_tmpfs: 128,
+            }
+        );
+        // By default, nothing is permitted.
+        assert_eq!(Allowlist::parse(None, None, None), Allowlist::default());
+
+        // Build a fixture of host files, where only $ROOT/certs is permitted
+        // and $ROOT/certs/link is a symlink which escapes it.
+        let tmp = tempfile::tempdir().unwrap();
+        let root = std::fs::canonicalize(tmp.path()).unwrap();
+        std::fs::create_dir(root.join("certs")).unwrap();
+        std::fs::create_dir(root.join("other")).unwrap();
+        std::fs::write(root.join("certs/ca.pem"), "ca").unwrap();
+        std::fs::write(root.join("other/secret"), "secret").unwrap();
+        std::os::unix::fs::symlink(root.join("other/secret"), root.join("certs/link")).unwrap();
+
+        let root = root.to_str().unwrap().to_string();
+        let allow = Allowlist {
+            mounts: vec![
+                format!("{root}/certs").into(),
+                format!("{root}/does-not-exist").into(),
+            ],
+            ..allow
+        };
+
+        let injections = Injections {
+            env: [
+                ("KRB5_CONFIG".to_string(), "/etc/krb5.conf".to_string()),
+                ("SSL_CERT_FILE".to_string(), "/certs/ca.pem".to_string()),
+            ]
+            .into(),
+            mounts: vec![models::ConnectorMount {
+                source: "$ROOT/certs/ca.pem".to_string(),
+                target: "/certs/ca.pem".to_string(),
+            }],
+            tmpfs: vec![models::ConnectorTmpfs {
+                target: "/scratch".to_string(),
+                size: 64,
+            }],
+        };
+        let resolve = |mut injections: Injections| {
+            for mount in injections.mounts.iter_mut() {
+                mount.source = mount.source.replace("$ROOT", &root);
+            }
+            allow.docker_args(&injections)
+        };
+
+        assert_eq!(
+            resolve(injections.clone()).unwrap(),
+            vec![
+                "--env=KRB5_CONFIG=/etc/krb5.conf".to_string(),
+                "--env=SSL_CERT_FILE=/certs/ca.pem".to_string(),
+                format!(
+                    "--mount=type=bind,source={root}/certs/ca.pem,target=/certs/ca.pem,readonly"
+                ),
+                "--mount=type=tmpfs,target=/scratch,tmpfs-size=67108864".to_string(),
+            ]
+        );
+
+        // A source having `..` components is permitted if it resolves within
+        // an allowed directory, and is mounted as its resolved path.
+        let mut within = injections.clone();
+        within.mounts[0].source = "$ROOT/other/../certs/ca.pem".to_string();
+        assert_eq!(
+            resolve(within).unwrap()[2],
+            format!("--mount=type=bind,source={root}/certs/ca.pem,target=/certs/ca.pem,readonly"),
+        );
+
+        let check = |modify: fn(&mut Injections)| {
+            let mut injections = injections.clone();
+            modify(&mut injections);
+            format!("{:#}", resolve(injections).unwrap_err()).replace(&root, "$ROOT")
+        };
+
+        insta::assert_debug_snapshot!([
+            check(|i| {
+                i.env.insert("AWS_SECRET".to_string(), String::new());
+            }),
+            check(|i| {
+                i.env.insert("LOG_LEVEL".to_string(), String::new());
+            }),
+            check(|i| {
+                i.env.insert("NOT-A-NAME".to_string(), String::new());
+            }),
+            check(|i| i.mounts[0].source = "/etc/passwd".to_string()),
+            check(|i| i.mounts[0].source = "$ROOT/certs/../other/secret".to_string()),
+            check(|i| i.mounts[0].source = "$ROOT/certs/link".to_string()),
+            check(|i| i.mounts[0].source = "$ROOT/certs/missing.pem".to_string()),
+            check(|i| i.mounts[0].source = "relative/ca.pem".to_string()),
+            check(|i| i.mounts[0].target = "/flow-connector-init".to_string()),
+            check(|i| i.mounts[0].target = "relative/path".to_string()),
+            check(|i| i.tmpfs[0].size = 0),
+            check(|i| i.tmpfs[0].size = 256),
+        ], @r###"
+        [
+            "connector environment variable AWS_SECRET is not permitted by the allowlist of this data plane (see FLOW_CONNECTOR_ALLOW_ENV)",
+            "connector environment variable LOG_LEVEL is reserved by the runtime",
+            "connector environment variable \"NOT-A-NAME\" is not a valid name",
+            "connector mount source /etc/passwd is not permitted by the allowlist of this data plane (see FLOW_CONNECTOR_ALLOW_MOUNTS)",
+            "connector mount source $ROOT/certs/../other/secret is not permitted by the allowlist of this data plane (see FLOW_CONNECTOR_ALLOW_MOUNTS)",
+            "connector mount source $ROOT/certs/link is not permitted by the allowlist of this data plane (see FLOW_CONNECTOR_ALLOW_MOUNTS)",
+            "failed to resolve connector mount source $ROOT/certs/missing.pem: No such file or directory (os error 2)",
+            "connector mount source \"relative/ca.pem\" must be an absolute path",
+            "connector mount target /flow-connector-init is reserved by the runtime",
+            "connector mount target \"relative/path\" must be a normalized absolute path",
+            "connector tmpfs /scratch must have a non-zero size",
+            "connector tmpfs filesystems total 256MB, which is more than the 128MB permitted by this data plane (see FLOW_CONNECTOR_MAX_TMPFS)",
+        ]
+        "###);
+    }
+
+    #[test]
+    fn test_parsing_range_splitting() {
+        let parse = |labels: serde_json::Value| {
+            let fixture = json!([{"Config": {"Labels": labels}}]);
+            parse_range_splitting(fixture.to_string().as_bytes()).map_err(|err| err.to_string())
+        };
+
+        assert_eq!(
+            parse(json!({"dev.estuary.range-splitting": "true"})),
+            Ok(true)
+        );
+        assert_eq!(
+            parse(json!({"dev.estuary.range-splitting": "false"})),
+            Ok(false)
+        );
+        assert_eq!(parse(json!({"other": "true"})), Ok(false));
+        assert_eq!(parse(json!(null)), Ok(false));
+        assert_eq!(
+            parse(json!({"dev.estuary.range-splitting": "yes"})),
+            Err("invalid 'dev.estuary.range-splitting' label value: 'yes', must be either 'true' or 'false'".to_string())
+        );
+    }
+
     #[test]
     fn test_parsing_network_ports() {
         let fixture = json!([
@@ -27,11 +27,11 @@ pub async fn start<L: LogHandler>(
 
     fn start_rpc(
         channel: tonic::transport::Channel,
-        rx: mpsc::Receiver<Request>,
+        rx: tonic::Request<mpsc::Receiver<Request>>,
     ) -> crate::image_connector::StartRpcFuture<Response> {
         async move {
             proto_grpc::derive::connector_client::ConnectorClient::new(channel)
-                .max_decoding_message_size(crate::MAX_MESSAGE_SIZE)
+                .max_decoding_message_size(crate::max_message_size())
                 .max_encoding_message_size(usize::MAX)
                 .derive(rx)
                 .await
@@ -43,6 +43,9 @@ pub async fn start<L: LogHandler>(
         models::DeriveUsing::Connector(models::ConnectorConfig {
             image,
             config: sealed_config,
+            env,
+            mounts,
+            tmpfs,
         }) => {
             *config_json = unseal::decrypt_sops(&sealed_config).await?.to_string();
             connector_tx.try_send(initial).unwrap();
@@ -50,6 +53,8 @@ pub async fn start<L: LogHandler>(
             crate::image_connector::serve(
                 attach_container,
                 image,
+                crate::container::Injections { env, mounts, tmpfs },
+                None,
                 runtime.log_handler.clone(),
                 log_level,
                 &runtime.container_network,
@@ -150,6 +155,9 @@ fn extract_endpoint<'r>(
                 image: "ghcr.io/estuary/derive-typescript:dev".to_string(),
                 config: models::RawValue::from_str(config_json)
                     .context("parsing connector config")?,
+                env: Default::default(),
+                mounts: Vec::new(),
+                tmpfs: Vec::new(),
             }),
             config_json,
         ))
@@ -46,6 +46,8 @@ struct Transform {
 pub struct Transaction {
     checkpoint: consumer::Checkpoint,        // Recorded checkpoint.
     combined_stats: DocsAndBytes,            // Combined output stats.
+    dead_letters: Vec<(String, String)>,     // Dead-lettered (collection, document) pairs.
+    failures: Option<ops::stats::Derive>,    // Connector stats of failed documents.
     max_clock: u64,                          // Maximum clock of read documents.
     publish_stats: DocsAndBytes,             // Published (right) stats.
     read_stats: BTreeMap<u32, DocsAndBytes>, // Per-transform read document stats.
@@ -59,6 +61,8 @@ impl Transaction {
         Self {
             checkpoint: Default::default(),
             combined_stats: Default::default(),
+            dead_letters: Vec::new(),
+            failures: None,
             max_clock: 0,
             publish_stats: Default::default(),
             read_stats: BTreeMap::new(),
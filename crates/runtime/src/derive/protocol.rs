@@ -5,7 +5,7 @@ use anyhow::Context;
 use prost::Message;
 use proto_flow::derive::{request, response, Request, Response};
 use proto_flow::flow;
-use proto_flow::runtime::derive_response_ext;
+use proto_flow::runtime::{derive_response_ext, DeriveRequestExt, DeriveResponseExt};
 use proto_gazette::consumer;
 use std::collections::BTreeMap;
 
@@ -166,11 +166,21 @@ pub fn recv_connector_published_or_flushed(
     task: &Task,
     txn: &mut Transaction,
 ) -> anyhow::Result<()> {
-    let response::Published { doc_json } = match response {
-        Some(Response {
-            flushed: Some(response::Flushed {}),
-            ..
-        }) if saw_flush => {
+    let (response::Published { doc_json }, internal) = match response {
+        Some(
+            response @ Response {
+                flushed: Some(response::Flushed {}),
+                ..
+            },
+        ) if saw_flush => {
+            // The connector may report the stats of documents its transforms failed to process.
+            let internal = response
+                .get_internal()
+                .context("failed to decode connector internal DeriveResponseExt")?;
+
+            if let Some(derive_response_ext::Flushed { stats: Some(stats) }) = internal.flushed {
+                txn.failures = stats.derive;
+            }
             *saw_flushed = true;
             return Ok(());
         }
@@ -181,11 +191,37 @@ pub fn recv_connector_published_or_flushed(
         }
         Some(Response {
             published: Some(published),
+            internal,
             ..
-        }) => published,
+        }) => (published, internal),
         response => return verify("connector", "Published or Flushed").fail(response),
     };
 
+    // A source document which a transform failed to process is passed through
+    // to its dead-letter collection, rather than combined into the derived collection.
+    // The connector has validated it against the dead-letter collection's write schema.
+    if !internal.is_empty() {
+        let derive_response_ext::Published { dead_letter, .. } =
+            proto_flow::runtime::DeriveResponseExt::decode(internal)
+                .context("failed to decode connector internal DeriveResponseExt")?
+                .published
+                .unwrap_or_default();
+
+        if !dead_letter.is_empty() {
+            let mut doc: serde_json::Value = serde_json::from_str(&doc_json)
+                .context("couldn't parse dead-lettered document as JSON")?;
+
+            if let Some(doc) = doc.as_object_mut() {
+                doc.insert(
+                    "_meta".to_string(),
+                    serde_json::json!({"uuid": crate::UUID_PLACEHOLDER}),
+                );
+            }
+            txn.dead_letters.push((dead_letter, doc.to_string()));
+            return Ok(());
+        }
+    }
+
     let memtable = accumulator.memtable()?;
     let alloc = memtable.alloc();
 
@@ -247,16 +283,43 @@ pub fn send_client_published(
     })
 }
 
+pub fn send_client_dead_letter(
+    buf: &mut bytes::BytesMut,
+    dead_letter: String,
+    doc_json: String,
+    txn: &Transaction,
+) -> Response {
+    Response {
+        published: Some(response::Published { doc_json }),
+        ..Default::default()
+    }
+    .with_internal_buf(buf, |internal| {
+        internal.published = Some(derive_response_ext::Published {
+            max_clock: txn.max_clock,
+            dead_letter,
+            ..Default::default()
+        });
+    })
+}
+
 pub fn send_client_flushed(buf: &mut bytes::BytesMut, task: &Task, txn: &Transaction) -> Response {
     let transforms: BTreeMap<_, _> = txn
         .read_stats
         .iter()
         .map(|(index, read_stats)| {
+            let transform = &task.transforms[*index as usize];
+            let failed = txn
+                .failures
+                .as_ref()
+                .and_then(|stats| stats.transforms.get(&transform.name));
+
             (
-                task.transforms[*index as usize].name.clone(),
+                transform.name.clone(),
                 ops::stats::derive::Transform {
                     input: Some(read_stats.clone()),
-                    source: task.transforms[*index as usize].collection_name.clone(),
+                    source: transform.collection_name.clone(),
+                    skipped: failed.and_then(|f| f.skipped.clone()),
+                    dead_lettered: failed.and_then(|f| f.dead_lettered.clone()),
                 },
             )
         })
@@ -267,6 +330,7 @@ pub fn send_client_flushed(buf: &mut bytes::BytesMut, task: &Task, txn: &Transac
     ops::merge_docs_and_bytes(&txn.combined_stats, &mut out);
 
     let stats = ops::Stats {
+        bindings: Default::default(),
         capture: Default::default(),
         derive: Some(ops::stats::Derive {
             transforms,
@@ -372,3 +436,27 @@ pub async fn recv_connector_started_commit(
 
     Ok(response)
 }
+
+pub fn is_client_query(request: &Request) -> bool {
+    matches!(
+        request.get_internal(),
+        Ok(DeriveRequestExt { query: Some(_), .. })
+    )
+}
+
+pub fn recv_connector_queried(response: Option<Response>) -> anyhow::Result<Response> {
+    let verify = verify("connector", "Queried");
+    let response = verify.not_eof(response)?;
+
+    if matches!(
+        response.get_internal(),
+        Ok(DeriveResponseExt {
+            queried: Some(_),
+            ..
+        })
+    ) {
+        Ok(response)
+    } else {
+        verify.fail(response)
+    }
+}
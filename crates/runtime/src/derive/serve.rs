@@ -128,6 +128,15 @@ async fn serve_session<L: LogHandler>(
                 Step::ClientRx(Some(reset @ Request { reset: Some(_), .. })) if !txn.started => {
                     send_fut = Some(connector_tx.feed(reset));
                 }
+                Step::ClientRx(Some(query)) if !txn.started && is_client_query(&query) => {
+                    // Queries of connector state run between transactions,
+                    // while there's no pending send to the connector.
+                    send_fut = None;
+                    // A failed send is surfaced by `connector_rx`.
+                    let _ = connector_tx.send(query).await;
+                    let queried = recv_connector_queried(connector_rx.try_next().await?)?;
+                    () = co.yield_(queried).await;
+                }
                 Step::ClientRx(request) => {
                     if let Some(send) = recv_client_read_or_flush(
                         request,
@@ -173,6 +182,10 @@ async fn serve_session<L: LogHandler>(
             let published = send_client_published(&mut buf, drained, shape, &task, &mut txn);
             () = co.yield_(published).await;
         }
+        for (dead_letter, doc_json) in std::mem::take(&mut txn.dead_letters) {
+            let published = send_client_dead_letter(&mut buf, dead_letter, doc_json, &txn);
+            () = co.yield_(published).await;
+        }
         () = co.yield_(send_client_flushed(&mut buf, &task, &txn)).await;
 
         // Read StartCommit and forward to the connector.
@@ -10,9 +10,15 @@ pub type StartRpcFuture<Response> =
 
 /// Serve an image-based connector by starting a container, dialing connector-init,
 /// and then starting a gRPC request.
+///
+/// If `is_keepalive` is provided, the RPC requests keepalives of connector-init,
+/// which are filtered from returned responses, and the RPC fails if a response
+/// or keepalive isn't received within the container::Keepalive deadline.
 pub async fn serve<Request, Response, StartRpc, Attach>(
     attach_container: Attach, // Attaches a Container description to a response.
     image: String,            // Container image to run.
+    injections: container::Injections, // Environment and mounts injected into the container.
+    is_keepalive: Option<fn(&Response) -> bool>, // Identifies keepalives, if the RPC has them.
     log_handler: impl crate::LogHandler, // Handler for connector logs.
     log_level: ops::LogLevel, // Log-level of the connector, if known.
     network: &str,            // Container network to use.
@@ -24,13 +30,17 @@ pub async fn serve<Request, Response, StartRpc, Attach>(
 where
     Request: serde::Serialize + Send + 'static,
     Response: Send + Sync + 'static,
-    StartRpc: Fn(tonic::transport::Channel, mpsc::Receiver<Request>) -> StartRpcFuture<Response>
+    StartRpc: Fn(
+            tonic::transport::Channel,
+            tonic::Request<mpsc::Receiver<Request>>,
+        ) -> StartRpcFuture<Response>
         + Send
         + 'static,
     Attach: Fn(&mut Response, Container) + Send + 'static,
 {
     let (container, channel, guard) = container::start(
         &image,
+        &injections,
         log_handler.clone(),
         log_level,
         &network,
@@ -39,25 +49,49 @@ where
     )
     .await?;
 
+    // Request keepalives of connector-init, if the RPC has them.
+    let keepalive = container::Keepalive::from_env();
+    let deadline = is_keepalive.and(keepalive.deadline());
+
+    let mut request = tonic::Request::new(request_rx);
+    if let (Some(_), Some(interval)) = (deadline, keepalive.interval) {
+        request.metadata_mut().insert(
+            connector_init::rpc::KEEPALIVE_METADATA_KEY,
+            interval.as_millis().to_string().parse().unwrap(),
+        );
+    }
+
     // Start RPC over the container's gRPC `channel`.
     let mut container_rx =
-        crate::stream_status_to_error((start_rpc)(channel, request_rx).await?.into_inner());
+        crate::stream_status_to_error((start_rpc)(channel, request).await?.into_inner());
 
     let container_rx = coroutines::try_coroutine(move |mut co| async move {
         let _guard = guard; // Move into future.
+        let mut container = Some(container);
 
-        // Attach `container` to the first request.
-        let Some(mut first) = container_rx.try_next().await? else {
-            return Ok(());
-        };
-        (attach_container)(&mut first, container);
-        () = co.yield_(first).await;
-
-        // Stream successive requests.
-        while let Some(response) = container_rx.try_next().await? {
+        loop {
+            let response = match deadline {
+                Some(deadline) => tokio::time::timeout(deadline, container_rx.try_next())
+                    .await
+                    .map_err(|_| {
+                        anyhow::anyhow!(
+                            "connector container sent no response or keepalive within {deadline:?}, and is presumed dead"
+                        )
+                    })??,
+                None => container_rx.try_next().await?,
+            };
+            let Some(mut response) = response else {
+                return Ok(());
+            };
+            if matches!(is_keepalive, Some(is_keepalive) if is_keepalive(&response)) {
+                continue;
+            }
+            // Attach `container` to the first response.
+            if let Some(container) = container.take() {
+                (attach_container)(&mut response, container);
+            }
             () = co.yield_(response).await;
         }
-        Ok(())
     });
 
     Ok(container_rx)
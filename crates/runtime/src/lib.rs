@@ -33,6 +33,22 @@ pub const UUID_PLACEHOLDER: &str = "DocUUIDPlaceholder-329Bb50aa48EAa9ef";
 /// documents without yielding, so it should not be *too* small.
 pub const CHANNEL_BUFFER: usize = 16;
 
+// Environment variable which enables air-gapped operation. It's set by the
+// Go runtime from its `--airgapped` flags.
+const AIRGAPPED_ENV: &str = "FLOW_AIRGAPPED";
+
+/// Returns true if air-gapped operation is enabled. When air-gapped, Flow
+/// doesn't access external resources: connector images are never pulled
+/// and must be pre-loaded, and remote (http:// or https://) resources
+/// of a catalog are not fetched.
+pub fn airgapped() -> bool {
+    // Accept the same truthy values as Go's strconv.ParseBool.
+    matches!(
+        std::env::var(AIRGAPPED_ENV).as_deref(),
+        Ok("1" | "t" | "T" | "true" | "TRUE" | "True")
+    )
+}
+
 /// Describes the basic type of runtime protocol. This corresponds to the
 /// `FLOW_RUNTIME_PROTOCOL` label that's used on docker images.
 #[derive(Debug, Clone, Copy, PartialEq)]
@@ -268,5 +284,21 @@ where
     })
 }
 
-// Maximum accepted message size.
+// Default maximum accepted message size.
 const MAX_MESSAGE_SIZE: usize = 1 << 26; // 64MB.
+
+// Environment variable which overrides MAX_MESSAGE_SIZE, in bytes.
+// It's set by the Go runtime from its flags.
+const MAX_MESSAGE_SIZE_ENV: &str = "FLOW_RUNTIME_MAX_MESSAGE_SIZE";
+
+/// Maximum accepted size of a message sent by a connector.
+fn max_message_size() -> usize {
+    match std::env::var(MAX_MESSAGE_SIZE_ENV).map(|s| s.parse::<usize>()) {
+        Ok(Ok(size)) if size != 0 => size,
+        Ok(_) => {
+            tracing::warn!("invalid {MAX_MESSAGE_SIZE_ENV} (using default)");
+            MAX_MESSAGE_SIZE
+        }
+        Err(_) => MAX_MESSAGE_SIZE,
+    }
+}
@@ -25,13 +25,20 @@ pub async fn start<L: LogHandler>(
         });
     }
 
+    fn is_keepalive(response: &Response) -> bool {
+        matches!(
+            response.get_internal(),
+            Ok(ext) if ext.keepalive.is_some()
+        )
+    }
+
     fn start_rpc(
         channel: tonic::transport::Channel,
-        rx: mpsc::Receiver<Request>,
+        rx: tonic::Request<mpsc::Receiver<Request>>,
     ) -> crate::image_connector::StartRpcFuture<Response> {
         async move {
             proto_grpc::materialize::connector_client::ConnectorClient::new(channel)
-                .max_decoding_message_size(crate::MAX_MESSAGE_SIZE)
+                .max_decoding_message_size(crate::max_message_size())
                 .max_encoding_message_size(usize::MAX)
                 .materialize(rx)
                 .await
@@ -43,6 +50,9 @@ pub async fn start<L: LogHandler>(
         models::MaterializationEndpoint::Connector(models::ConnectorConfig {
             image,
             config: sealed_config,
+            env,
+            mounts,
+            tmpfs,
         }) => {
             *config_json = unseal::decrypt_sops(&sealed_config).await?.to_string();
             connector_tx.try_send(initial).unwrap();
@@ -50,6 +60,8 @@ pub async fn start<L: LogHandler>(
             crate::image_connector::serve(
                 attach_container,
                 image,
+                crate::container::Injections { env, mounts, tmpfs },
+                Some(is_keepalive),
                 runtime.log_handler.clone(),
                 log_level,
                 &runtime.container_network,
@@ -122,6 +134,17 @@ fn extract_endpoint<'r>(
 
             (inner.connector_type, &mut inner.config_json)
         }
+        Request {
+            type_mapping: Some(type_mapping),
+            ..
+        } => {
+            let inner = type_mapping
+                .validate
+                .as_mut()
+                .context("`type_mapping` missing required `validate`")?;
+
+            (inner.connector_type, &mut inner.config_json)
+        }
         request => return crate::verify("client", "valid first request").fail(request),
     };
 
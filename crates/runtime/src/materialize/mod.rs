@@ -33,7 +33,7 @@ struct Binding {
     ser_policy: doc::SerPolicy,  // Serialization policy for this source.
     state_key: String,           // State key for this binding.
     store_document: bool,        // Are we storing the root document (often `flow_document`)?
-    value_extractors: Vec<doc::Extractor>, // Field extractors for this collection.
+    value_extractors: doc::BatchExtractor, // Compiled field extractors for this collection.
 }
 
 #[derive(Debug)]
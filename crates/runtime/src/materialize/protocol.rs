@@ -74,6 +74,10 @@ pub fn recv_connector_unary(request: Request, response: Response) -> anyhow::Res
         Ok(response)
     } else if request.apply.is_some() {
         verify("connector", "Applied").fail(response)
+    } else if request.type_mapping.is_some() && response.type_mapped.is_some() {
+        Ok(response)
+    } else if request.type_mapping.is_some() {
+        verify("connector", "TypeMapped").fail(response)
     } else {
         verify("client", "unary request").fail(request)
     }
@@ -387,12 +391,9 @@ pub fn send_connector_store(
     )
     .expect("document serialization cannot fail");
 
-    let values_packed = doc::Extractor::extract_all_owned_indicate_truncation(
-        &root,
-        &binding.value_extractors,
-        buf,
-        &truncation_indicator,
-    );
+    let values_packed = binding
+        .value_extractors
+        .extract_all_owned_indicate_truncation(&root, buf, &truncation_indicator);
 
     // Accumulate metrics over reads for our transforms.
     let stats = &mut txn.stats.entry(binding_index as u32).or_default();
@@ -420,19 +421,25 @@ pub fn send_connector_store(
 
 pub fn send_client_flushed(buf: &mut bytes::BytesMut, task: &Task, txn: &Transaction) -> Response {
     let mut materialize = BTreeMap::<String, ops::stats::Binding>::new();
+    let mut bindings = BTreeMap::<String, ops::stats::Binding>::new();
 
     for (index, binding_stats) in txn.stats.iter() {
-        let index = *index as usize;
-        let entry = materialize
-            .entry(task.bindings[index].collection_name.clone())
-            .or_default();
-
-        ops::merge_docs_and_bytes(&binding_stats.0, &mut entry.left);
-        ops::merge_docs_and_bytes(&binding_stats.1, &mut entry.right);
-        ops::merge_docs_and_bytes(&binding_stats.2, &mut entry.out);
+        let binding = &task.bindings[*index as usize];
+
+        for entry in [
+            materialize
+                .entry(binding.collection_name.clone())
+                .or_default(),
+            bindings.entry(binding.state_key.clone()).or_default(),
+        ] {
+            ops::merge_docs_and_bytes(&binding_stats.0, &mut entry.left);
+            ops::merge_docs_and_bytes(&binding_stats.1, &mut entry.right);
+            ops::merge_docs_and_bytes(&binding_stats.2, &mut entry.out);
+        }
     }
 
     let stats = ops::Stats {
+        bindings,
         capture: Default::default(),
         derive: None,
         interval: None,
@@ -130,7 +130,11 @@ impl Binding {
         } = collection.as_ref().context("missing collection")?;
 
         let key_extractors = extractors::for_fields(selected_key, projections, ser_policy)?;
-        let value_extractors = extractors::for_fields(selected_values, projections, ser_policy)?;
+        let value_extractors = doc::BatchExtractor::new(extractors::for_fields(
+            selected_values,
+            projections,
+            ser_policy,
+        )?);
 
         let read_schema_json = if read_schema_json.is_empty() {
             write_schema_json
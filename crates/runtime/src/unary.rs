@@ -39,14 +39,22 @@ fn unary_in<R: Send + 'static>(request: R) -> BoxStream<'static, anyhow::Result<
 async fn unary_out<S, R>(response_rx: S, timeout: Duration) -> anyhow::Result<R>
 where
     S: futures::Stream<Item = anyhow::Result<R>>,
+    R: UnaryResponse,
 {
     let response = async move {
-        let mut responses: Vec<R> = response_rx.try_collect().await?;
+        let response = response_rx
+            .try_fold(None, |last: Option<R>, next| async move {
+                match last {
+                    None => Ok(Some(next)),
+                    Some(mut last) => {
+                        last.merge(next)?;
+                        Ok(Some(last))
+                    }
+                }
+            })
+            .await?;
 
-        if responses.len() != 1 {
-            anyhow::bail!("unary request didn't return a response");
-        }
-        Ok(responses.pop().unwrap())
+        response.ok_or_else(|| anyhow::anyhow!("unary request didn't return a response"))
     };
 
     tokio::select! {
@@ -57,3 +65,28 @@ where
         }
     }
 }
+
+/// UnaryResponse is the response of a unary request,
+/// which may be sent as a sequence of messages that are merged into one.
+trait UnaryResponse: Sized {
+    fn merge(&mut self, _next: Self) -> anyhow::Result<()> {
+        anyhow::bail!("unary request returned more than one response")
+    }
+}
+
+impl UnaryResponse for derive::Response {}
+impl UnaryResponse for materialize::Response {}
+
+impl UnaryResponse for capture::Response {
+    // Discovered may be sent as a sequence of responses,
+    // each having a chunk of bindings.
+    fn merge(&mut self, next: Self) -> anyhow::Result<()> {
+        match (self.discovered.as_mut(), next.discovered) {
+            (Some(discovered), Some(chunk)) => {
+                discovered.bindings.extend(chunk.bindings);
+                Ok(())
+            }
+            _ => anyhow::bail!("unary request returned more than one response"),
+        }
+    }
+}
@@ -79,7 +79,7 @@ pub fn inline_capture(
     }
 }
 
-fn inline_collection(
+pub fn inline_collection(
     scope: &url::Url,
     spec: &mut models::CollectionDef,
     imports: &mut tables::Imports,
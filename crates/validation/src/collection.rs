@@ -52,7 +52,7 @@ fn walk_collection(
                 read_schema,
                 key,
                 projections,
-                journals: _,
+                journals,
                 derive: _,
             },
     } = collection;
@@ -147,6 +147,24 @@ fn walk_collection(
         errors,
     );
 
+    if journals.fragments.kms_key.is_some() {
+        for store in partition_stores {
+            if !matches!(store, models::Store::S3(_)) {
+                Error::KmsKeyUnsupportedStore {
+                    collection: name.to_string(),
+                    store: store.to_url(name).to_string(),
+                }
+                .push(
+                    scope
+                        .push_prop("journals")
+                        .push_prop("fragments")
+                        .push_prop("kmsKey"),
+                    errors,
+                );
+            }
+        }
+    }
+
     Some(assemble::collection_spec(
         build_id,
         collection,
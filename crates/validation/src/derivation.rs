@@ -176,6 +176,9 @@ pub async fn walk_all_derivations(
                         lambda: _,
                         disable: _,
                         backfill: _,
+                        on_error: _,
+                        error_budget: _,
+                        dead_letter: _,
                     } = transform_models[transform_index];
 
                     let shuffle_key = match shuffle {
@@ -342,7 +345,7 @@ fn walk_derive_request<'a>(
         ),
         models::DeriveUsing::Sqlite(config) => (
             ConnectorType::Sqlite as i32,
-            serde_json::to_string(config).unwrap(),
+            sqlite_config_json(config, transforms, built_collections),
         ),
         models::DeriveUsing::Typescript(config) => (
             ConnectorType::Typescript as i32,
@@ -363,6 +366,7 @@ fn walk_derive_request<'a>(
             walk_derive_transform(
                 scope.push_item(transform_index),
                 built_collections,
+                connector_type,
                 transform,
                 errors,
             )
@@ -460,6 +464,7 @@ fn walk_derive_request<'a>(
 fn walk_derive_transform(
     scope: Scope,
     built_collections: &[tables::BuiltCollection],
+    connector_type: i32,
     transform: &models::TransformDef,
     errors: &mut tables::Errors,
 ) -> Option<(derive::request::validate::Transform, Vec<ShuffleType>)> {
@@ -472,6 +477,9 @@ fn walk_derive_transform(
         lambda,
         disable: _,
         backfill,
+        on_error,
+        error_budget: _,
+        dead_letter,
     } = transform;
 
     indexed::walk_name(
@@ -482,6 +490,42 @@ fn walk_derive_transform(
         errors,
     );
 
+    // Error policies other than `halt` are applied by the SQLite connector.
+    if !on_error.is_halt() && connector_type != ConnectorType::Sqlite as i32 {
+        Error::OnErrorUnsupported {
+            transform: name.to_string(),
+        }
+        .push(scope.push_prop("onError"), errors);
+    }
+    match (on_error, dead_letter) {
+        (models::OnError::DeadLetter, None) => {
+            Error::DeadLetterMissing {
+                transform: name.to_string(),
+            }
+            .push(scope, errors);
+        }
+        (_, Some(dead_letter)) => {
+            let built = reference::walk_reference(
+                scope.push_prop("deadLetter"),
+                &format!("transform {name}"),
+                "collection",
+                dead_letter,
+                built_collections,
+                |c| (&c.collection, Scope::new(&c.scope)),
+                errors,
+            );
+            // Dead-lettered documents are mapped into a single logical partition.
+            if matches!(built, Some(built) if !built.spec.partition_fields.is_empty()) {
+                Error::DeadLetterPartitioned {
+                    transform: name.to_string(),
+                    collection: dead_letter.to_string(),
+                }
+                .push(scope.push_prop("deadLetter"), errors);
+            }
+        }
+        (_, None) => {}
+    }
+
     let (source_name, source_partitions) = match source {
         models::Source::Collection(name) => (name, None),
         models::Source::Source(models::FullSource {
@@ -567,6 +611,54 @@ fn walk_derive_transform(
     Some((request, shuffle_types))
 }
 
+// sqlite_config_json encodes the `config` of a SQLite derivation, extended
+// with the error policies of its enabled `transforms` which don't halt.
+// Policies include the write schema of their dead-letter collection,
+// which the connector validates dead-lettered documents against.
+fn sqlite_config_json(
+    config: &models::DeriveUsingSqlite,
+    transforms: &[models::TransformDef],
+    built_collections: &[tables::BuiltCollection],
+) -> String {
+    let mut config_json = serde_json::to_value(config).unwrap();
+
+    let policies: serde_json::Map<String, serde_json::Value> = transforms
+        .iter()
+        .filter(|t| !t.disable && !t.on_error.is_halt())
+        .map(|t| {
+            (
+                t.name.to_string(),
+                serde_json::json!({
+                    "onError": t.on_error,
+                    "errorBudget": t.error_budget,
+                    "deadLetter": t.dead_letter,
+                    "deadLetterSchema": t.dead_letter.as_ref().and_then(|collection| {
+                        dead_letter_schema(collection, built_collections)
+                    }),
+                }),
+            )
+        })
+        .collect();
+
+    if !policies.is_empty() {
+        config_json["onError"] = serde_json::Value::Object(policies);
+    }
+    config_json.to_string()
+}
+
+// dead_letter_schema returns the write schema of the built dead-letter `collection`.
+// It's None if the collection doesn't exist, which is a reported error.
+fn dead_letter_schema(
+    collection: &models::Collection,
+    built_collections: &[tables::BuiltCollection],
+) -> Option<serde_json::Value> {
+    let index = built_collections
+        .binary_search_by_key(&collection, |b| &b.collection)
+        .ok()?;
+
+    serde_json::from_str(&built_collections[index].spec.write_schema_json).ok()
+}
+
 fn extract_validated(
     response: anyhow::Result<derive::Response>,
 ) -> Result<(derive::response::Validated, Vec<flow::NetworkPort>), Error> {
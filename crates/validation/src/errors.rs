@@ -40,6 +40,8 @@ pub enum Error {
     },
     #[error("at least one storage mapping must be defined")]
     NoStorageMappings {},
+    #[error("collection {collection} has a kmsKey, but its mapped store {store} isn't an S3 store (kmsKey configures S3 SSE-KMS, and is supported only by S3 stores)")]
+    KmsKeyUnsupportedStore { collection: String, store: String },
     /// This comes from a validation that ensures users cannot specify the `endpoint` property of a Store that pertains
     /// to the 'default/' prefix. This is because the prefix is used to look up the AWS credentials for each store that
     /// uses a custom endpoint, but the 'default' profile is always used for Flow's own credentials. Therefore, allowing
@@ -138,6 +140,17 @@ pub enum Error {
         types: Vec<ShuffleType>,
         given_types: Vec<ShuffleType>,
     },
+    #[error("transform {transform} `onError` policy is supported only by SQLite derivations (TypeScript and connector derivations must handle failed documents within their lambdas)")]
+    OnErrorUnsupported { transform: String },
+    #[error(
+        "transform {transform} has `onError: deadLetter`, but is missing a `deadLetter` collection"
+    )]
+    DeadLetterMissing { transform: String },
+    #[error("transform {transform} `deadLetter` collection {collection} has logical partitions, which dead-letter collections may not have")]
+    DeadLetterPartitioned {
+        transform: String,
+        collection: String,
+    },
     #[error("transform {transform} is missing `shuffle`, which is now a required field (https://go.estuary.dev/LK19Py). If you're unsure of what shuffle to use, try `shuffle: any`")]
     ShuffleUnset { transform: String },
     #[error("connector returned an invalid generated file URL {url:?}")]
@@ -1390,6 +1390,34 @@ test://example/catalog.yaml:
     insta::assert_debug_snapshot!(errors);
 }
 
+#[test]
+fn test_kms_key_unsupported_stores() {
+    let errors = run_test_errors(
+        &GOLDEN,
+        r#"
+test://example/catalog.yaml:
+  storageMappings:
+    testing/:
+      stores:
+        - { provider: S3, bucket: data-bucket }
+        - { provider: GCS, bucket: gcs-bucket }
+        - provider: AZURE
+          storage_account_name: pizza
+          container_name: pepperoni
+          account_tenant_id: mozzarella
+        - { provider: CUSTOM, bucket: custom-bucket, endpoint: storage.example.com }
+
+test://example/int-string:
+  collections:
+    testing/int-string:
+      journals:
+        fragments:
+          kmsKey: arn:aws:kms:us-east-1:123456789012:key/a-key
+"#,
+    );
+    insta::assert_debug_snapshot!(errors);
+}
+
 #[test]
 fn test_collection_schema_string() {
     let errors = run_test_errors(
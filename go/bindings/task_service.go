@@ -12,7 +12,10 @@ import (
 	"os/signal"
 	"path"
 	"reflect"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 	"unsafe"
 
 	"github.com/estuary/flow/go/protocols/ops"
@@ -23,6 +26,72 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// SetConnectorKeepalive configures keepalives of the connector containers
+// started by TaskServices of this process. Connectors are sent HTTP/2 keepalive
+// pings every |interval|, and a connector which doesn't acknowledge a ping
+// within |timeout| is considered dead, which fails its streams. Capture and
+// materialize streams also receive keepalive frames from the container while
+// its connector is otherwise silent, and a stream which receives nothing for
+// |interval| plus |timeout| is failed. This detects half-open connections
+// (NAT timeouts, paused containers) within seconds, rather than relying on
+// TCP defaults. A zero |interval| disables keepalives.
+//
+// It must be called before TaskServices are started.
+func SetConnectorKeepalive(interval, timeout time.Duration) {
+	// The Rust runtime reads its configuration from the process environment.
+	os.Setenv("FLOW_RUNTIME_CONNECTOR_KEEPALIVE_MS", strconv.FormatInt(interval.Milliseconds(), 10))
+	os.Setenv("FLOW_RUNTIME_CONNECTOR_KEEPALIVE_TIMEOUT_MS", strconv.FormatInt(timeout.Milliseconds(), 10))
+}
+
+// SetConnectorAllowlist configures the environment variables, read-only mounts,
+// and tmpfs filesystems which tasks may inject into the connector containers
+// started by TaskServices of this process. |env| are permitted variable names,
+// where a name ending in '*' permits all variables having its prefix. |mounts|
+// are host directories within which mount sources are permitted, and |maxTmpfs|
+// is the maximum total size of tmpfs filesystems, in megabytes.
+//
+// It must be called before TaskServices are started.
+func SetConnectorAllowlist(env, mounts []string, maxTmpfs int64) {
+	// The Rust runtime reads its configuration from the process environment.
+	os.Setenv("FLOW_CONNECTOR_ALLOW_ENV", strings.Join(env, ","))
+	os.Setenv("FLOW_CONNECTOR_ALLOW_MOUNTS", strings.Join(mounts, ","))
+	os.Setenv("FLOW_CONNECTOR_MAX_TMPFS", strconv.FormatInt(maxTmpfs, 10))
+}
+
+// SetConnectorAddressFamily configures the address family used to reach the
+// connector containers started by TaskServices of this process, and by their
+// network tunnels. |family| is one of "any", "ipv4", or "ipv6", where "any"
+// prefers IPv4 and falls back to IPv6 if a container has no IPv4 address.
+//
+// It must be called before TaskServices are started.
+func SetConnectorAddressFamily(family string) {
+	// The Rust runtime reads its configuration from the process environment.
+	os.Setenv("FLOW_CONNECTOR_ADDRESS_FAMILY", family)
+}
+
+// SetMaxMessageSize configures the maximum size, in bytes, of messages which
+// are received from TaskServices of this process and their connectors.
+// Larger connector messages fail the stream which received them.
+//
+// It must be called before TaskServices are started.
+func SetMaxMessageSize(size int) {
+	maxMessageSize = size
+	// The Rust runtime reads its configuration from the process environment.
+	os.Setenv("FLOW_RUNTIME_MAX_MESSAGE_SIZE", strconv.Itoa(size))
+}
+
+// SetAirgapped enables air-gapped operation of this process and its children.
+// When air-gapped, connector images are never pulled and must be pre-loaded,
+// and catalog builds don't fetch remote (http:// or https://) resources.
+//
+// It's a no-op if |airgapped| is false, as air-gapped operation may also be
+// enabled by the caller's FLOW_AIRGAPPED environment variable.
+func SetAirgapped(airgapped bool) {
+	if airgapped {
+		os.Setenv("FLOW_AIRGAPPED", "true")
+	}
+}
+
 type TaskService struct {
 	config pr.TaskServiceConfig
 	cSvc   *C.TaskService
@@ -121,7 +190,7 @@ func NewTaskService(
 		// Instrument client for gRPC metric collection.
 		grpc.WithUnaryInterceptor(grpc_prometheus.UnaryClientInterceptor),
 		grpc.WithStreamInterceptor(grpc_prometheus.StreamClientInterceptor),
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize), grpc.MaxCallSendMsgSize(maxMessageSize)),
+		messageSizeOptions(),
 	)
 
 	if err != nil {
@@ -165,4 +234,10 @@ func (s *TaskService) err() error {
 	return err
 }
 
-const maxMessageSize = 1 << 26 // 64 MB.
+// messageSizeOptions returns the DialOption which bounds the size of messages
+// exchanged with a TaskService, as configured by SetMaxMessageSize.
+func messageSizeOptions() grpc.DialOption {
+	return grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize), grpc.MaxCallSendMsgSize(maxMessageSize))
+}
+
+var maxMessageSize = 1 << 26 // 64 MB.
@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
+	"os"
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/bradleyjkemp/cupaloy"
+	pcap "github.com/estuary/flow/go/protocols/capture"
 	"github.com/estuary/flow/go/protocols/catalog"
 	pd "github.com/estuary/flow/go/protocols/derive"
 	pf "github.com/estuary/flow/go/protocols/flow"
@@ -19,6 +23,10 @@ import (
 	"github.com/stretchr/testify/require"
 	pb "go.gazette.dev/core/broker/protocol"
 	pc "go.gazette.dev/core/consumer/protocol"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 func TestSimpleDerive(t *testing.T) {
@@ -134,6 +142,70 @@ func TestSimpleDerive(t *testing.T) {
 	require.NotEmpty(t, publisher.logs)
 }
 
+func TestMessageSizeLimit(t *testing.T) {
+	defer func(size int) { maxMessageSize = size }(maxMessageSize)
+	defer os.Unsetenv("FLOW_RUNTIME_MAX_MESSAGE_SIZE")
+
+	var listener, err = net.Listen("unix", path.Join(t.TempDir(), "sock"))
+	require.NoError(t, err)
+
+	var server = grpc.NewServer(grpc.MaxRecvMsgSize(math.MaxInt), grpc.MaxSendMsgSize(math.MaxInt))
+	pcap.RegisterConnectorServer(server, largeDiscoverServer{})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	var discover = func() (*pcap.Response, error) {
+		var conn, err = grpc.Dial("unix://"+listener.Addr().String(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			messageSizeOptions(),
+		)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		stream, err := pcap.NewConnectorClient(conn).Capture(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&pcap.Request{Discover: &pcap.Request_Discover{}}))
+
+		return stream.Recv()
+	}
+
+	// The response is larger than the default limit.
+	var _, recvErr = discover()
+	require.Equal(t, codes.ResourceExhausted, status.Code(recvErr))
+
+	// Once the limit is raised, the response is received in full.
+	SetMaxMessageSize(1 << 27)
+	require.Equal(t, "134217728", os.Getenv("FLOW_RUNTIME_MAX_MESSAGE_SIZE"))
+
+	response, err := discover()
+	require.NoError(t, err)
+	require.Len(t, response.Discovered.Bindings, largeDiscoverBindings)
+	require.Greater(t, response.ProtoSize(), 1<<26)
+}
+
+// largeDiscoverServer responds to Discover with bindings which total
+// more than 64MB.
+type largeDiscoverServer struct{}
+
+const largeDiscoverBindings = 1 << 10
+
+func (largeDiscoverServer) Capture(stream pcap.Connector_CaptureServer) error {
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	var schema = []byte(`"` + strings.Repeat("x", 1<<16) + `"`)
+
+	var discovered = new(pcap.Response_Discovered)
+	for i := 0; i != largeDiscoverBindings; i++ {
+		discovered.Bindings = append(discovered.Bindings, &pcap.Response_Discovered_Binding{
+			RecommendedName:    fmt.Sprintf("binding-%d", i),
+			ResourceConfigJson: []byte(`{}`),
+			DocumentSchemaJson: schema,
+		})
+	}
+	return stream.Send(&pcap.Response{Discovered: discovered})
+}
+
 type testPublisher struct {
 	t    *testing.T
 	logs int
@@ -0,0 +1,245 @@
+package flow
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"time"
+
+	pb "go.gazette.dev/core/broker/protocol"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AppendRetryClient is a pb.RoutedJournalClient which retries Append RPCs
+// that fail because brokers are unavailable, as happens during a broker
+// restart. The content of each Append is buffered up to a bound, and a failed
+// RPC is retried from its buffer with a jittered backoff until it succeeds or
+// its context is cancelled, so that a brief unavailability of brokers isn't
+// visible to the appending task.
+//
+// Appends having more content than the bound are not buffered, and their
+// failures are returned (to be retried by the client.AppendService).
+// Other RPCs, and Appends which fail with a broker Status, are not retried.
+type AppendRetryClient struct {
+	pb.RoutedJournalClient
+	maxBuffer int
+	observer  AppendRetryObserver
+}
+
+// AppendRetryObserver is notified of Appends retried by an AppendRetryClient.
+type AppendRetryObserver interface {
+	// AppendDegraded is called when an Append of |journal| under |ctx|
+	// has failed, and retries of the Append begin.
+	AppendDegraded(ctx context.Context, journal pb.Journal, err error)
+	// AppendRecovered is called when a retried Append of |journal|
+	// under |ctx| succeeds after |attempts| retries.
+	AppendRecovered(ctx context.Context, journal pb.Journal, attempts int, elapsed time.Duration)
+}
+
+var _ pb.RoutedJournalClient = (*AppendRetryClient)(nil)
+
+// NewAppendRetryClient returns an AppendRetryClient which buffers up to
+// |maxBuffer| bytes of the content of an Append, and which notifies
+// |observer| of Appends which are retried.
+func NewAppendRetryClient(client pb.RoutedJournalClient, maxBuffer int, observer AppendRetryObserver) *AppendRetryClient {
+	return &AppendRetryClient{
+		RoutedJournalClient: client,
+		maxBuffer:           maxBuffer,
+		observer:            observer,
+	}
+}
+
+// Append returns a Journal_AppendClient which buffers its AppendRequests,
+// and starts its RPC only once its response is read.
+func (c *AppendRetryClient) Append(ctx context.Context, opts ...grpc.CallOption) (pb.Journal_AppendClient, error) {
+	return &retryAppendClient{ctx: ctx, rc: c, opts: opts}, nil
+}
+
+type retryAppendClient struct {
+	ctx  context.Context
+	rc   *AppendRetryClient
+	opts []grpc.CallOption
+
+	buffered  []pb.AppendRequest      // Requests buffered for retries.
+	size      int                     // Buffered content bytes.
+	response  *pb.AppendResponse      // Response of the buffered Append, once read.
+	delivered bool                    // Was |response| read by the client?
+	stream    pb.Journal_AppendClient // Unbuffered RPC, if the buffer overflowed.
+}
+
+func (c *retryAppendClient) Send(req *pb.AppendRequest) error {
+	if c.stream != nil {
+		return c.stream.Send(req)
+	}
+
+	var clone = *req
+	clone.Content = append([]byte(nil), req.Content...)
+	c.buffered = append(c.buffered, clone)
+	c.size += len(req.Content)
+
+	if c.size <= c.rc.maxBuffer {
+		return nil
+	}
+
+	// The Append is too large to buffer. Start its RPC now with the requests
+	// buffered thus far, and pass further requests through to it.
+	var stream, err = c.rc.RoutedJournalClient.Append(c.ctx, c.opts...)
+	if err != nil {
+		return err
+	}
+	c.stream = stream
+
+	for i := range c.buffered {
+		if err = stream.Send(&c.buffered[i]); err != nil {
+			break
+		}
+	}
+	c.buffered = nil
+
+	return err
+}
+
+func (c *retryAppendClient) SendMsg(m interface{}) error {
+	return c.Send(m.(*pb.AppendRequest))
+}
+
+func (c *retryAppendClient) CloseSend() error {
+	if c.stream != nil {
+		return c.stream.CloseSend()
+	}
+	return nil // Sent upon each attempt of the buffered Append.
+}
+
+func (c *retryAppendClient) RecvMsg(m interface{}) error {
+	if c.stream != nil {
+		return c.stream.RecvMsg(m)
+	} else if c.delivered {
+		return io.EOF
+	} else if err := c.appendWithRetries(); err != nil {
+		return err
+	}
+
+	*m.(*pb.AppendResponse) = *c.response
+	c.delivered = true
+
+	return nil
+}
+
+func (c *retryAppendClient) CloseAndRecv() (*pb.AppendResponse, error) {
+	if c.stream != nil {
+		return c.stream.CloseAndRecv()
+	}
+	// The buffered Append was never started, and is aborted by dropping it.
+	c.buffered, c.delivered = nil, true
+	return nil, io.EOF
+}
+
+func (c *retryAppendClient) Header() (metadata.MD, error) {
+	if c.stream != nil {
+		return c.stream.Header()
+	}
+	return nil, nil
+}
+
+func (c *retryAppendClient) Trailer() metadata.MD {
+	if c.stream != nil {
+		return c.stream.Trailer()
+	}
+	return nil
+}
+
+func (c *retryAppendClient) Context() context.Context {
+	if c.stream != nil {
+		return c.stream.Context()
+	}
+	return c.ctx
+}
+
+// appendWithRetries performs the buffered Append, retrying it with a jittered
+// backoff while it fails because brokers are unavailable.
+func (c *retryAppendClient) appendWithRetries() error {
+	if len(c.buffered) == 0 {
+		return io.EOF // Nothing was sent.
+	}
+	var journal = c.buffered[0].Journal
+	var started = time.Now()
+
+	for attempt := 0; ; attempt++ {
+		var ctx = c.ctx
+		if attempt != 0 {
+			// Re-resolve the journal's route, which may have changed.
+			ctx = pb.WithDispatchItemRoute(c.ctx, c.rc, journal.String(), true)
+		}
+
+		var response, err = c.tryAppend(ctx)
+		if err == nil {
+			if attempt != 0 {
+				c.rc.observer.AppendRecovered(c.ctx, journal, attempt, time.Since(started))
+			}
+			c.response = response
+			return nil
+		} else if status.Code(err) != codes.Unavailable || c.ctx.Err() != nil {
+			return err
+		} else if attempt == 0 {
+			c.rc.observer.AppendDegraded(c.ctx, journal, err)
+		}
+
+		select {
+		case <-c.ctx.Done():
+			return c.ctx.Err()
+		case <-time.After(appendRetryBackoff(attempt)):
+		}
+	}
+}
+
+// tryAppend sends the buffered requests of the Append as a new RPC,
+// and returns its response.
+func (c *retryAppendClient) tryAppend(ctx context.Context) (*pb.AppendResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var stream, err = c.rc.RoutedJournalClient.Append(ctx, c.opts...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range c.buffered {
+		// EOF indicates a server-side error, which is read by RecvMsg.
+		if err = stream.Send(&c.buffered[i]); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	_ = stream.CloseSend()
+
+	var response = new(pb.AppendResponse)
+	if err = stream.RecvMsg(response); err != nil {
+		return nil, err
+	}
+	// Read EOF, as does client.Appender.
+	_ = stream.RecvMsg(new(pb.AppendResponse))
+
+	return response, nil
+}
+
+// appendRetryBackoff returns the jittered delay before retry |attempt|
+// of a failed Append.
+func appendRetryBackoff(attempt int) time.Duration {
+	var d time.Duration
+	switch attempt {
+	case 0:
+		d = time.Millisecond * 50
+	case 1, 2:
+		d = time.Millisecond * 250
+	case 3, 4:
+		d = time.Second
+	default:
+		d = 5 * time.Second
+	}
+	// Jitter by +/- 20%, so that many appends which failed together
+	// (e.x. due to a broker restart) don't retry in lockstep.
+	return d - d/5 + time.Duration(rand.Int63n(int64(2*d/5)+1))
+}
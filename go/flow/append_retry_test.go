@@ -0,0 +1,152 @@
+package flow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAppendRetryClientRetriesUnavailable(t *testing.T) {
+	var fake = &flakyJournalClient{failures: 2}
+	var observer = new(recordingAppendObserver)
+	var rc = NewAppendRetryClient(fake, 1024, observer)
+
+	var stream, err = rc.Append(context.Background())
+	require.NoError(t, err)
+
+	// Requests are buffered, and no RPC is started until a response is read.
+	require.NoError(t, stream.SendMsg(&pb.AppendRequest{Journal: "a/journal"}))
+	require.NoError(t, stream.SendMsg(&pb.AppendRequest{Content: []byte("hello")}))
+	require.NoError(t, stream.SendMsg(&pb.AppendRequest{}))
+	require.NoError(t, stream.CloseSend())
+	require.Equal(t, 0, fake.attempts)
+
+	// The RPC fails twice as Unavailable, and then succeeds.
+	var response pb.AppendResponse
+	require.NoError(t, stream.RecvMsg(&response))
+	require.Equal(t, pb.Status_OK, response.Status)
+	require.Equal(t, io.EOF, stream.RecvMsg(new(pb.AppendResponse)))
+
+	require.Equal(t, 3, fake.attempts)
+	require.Equal(t, "hello", string(fake.content))
+	require.Equal(t, []string{"degraded a/journal", "recovered a/journal after 2"}, observer.events)
+}
+
+func TestAppendRetryClientDoesNotRetryOtherErrors(t *testing.T) {
+	var fake = &flakyJournalClient{failures: 1, err: errors.New("whoops")}
+	var observer = new(recordingAppendObserver)
+	var rc = NewAppendRetryClient(fake, 1024, observer)
+
+	var stream, _ = rc.Append(context.Background())
+	require.NoError(t, stream.SendMsg(&pb.AppendRequest{Journal: "a/journal"}))
+	require.NoError(t, stream.SendMsg(&pb.AppendRequest{}))
+
+	require.EqualError(t, stream.RecvMsg(new(pb.AppendResponse)), "whoops")
+	require.Equal(t, 1, fake.attempts)
+	require.Empty(t, observer.events)
+}
+
+func TestAppendRetryClientPassesThroughLargeAppends(t *testing.T) {
+	var fake = &flakyJournalClient{failures: 1}
+	var observer = new(recordingAppendObserver)
+	var rc = NewAppendRetryClient(fake, 4, observer)
+
+	var stream, _ = rc.Append(context.Background())
+	require.NoError(t, stream.SendMsg(&pb.AppendRequest{Journal: "a/journal"}))
+	require.NoError(t, stream.SendMsg(&pb.AppendRequest{Content: []byte("hel")}))
+	require.Equal(t, 0, fake.attempts)
+
+	// Exceeding the buffer starts the RPC, and its failure isn't retried.
+	require.NoError(t, stream.SendMsg(&pb.AppendRequest{Content: []byte("lo")}))
+	require.Equal(t, 1, fake.attempts)
+	require.NoError(t, stream.SendMsg(&pb.AppendRequest{}))
+
+	require.Equal(t, codes.Unavailable, status.Code(stream.RecvMsg(new(pb.AppendResponse))))
+	require.Equal(t, "hello", string(fake.content))
+	require.Empty(t, observer.events)
+}
+
+func TestAppendRetryBackoffIsJittered(t *testing.T) {
+	for attempt, expect := range []time.Duration{
+		time.Millisecond * 50,
+		time.Millisecond * 250,
+		time.Millisecond * 250,
+		time.Second,
+		time.Second,
+		5 * time.Second,
+	} {
+		var d = appendRetryBackoff(attempt)
+		require.GreaterOrEqual(t, d, expect-expect/5)
+		require.LessOrEqual(t, d, expect+expect/5)
+	}
+}
+
+// flakyJournalClient fails its first |failures| Append RPCs with |err|,
+// or with an Unavailable status if |err| is nil.
+type flakyJournalClient struct {
+	pb.RoutedJournalClient
+	failures int
+	err      error
+	attempts int
+	content  []byte
+}
+
+func (c *flakyJournalClient) Append(ctx context.Context, _ ...grpc.CallOption) (pb.Journal_AppendClient, error) {
+	c.attempts++
+	c.content = nil
+
+	var err error
+	if c.attempts <= c.failures {
+		err = c.err
+		if err == nil {
+			err = status.Error(codes.Unavailable, "broker is restarting")
+		}
+	}
+	return &flakyAppendClient{c: c, err: err}, nil
+}
+
+func (c *flakyJournalClient) Route(context.Context, string) pb.Route { return pb.Route{Primary: -1} }
+
+type flakyAppendClient struct {
+	pb.Journal_AppendClient
+	c    *flakyJournalClient
+	err  error
+	done bool
+}
+
+func (s *flakyAppendClient) Send(req *pb.AppendRequest) error {
+	s.c.content = append(s.c.content, req.Content...)
+	return nil
+}
+
+func (s *flakyAppendClient) CloseSend() error { return nil }
+
+func (s *flakyAppendClient) RecvMsg(m interface{}) error {
+	if s.err != nil {
+		return s.err
+	} else if s.done {
+		return io.EOF
+	}
+	s.done = true
+	*m.(*pb.AppendResponse) = pb.AppendResponse{Status: pb.Status_OK}
+	return nil
+}
+
+type recordingAppendObserver struct{ events []string }
+
+func (o *recordingAppendObserver) AppendDegraded(_ context.Context, journal pb.Journal, _ error) {
+	o.events = append(o.events, "degraded "+journal.String())
+}
+
+func (o *recordingAppendObserver) AppendRecovered(_ context.Context, journal pb.Journal, attempts int, _ time.Duration) {
+	o.events = append(o.events, fmt.Sprintf("recovered %s after %d", journal, attempts))
+}
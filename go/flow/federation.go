@@ -0,0 +1,236 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "go.gazette.dev/core/broker/protocol"
+	"google.golang.org/grpc"
+)
+
+// FederatedJournalClient is a pb.RoutedJournalClient which dispatches the
+// RPCs of each journal to the broker cluster which serves its collection.
+// It allows a single consumer to read or write collections of several broker
+// clusters, as is required for staged migrations between clusters.
+//
+// Journal-specific RPCs (Read, Append, and ListFragments) are dispatched by the
+// collection of their journal. Apply is dispatched by the collection of its
+// changed journals, which must all be served by the same cluster. List is
+// dispatched to each cluster which may serve journals of its selector, and
+// their responses are merged. Replicate always uses the local client.
+type FederatedJournalClient struct {
+	local   pb.RoutedJournalClient
+	remotes []federatedRemote // Ordered on descending prefix length.
+}
+
+type federatedRemote struct {
+	prefix string
+	client pb.RoutedJournalClient
+}
+
+var _ pb.RoutedJournalClient = (*FederatedJournalClient)(nil)
+
+// NewFederatedJournalClient returns a FederatedJournalClient which dispatches
+// journals of the collections of |remotes| to their respective client,
+// and all other journals to |local|. Keys of |remotes| are either a collection
+// name, or a collection name prefix which ends in '/'.
+func NewFederatedJournalClient(
+	local pb.RoutedJournalClient,
+	remotes map[string]pb.RoutedJournalClient,
+) (*FederatedJournalClient, error) {
+	var out = &FederatedJournalClient{local: local}
+
+	for name, client := range remotes {
+		if name == "" {
+			return nil, fmt.Errorf("federated collection name cannot be empty")
+		} else if !strings.HasSuffix(name, "/") {
+			// A collection name matches journals of just that collection,
+			// and not other collections which it prefixes.
+			name += "/"
+		}
+		out.remotes = append(out.remotes, federatedRemote{prefix: name, client: client})
+	}
+	// Order on descending prefix length so that the most-specific prefix matches.
+	sort.Slice(out.remotes, func(i, j int) bool {
+		return len(out.remotes[i].prefix) > len(out.remotes[j].prefix)
+	})
+
+	return out, nil
+}
+
+// ClientFor returns the RoutedJournalClient which serves |journal|.
+func (c *FederatedJournalClient) ClientFor(journal pb.Journal) pb.RoutedJournalClient {
+	return c.client(c.indexFor(journal))
+}
+
+// indexFor returns the index of the remote which serves |journal|,
+// or -1 if |journal| is served by the local client.
+func (c *FederatedJournalClient) indexFor(journal pb.Journal) int {
+	var name = journal.StripMeta().String()
+
+	for i, r := range c.remotes {
+		if strings.HasPrefix(name, r.prefix) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *FederatedJournalClient) client(index int) pb.RoutedJournalClient {
+	if index == -1 {
+		return c.local
+	}
+	return c.remotes[index].client
+}
+
+// indicesFor returns the indices of clients which may serve journals
+// matched by |selector|, with the local client (if any) first.
+func (c *FederatedJournalClient) indicesFor(selector pb.LabelSelector) []int {
+	var selected = make([]bool, len(c.remotes)+1) // Offset by one, for the local client.
+
+	if names := selector.Include.ValuesOf("name"); len(names) != 0 {
+		for _, name := range names {
+			selected[c.indexFor(pb.Journal(name))+1] = true
+		}
+	} else if prefixes := selector.Include.ValuesOf("prefix"); len(prefixes) != 0 {
+		for _, prefix := range prefixes {
+			// Journals having |prefix| are served by the client of |prefix| itself,
+			// and by any remote of a collection which |prefix| covers.
+			selected[c.indexFor(pb.Journal(prefix))+1] = true
+
+			for i, r := range c.remotes {
+				if strings.HasPrefix(r.prefix, prefix) {
+					selected[i+1] = true
+				}
+			}
+		}
+	} else {
+		for i := range selected {
+			selected[i] = true
+		}
+	}
+
+	var out []int
+	for i, ok := range selected {
+		if ok {
+			out = append(out, i-1)
+		}
+	}
+	return out
+}
+
+// List journals of each cluster which may serve journals of the request
+// selector. Where there's more than one, their listed journals are merged
+// under the Header of the first cluster listed.
+func (c *FederatedJournalClient) List(ctx context.Context, in *pb.ListRequest, opts ...grpc.CallOption) (*pb.ListResponse, error) {
+	var indices = c.indicesFor(in.Selector)
+	if len(indices) == 1 {
+		return c.client(indices[0]).List(ctx, in, opts...)
+	}
+
+	var out *pb.ListResponse
+	for _, index := range indices {
+		var resp, err = c.client(index).List(ctx, in, opts...)
+		if err != nil {
+			return nil, err
+		} else if resp.Status != pb.Status_OK {
+			return resp, nil
+		} else if out == nil {
+			out = resp
+		} else {
+			out.Journals = append(out.Journals, resp.Journals...)
+		}
+	}
+	sort.Slice(out.Journals, func(i, j int) bool {
+		return out.Journals[i].Spec.Name < out.Journals[j].Spec.Name
+	})
+	return out, nil
+}
+
+// Apply changes to the cluster which serves their journals.
+// Changes of journals served by different clusters cannot be applied
+// together, as they cannot be applied atomically.
+func (c *FederatedJournalClient) Apply(ctx context.Context, in *pb.ApplyRequest, opts ...grpc.CallOption) (*pb.ApplyResponse, error) {
+	var index = -1
+
+	for i, change := range in.Changes {
+		var journal = changeJournal(change)
+
+		if next := c.indexFor(journal); i == 0 {
+			index = next
+		} else if next != index {
+			return nil, fmt.Errorf("cannot apply changes of journals served by different broker clusters (%s and %s)",
+				changeJournal(in.Changes[0]), journal)
+		}
+	}
+	return c.client(index).Apply(ctx, in, opts...)
+}
+
+func changeJournal(change pb.ApplyRequest_Change) pb.Journal {
+	if change.Upsert != nil {
+		return change.Upsert.Name
+	}
+	return change.Delete
+}
+
+func (c *FederatedJournalClient) Read(ctx context.Context, in *pb.ReadRequest, opts ...grpc.CallOption) (pb.Journal_ReadClient, error) {
+	return c.ClientFor(in.Journal).Read(ctx, in, opts...)
+}
+
+// Append returns a Journal_AppendClient which defers starting its RPC until
+// its first AppendRequest is sent, as only then is its journal known.
+func (c *FederatedJournalClient) Append(ctx context.Context, opts ...grpc.CallOption) (pb.Journal_AppendClient, error) {
+	return &federatedAppendClient{ctx: ctx, fc: c, opts: opts}, nil
+}
+
+func (c *FederatedJournalClient) Replicate(ctx context.Context, opts ...grpc.CallOption) (pb.Journal_ReplicateClient, error) {
+	return c.local.Replicate(ctx, opts...)
+}
+
+func (c *FederatedJournalClient) ListFragments(ctx context.Context, in *pb.FragmentsRequest, opts ...grpc.CallOption) (*pb.FragmentsResponse, error) {
+	return c.ClientFor(in.Journal).ListFragments(ctx, in, opts...)
+}
+
+// Route delegates to the DispatchRouter of the client which serves |item|,
+// which is a journal name.
+func (c *FederatedJournalClient) Route(ctx context.Context, item string) pb.Route {
+	return c.ClientFor(pb.Journal(item)).Route(ctx, item)
+}
+
+// UpdateRoute delegates to the DispatchRouter of the client which serves |item|.
+func (c *FederatedJournalClient) UpdateRoute(item string, route *pb.Route) {
+	c.ClientFor(pb.Journal(item)).UpdateRoute(item, route)
+}
+
+// IsNoopRouter returns whether the local client's DispatchRouter is a no-op.
+func (c *FederatedJournalClient) IsNoopRouter() bool {
+	return c.local.IsNoopRouter()
+}
+
+type federatedAppendClient struct {
+	ctx  context.Context
+	fc   *FederatedJournalClient
+	opts []grpc.CallOption
+
+	pb.Journal_AppendClient // Started upon first Send.
+}
+
+func (c *federatedAppendClient) Send(req *pb.AppendRequest) error {
+	if c.Journal_AppendClient == nil {
+		var stream, err = c.fc.ClientFor(req.Journal).Append(c.ctx, c.opts...)
+		if err != nil {
+			return err
+		}
+		c.Journal_AppendClient = stream
+	}
+	return c.Journal_AppendClient.Send(req)
+}
+
+func (c *federatedAppendClient) Context() context.Context {
+	if c.Journal_AppendClient == nil {
+		return c.ctx
+	}
+	return c.Journal_AppendClient.Context()
+}
@@ -0,0 +1,163 @@
+package flow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+	"google.golang.org/grpc"
+)
+
+func TestFederatedJournalClientRouting(t *testing.T) {
+	var local, remoteA, remoteB = newFakeJournalClient("local"), newFakeJournalClient("A"), newFakeJournalClient("B")
+
+	var fc, err = NewFederatedJournalClient(local, map[string]pb.RoutedJournalClient{
+		"acmeCo/source":                remoteA,
+		"acmeCo/nested/":               remoteA,
+		"acmeCo/nested/more/specific/": remoteB,
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		journal pb.Journal
+		expect  *fakeJournalClient
+	}{
+		{"acmeCo/source/pivot=00", remoteA},
+		{"acmeCo/source/pivot=00;derive/some/transform", remoteA},
+		{"acmeCo/sourceOther/pivot=00", local},
+		{"acmeCo/nested/one/pivot=00", remoteA},
+		{"acmeCo/nested/more/specific/two/pivot=00", remoteB},
+		{"acmeCo/derived/pivot=00", local},
+		{"recovery/capture/acmeCo/source/0000-0000", local},
+	} {
+		require.Equal(t, tc.expect, fc.ClientFor(tc.journal), string(tc.journal))
+	}
+
+	// Appends start their RPC against the serving client upon the first Send.
+	stream, err := fc.Append(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, remoteA.appends)
+
+	require.NoError(t, stream.Send(&pb.AppendRequest{Journal: "acmeCo/source/pivot=00"}))
+	require.NoError(t, stream.Send(&pb.AppendRequest{Content: []byte("hello")}))
+	require.Equal(t, 1, remoteA.appends)
+	require.Equal(t, 0, local.appends)
+
+	_, err = NewFederatedJournalClient(local, map[string]pb.RoutedJournalClient{"": remoteA})
+	require.EqualError(t, err, "federated collection name cannot be empty")
+}
+
+func TestFederatedJournalClientListAndApply(t *testing.T) {
+	var local, remote = newFakeJournalClient("local"), newFakeJournalClient("remote")
+	local.journals = []pb.Journal{"acmeCo/derived/pivot=00", "acmeCo/other/pivot=00"}
+	remote.journals = []pb.Journal{"acmeCo/source/pivot=00", "acmeCo/source/pivot=80"}
+
+	var fc, err = NewFederatedJournalClient(local, map[string]pb.RoutedJournalClient{
+		"acmeCo/source": remote,
+	})
+	require.NoError(t, err)
+
+	var list = func(selector pb.LabelSelector) []string {
+		local.lists, remote.lists = 0, 0
+
+		var resp, err = fc.List(context.Background(), &pb.ListRequest{Selector: selector})
+		require.NoError(t, err)
+
+		var out []string
+		for _, j := range resp.Journals {
+			out = append(out, j.Spec.Name.String())
+		}
+		return out
+	}
+
+	// A selector of a federated collection lists only from its remote cluster.
+	require.Equal(t, []string{"acmeCo/source/pivot=00", "acmeCo/source/pivot=80"},
+		list(pb.LabelSelector{Include: pb.MustLabelSet("prefix", "acmeCo/source/")}))
+	require.Equal(t, []int{0, 1}, []int{local.lists, remote.lists})
+
+	require.Equal(t, []string{"acmeCo/source/pivot=00"},
+		list(pb.LabelSelector{Include: pb.MustLabelSet("name", "acmeCo/source/pivot=00")}))
+	require.Equal(t, []int{0, 1}, []int{local.lists, remote.lists})
+
+	// A selector of a local collection lists only from the local cluster.
+	require.Equal(t, []string{"acmeCo/derived/pivot=00"},
+		list(pb.LabelSelector{Include: pb.MustLabelSet("prefix", "acmeCo/derived/")}))
+	require.Equal(t, []int{1, 0}, []int{local.lists, remote.lists})
+
+	// A selector which spans clusters lists from each, and merges their journals.
+	require.Equal(t, []string{
+		"acmeCo/derived/pivot=00",
+		"acmeCo/other/pivot=00",
+		"acmeCo/source/pivot=00",
+		"acmeCo/source/pivot=80",
+	}, list(pb.LabelSelector{Include: pb.MustLabelSet("prefix", "acmeCo/")}))
+	require.Equal(t, []int{1, 1}, []int{local.lists, remote.lists})
+
+	require.Len(t, list(pb.LabelSelector{}), 4)
+	require.Equal(t, []int{1, 1}, []int{local.lists, remote.lists})
+
+	// Applies are dispatched to the cluster which serves their journals.
+	_, err = fc.Apply(context.Background(), &pb.ApplyRequest{Changes: []pb.ApplyRequest_Change{
+		{Upsert: &pb.JournalSpec{Name: "acmeCo/source/pivot=00"}},
+		{Delete: "acmeCo/source/pivot=80"},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1}, []int{local.applies, remote.applies})
+
+	_, err = fc.Apply(context.Background(), &pb.ApplyRequest{Changes: []pb.ApplyRequest_Change{
+		{Delete: "acmeCo/derived/pivot=00"},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 1}, []int{local.applies, remote.applies})
+
+	// Changes which span clusters are rejected.
+	_, err = fc.Apply(context.Background(), &pb.ApplyRequest{Changes: []pb.ApplyRequest_Change{
+		{Delete: "acmeCo/derived/pivot=00"},
+		{Upsert: &pb.JournalSpec{Name: "acmeCo/source/pivot=00"}},
+	}})
+	require.EqualError(t, err, "cannot apply changes of journals served by different broker clusters (acmeCo/derived/pivot=00 and acmeCo/source/pivot=00)")
+	require.Equal(t, []int{1, 1}, []int{local.applies, remote.applies})
+}
+
+type fakeJournalClient struct {
+	pb.RoutedJournalClient
+	name     string
+	journals []pb.Journal
+	appends  int
+	lists    int
+	applies  int
+}
+
+func newFakeJournalClient(name string) *fakeJournalClient {
+	return &fakeJournalClient{name: name}
+}
+
+func (c *fakeJournalClient) Append(ctx context.Context, _ ...grpc.CallOption) (pb.Journal_AppendClient, error) {
+	c.appends++
+	return fakeAppendClient{}, nil
+}
+
+func (c *fakeJournalClient) List(ctx context.Context, req *pb.ListRequest, _ ...grpc.CallOption) (*pb.ListResponse, error) {
+	c.lists++
+
+	var resp = &pb.ListResponse{Status: pb.Status_OK}
+	var scratch pb.LabelSet
+
+	for _, journal := range c.journals {
+		var spec = pb.JournalSpec{Name: journal}
+		if req.Selector.Matches(pb.ExtractJournalSpecMetaLabels(&spec, scratch)) {
+			resp.Journals = append(resp.Journals, pb.ListResponse_Journal{Spec: spec})
+		}
+	}
+	return resp, nil
+}
+
+func (c *fakeJournalClient) Apply(ctx context.Context, req *pb.ApplyRequest, _ ...grpc.CallOption) (*pb.ApplyResponse, error) {
+	c.applies++
+	return &pb.ApplyResponse{Status: pb.Status_OK}, nil
+}
+
+type fakeAppendClient struct{ pb.Journal_AppendClient }
+
+func (fakeAppendClient) Send(*pb.AppendRequest) error { return nil }
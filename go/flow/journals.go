@@ -3,6 +3,10 @@ package flow
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.gazette.dev/core/broker"
@@ -11,8 +15,30 @@ import (
 
 // Journals is a type wrapper of a KeySpace that's a local mirror of Gazette
 // journals accross the cluster.
+//
+// Journals of federated collections are instead served by the remote broker
+// clusters of their Remotes, each having its own mirrored KeySpace.
 type Journals struct {
 	*keyspace.KeySpace
+	// Remotes of federated collections, ordered on descending prefix length.
+	Remotes []RemoteJournals
+
+	updates *journalsUpdates
+}
+
+// RemoteJournals is a KeySpace of the journals of a remote broker cluster,
+// which serves the collections having Prefix.
+type RemoteJournals struct {
+	*keyspace.KeySpace
+	// Collection name prefix, which ends in '/'.
+	Prefix string
+	// Etcd client of the remote broker cluster.
+	Etcd *clientv3.Client
+}
+
+type journalsUpdates struct {
+	mu sync.Mutex
+	ch chan struct{}
 }
 
 // NewJournalsKeySpace builds a KeySpace over all JournalSpecs managed by the
@@ -25,3 +51,118 @@ func NewJournalsKeySpace(ctx context.Context, etcd *clientv3.Client, root string
 	}
 	return journals, nil
 }
+
+// NewFederatedJournalsKeySpace builds Journals having a local KeySpace over
+// |etcd|, and a remote KeySpace for each collection of |remotes|, which are
+// loaded from their respective Etcd client. Keys of |remotes| are either a
+// collection name, or a collection name prefix which ends in '/'.
+// All clusters are expected to use the same |brokerRoot| Etcd prefix.
+func NewFederatedJournalsKeySpace(
+	ctx context.Context,
+	etcd *clientv3.Client,
+	remotes map[string]*clientv3.Client,
+	root string,
+) (Journals, error) {
+	var journals, err = NewJournalsKeySpace(ctx, etcd, root)
+	if err != nil {
+		return Journals{}, err
+	}
+
+	for name, remoteEtcd := range remotes {
+		if name == "" {
+			return Journals{}, fmt.Errorf("federated collection name cannot be empty")
+		} else if !strings.HasSuffix(name, "/") {
+			name += "/" // Match just the journals of this collection.
+		}
+		var remote = RemoteJournals{
+			KeySpace: broker.NewKeySpace(root),
+			Prefix:   name,
+			Etcd:     remoteEtcd,
+		}
+		if err := remote.KeySpace.Load(ctx, remoteEtcd, 0); err != nil {
+			return Journals{}, fmt.Errorf("initial load of %q for federated collection %q: %w", root, name, err)
+		}
+		journals.Remotes = append(journals.Remotes, remote)
+	}
+	// Order on descending prefix length so that the most-specific prefix matches.
+	sort.Slice(journals.Remotes, func(i, j int) bool {
+		return len(journals.Remotes[i].Prefix) > len(journals.Remotes[j].Prefix)
+	})
+	journals.updates = new(journalsUpdates)
+
+	return journals, nil
+}
+
+// For returns the KeySpace which mirrors journals of |collection|, and the Etcd
+// client of its remote broker cluster. The returned client is nil if journals
+// of |collection| are served by the local cluster.
+func (j Journals) For(collection string) (*keyspace.KeySpace, *clientv3.Client) {
+	var name = collection + "/"
+
+	for _, r := range j.Remotes {
+		if strings.HasPrefix(name, r.Prefix) {
+			return r.KeySpace, r.Etcd
+		}
+	}
+	return j.KeySpace, nil
+}
+
+// Watch the local KeySpace and all remote KeySpaces for updates,
+// until the context is cancelled or any watch fails.
+func (j Journals) Watch(ctx context.Context, etcd clientv3.Watcher) error {
+	var errCh = make(chan error, 1+len(j.Remotes))
+
+	go func() { errCh <- j.KeySpace.Watch(ctx, etcd) }()
+	for _, r := range j.Remotes {
+		go func(r RemoteJournals) {
+			var err = r.KeySpace.Watch(ctx, r.Etcd)
+			if err != nil && err != context.Canceled {
+				err = fmt.Errorf("watching journals of federated collection %q: %w", r.Prefix, err)
+			}
+			errCh <- err
+		}(r)
+	}
+	// All watches run until |ctx| is cancelled, so the first to return
+	// reflects either cancellation or a failure.
+	return <-errCh
+}
+
+// Update returns a channel which will signal on the next update of any of
+// the local or remote KeySpaces. As with KeySpace.Update, a write lock of
+// any KeySpace Mutex must not be held.
+func (j Journals) Update() <-chan struct{} {
+	if len(j.Remotes) == 0 {
+		return j.KeySpace.Update()
+	}
+
+	j.updates.mu.Lock()
+	defer j.updates.mu.Unlock()
+
+	if j.updates.ch != nil {
+		return j.updates.ch
+	}
+	var cases = []reflect.SelectCase{{
+		Dir:  reflect.SelectRecv,
+		Chan: reflect.ValueOf(j.KeySpace.Update()),
+	}}
+	for _, r := range j.Remotes {
+		cases = append(cases, reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(r.KeySpace.Update()),
+		})
+	}
+	var ch = make(chan struct{})
+	j.updates.ch = ch
+
+	go func(updates *journalsUpdates) {
+		_, _, _ = reflect.Select(cases)
+
+		updates.mu.Lock()
+		updates.ch = nil
+		updates.mu.Unlock()
+
+		close(ch)
+	}(j.updates)
+
+	return ch
+}
@@ -23,6 +23,7 @@ import (
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.gazette.dev/core/allocator"
 	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/keyspace"
 	"go.gazette.dev/core/message"
 )
 
@@ -52,6 +53,8 @@ type Mapper struct {
 
 // NewMapper builds and returns a new Mapper, which monitors from |journals|
 // and creates new partitions into the given |etcd| client.
+// Partitions of federated collections are instead created into the Etcd
+// of their remote broker cluster.
 // When creating partitions, it requires that |shardFQN| still exists, to ensure
 // that its creation of new partitions doesn't race with the tear-down of its
 // authority to create those partitions.
@@ -82,11 +85,13 @@ func (m *Mapper) Map(mappable message.Mappable) (pb.Journal, string, error) {
 		mappingBufferPool.Put(bufPtr)
 	}()
 
+	var ks, remoteEtcd = m.journals.For(msg.Spec.Name.String())
+
 	for attempt := 0; true; attempt++ {
 		// Pick a partition at the current Etcd |revision|.
-		m.journals.Mu.RLock()
-		var picked = m.pickPartition(logicalPrefix, hexKey)
-		m.journals.Mu.RUnlock()
+		ks.Mu.RLock()
+		var picked = pickPartition(ks, logicalPrefix, hexKey)
+		ks.Mu.RUnlock()
 
 		if picked != nil {
 			// Partition already exists (the common case).
@@ -109,19 +114,34 @@ func (m *Mapper) Map(mappable message.Mappable) (pb.Journal, string, error) {
 			panic(err) // Cannot fail because KeyBegin is always set.
 		}
 
-		var applyKey = allocator.ItemKey(m.journals.KeySpace, applySpec.Name.String())
+		var applyKey = allocator.ItemKey(ks, applySpec.Name.String())
 		applyBytes, err := applySpec.Marshal()
 		if err != nil {
 			panic(err) // Cannot fail because a custom marshaler isn't used.
 		}
 
-		// Conditionally apply the new specification in an Etcd transaction.
-		applyResponse, err := m.etcd.Txn(m.ctx).If(
+		var etcd, conditions = m.etcd, []clientv3.Cmp{
 			// Require that the partition doesn't already exist.
 			clientv3.Compare(clientv3.ModRevision(applyKey), "=", 0),
 			// Require that the shard FQN under which we're running has not been removed.
 			clientv3.Compare(clientv3.ModRevision(m.shardFQN), "!=", 0),
-		).Then(
+		}
+		if remoteEtcd != nil {
+			// Our shard FQN is a key of the local Etcd, and cannot be compared
+			// within a transaction of the remote cluster's Etcd. Check it
+			// beforehand instead, which narrows (but doesn't close) the race
+			// with the tear-down of our authority to create partitions.
+			if resp, err := m.etcd.Get(m.ctx, m.shardFQN, clientv3.WithCountOnly()); err != nil {
+				return "", "", fmt.Errorf("creating partition %s: %w", applySpec.Name, err)
+			} else if resp.Count == 0 {
+				return "", "", fmt.Errorf("creating partition %s: %w", applySpec.Name,
+					fmt.Errorf("shard spec doesn't exist"))
+			}
+			etcd, conditions = remoteEtcd, conditions[:1]
+		}
+
+		// Conditionally apply the new specification in an Etcd transaction.
+		applyResponse, err := etcd.Txn(m.ctx).If(conditions...).Then(
 			// Put the spec (which doesn't yet exist).
 			clientv3.OpPut(applyKey, string(applyBytes)),
 		).Else(
@@ -157,7 +177,7 @@ func (m *Mapper) Map(mappable message.Mappable) (pb.Journal, string, error) {
 		} else {
 			// On success, |applyResponse| always reference the revision of the
 			// applied Etcd transaction, which is guaranteed to produce an update
-			// into |m.Journals|.
+			// into |ks|.
 			readThrough = applyResponse.Header.Revision
 
 			log.WithFields(log.Fields{
@@ -168,9 +188,9 @@ func (m *Mapper) Map(mappable message.Mappable) (pb.Journal, string, error) {
 			createdPartitionsCounters.WithLabelValues(msg.Spec.Name.String()).Inc()
 		}
 
-		m.journals.Mu.RLock()
-		err = m.journals.WaitForRevision(m.ctx, readThrough)
-		m.journals.Mu.RUnlock()
+		ks.Mu.RLock()
+		err = ks.WaitForRevision(m.ctx, readThrough)
+		ks.Mu.RUnlock()
 
 		if err != nil {
 			return "", "", fmt.Errorf("awaiting journal revision '%d': %w", readThrough, err)
@@ -207,14 +227,14 @@ func appendHex32(b []byte, n uint32) []byte {
 	return strconv.AppendUint(b, uint64(n), 16)
 }
 
-func (m *Mapper) pickPartition(logicalPrefix []byte, hexKey []byte) *pb.JournalSpec {
+func pickPartition(ks *keyspace.KeySpace, logicalPrefix []byte, hexKey []byte) *pb.JournalSpec {
 	// This unsafe cast avoids |logicalPrefix| escaping to heap, as would otherwise
 	// happen due to it's use within a closure that crosses the sort.Search interface
 	// boundary. It's safe to do because the value is not retained or used beyond
-	// the journals.Prefixed call.
+	// the ks.Prefixed call.
 	var logicalPrefixStrUnsafe = *(*string)(unsafe.Pointer(&logicalPrefix))
 	// Map |logicalPrefix| into a set of physical partitions.
-	var physical = m.journals.Prefixed(logicalPrefixStrUnsafe)
+	var physical = ks.Prefixed(logicalPrefixStrUnsafe)
 
 	// Find the first physical partition having KeyEnd > hexKey.
 	// Note we're performing this comparasion in a hex-encoded space.
@@ -14,6 +14,8 @@ import (
 	"github.com/estuary/flow/go/protocols/fdb/tuple"
 	pf "github.com/estuary/flow/go/protocols/flow"
 	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/namespace"
 	"go.gazette.dev/core/allocator"
 	"go.gazette.dev/core/broker/client"
 	pb "go.gazette.dev/core/broker/protocol"
@@ -27,7 +29,7 @@ func TestPartitionPicking(t *testing.T) {
 	var fixtures = buildCombineFixtures(t)
 	var logicalPrefix, hexKey, b []byte
 
-	var m = NewMapper(context.Background(), nil, Journals{&keyspace.KeySpace{Root: "/root"}}, "")
+	var m = NewMapper(context.Background(), nil, Journals{KeySpace: &keyspace.KeySpace{Root: "/root"}}, "")
 
 	for ind, tc := range []struct {
 		expectPrefix string
@@ -65,28 +67,28 @@ func TestPartitionPicking(t *testing.T) {
 
 	require.Equal(t,
 		"a/collection/bar=32/foo=A/pivot=00",
-		m.pickPartition([]byte("/root/items/a/collection/bar=32/foo=A/"), []byte("23")).Name.String(),
+		pickPartition(m.journals.KeySpace, []byte("/root/items/a/collection/bar=32/foo=A/"), []byte("23")).Name.String(),
 	)
 	require.Equal(t,
 		"a/collection/bar=32/foo=A/pivot=77",
-		m.pickPartition([]byte("/root/items/a/collection/bar=32/foo=A/"), []byte("90")).Name.String(),
+		pickPartition(m.journals.KeySpace, []byte("/root/items/a/collection/bar=32/foo=A/"), []byte("90")).Name.String(),
 	)
 	require.Nil(t,
-		m.pickPartition([]byte("/root/items/a/collection/bar=32/foo=A/"), []byte("ef")), // Out of range.
+		pickPartition(m.journals.KeySpace, []byte("/root/items/a/collection/bar=32/foo=A/"), []byte("ef")), // Out of range.
 	)
 	require.Equal(t,
 		"a/collection/bar=42/foo=A/pivot=00",
-		m.pickPartition([]byte("/root/items/a/collection/bar=42/foo=A/"), []byte("ab")).Name.String(),
+		pickPartition(m.journals.KeySpace, []byte("/root/items/a/collection/bar=42/foo=A/"), []byte("ab")).Name.String(),
 	)
 
 	// Issue #255 regression cases.
 	require.Equal(t,
 		"a/collection/bar=32/foo=A/pivot=00",
-		m.pickPartition([]byte("/root/items/a/collection/bar=32/foo=A/"), []byte("77")).Name.String(),
+		pickPartition(m.journals.KeySpace, []byte("/root/items/a/collection/bar=32/foo=A/"), []byte("77")).Name.String(),
 	)
 	require.Equal(t,
 		"a/collection/bar=42/foo=A/pivot=00",
-		m.pickPartition([]byte("/root/items/a/collection/bar=42/foo=A/"), []byte("dd")).Name.String(),
+		pickPartition(m.journals.KeySpace, []byte("/root/items/a/collection/bar=42/foo=A/"), []byte("dd")).Name.String(),
 	)
 }
 
@@ -236,6 +238,69 @@ func buildCombineFixtures(t *testing.T) []Mappable {
 	}
 }
 
+func TestFederatedMappingIntegration(t *testing.T) {
+	var etcd = etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+	var ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	// Model the Etcd of a second broker cluster as a namespace of the test Etcd.
+	var remoteEtcd = clientv3.NewCtxClient(ctx)
+	remoteEtcd.KV = namespace.NewKV(etcd.KV, "/remote.cluster")
+	remoteEtcd.Watcher = namespace.NewWatcher(etcd.Watcher, "/remote.cluster")
+	remoteEtcd.Lease = namespace.NewLease(etcd.Lease, "/remote.cluster")
+
+	var journals, err = NewFederatedJournalsKeySpace(ctx, etcd,
+		map[string]*clientv3.Client{"a/collection": remoteEtcd}, "/broker.test")
+	require.NoError(t, err)
+	go journals.Watch(ctx, etcd)
+
+	// Create a shard FQN fixture, which gives authority to create partitions.
+	_, err = etcd.Put(ctx, "/the.shard", "")
+	require.NoError(t, err)
+
+	var fixtures = buildCombineFixtures(t)
+	var mapper = NewMapper(ctx, etcd, journals, "/the.shard")
+	var update = journals.Update()
+
+	journal, _, err := mapper.Map(fixtures[0])
+	require.NoError(t, err)
+	require.Equal(t, "a/collection/bar=%_32/foo=A/pivot=00", journal.String())
+	<-update // Signaled by the update of the remote KeySpace.
+
+	// The partition was created within the remote cluster, and not the local one.
+	var key = "/broker.test" + allocator.ItemsPrefix + journal.String()
+	resp, err := remoteEtcd.Get(ctx, key)
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	resp, err = etcd.Get(ctx, key)
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 0)
+
+	var remote, _ = journals.For("a/collection")
+	remote.Mu.RLock()
+	require.Len(t, remote.Prefixed(remote.Root+allocator.ItemsPrefix), 1)
+	remote.Mu.RUnlock()
+
+	journals.Mu.RLock()
+	require.Len(t, journals.Prefixed(journals.Root+allocator.ItemsPrefix), 0)
+	journals.Mu.RUnlock()
+
+	// A subsequent mapping of the partition picks it from the remote KeySpace.
+	journal, _, err = mapper.Map(fixtures[1])
+	require.NoError(t, err)
+	require.Equal(t, "a/collection/bar=%_32/foo=A/pivot=00", journal.String())
+
+	// Removal of the shard FQN from the local Etcd is still observed.
+	_, err = etcd.Delete(ctx, "/the.shard")
+	require.NoError(t, err)
+
+	fixtures[0].Partitions[0] = 52
+	_, _, err = mapper.Map(fixtures[0])
+	require.EqualError(t, err,
+		"creating partition a/collection/bar=%_52/foo=A/pivot=00: shard spec doesn't exist")
+}
+
 func TestHighwayHashRegression(t *testing.T) {
 	var cases = []struct {
 		expect uint32
@@ -0,0 +1,217 @@
+package flow
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// MemoryComponent enumerates the task components which hold accounted memory.
+type MemoryComponent int
+
+const (
+	// MemoryCombine is document bytes which have been handed to the task
+	// runtime for combining, and which are released when the transaction drains.
+	MemoryCombine MemoryComponent = iota
+	// MemoryReadAhead is ShuffleResponse bytes which have been read
+	// but are queued awaiting processing by the task.
+	MemoryReadAhead
+	// MemoryProxy is buffer bytes held by open connector network proxy connections.
+	MemoryProxy
+
+	numMemoryComponents
+)
+
+// String returns the name of the MemoryComponent.
+func (c MemoryComponent) String() string {
+	switch c {
+	case MemoryCombine:
+		return "combine"
+	case MemoryReadAhead:
+		return "readAhead"
+	case MemoryProxy:
+		return "proxy"
+	default:
+		return "invalid"
+	}
+}
+
+// MemoryUsage is a point-in-time snapshot of a MemoryBudget.
+type MemoryUsage struct {
+	Limit     int64 `json:"limit,omitempty"`
+	Combine   int64 `json:"combine"`
+	ReadAhead int64 `json:"readAhead"`
+	Proxy     int64 `json:"proxy"`
+}
+
+// Total bytes of MemoryUsage across all components.
+func (u MemoryUsage) Total() int64 { return u.Combine + u.ReadAhead + u.Proxy }
+
+// MemoryBudget accounts for the memory held by the components of a task,
+// and offers back-pressure as its total usage exceeds a configured limit.
+// A limit of zero is unlimited: usage is still accounted but never exceeded.
+//
+// A nil *MemoryBudget is valid and does nothing.
+type MemoryBudget struct {
+	mu     sync.Mutex
+	limit  int64
+	usage  [numMemoryComponents]int64
+	peak   int64
+	waitCh chan struct{} // Closed when usage next falls within the limit.
+}
+
+// NewMemoryBudget returns a MemoryBudget having the given byte |limit|.
+func NewMemoryBudget(limit int64) *MemoryBudget {
+	return &MemoryBudget{limit: limit}
+}
+
+// SetLimit updates the byte limit of the MemoryBudget.
+func (b *MemoryBudget) SetLimit(limit int64) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	b.limit = limit
+	b.maybeWakeLocked()
+	b.mu.Unlock()
+}
+
+// Acquire accounts |n| bytes as being held by component |c|.
+// It never blocks: callers which are able to back-pressure
+// should consult Exceeded or Wait.
+func (b *MemoryBudget) Acquire(c MemoryComponent, n int64) {
+	if b == nil || n == 0 {
+		return
+	}
+	b.mu.Lock()
+	b.usage[c] += n
+
+	if t := b.totalLocked(); t > b.peak {
+		b.peak = t
+	}
+	b.mu.Unlock()
+}
+
+// Release |n| bytes previously Acquired by component |c|.
+// Releasing more than was acquired is an accounting bug, which is logged,
+// and the usage of |c| is clamped to zero.
+func (b *MemoryBudget) Release(c MemoryComponent, n int64) {
+	if b == nil || n == 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.usage[c] < n {
+		log.WithFields(log.Fields{
+			"component": c.String(),
+			"released":  n,
+			"acquired":  b.usage[c],
+		}).Error("memory budget released more than was acquired (clamping to zero)")
+		n = b.usage[c]
+	}
+	b.usage[c] -= n
+	b.maybeWakeLocked()
+}
+
+// ReleaseAll releases all bytes held by component |c|.
+func (b *MemoryBudget) ReleaseAll(c MemoryComponent) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	b.usage[c] = 0
+	b.maybeWakeLocked()
+	b.mu.Unlock()
+}
+
+// Exceeded returns true if total usage is over the budget's limit.
+func (b *MemoryBudget) Exceeded() bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.exceededLocked()
+}
+
+// Wait blocks while the budget is exceeded and combined memory is held,
+// returning when usage falls within the limit or |ctx| is done.
+//
+// Only combined memory is reclaimed by closing the current transaction,
+// so Wait doesn't block if the budget is exceeded without any combined memory:
+// doing so could deadlock a reader which must progress to release
+// its read-ahead memory.
+func (b *MemoryBudget) Wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	for {
+		b.mu.Lock()
+		if !b.exceededLocked() || b.usage[MemoryCombine] == 0 {
+			b.mu.Unlock()
+			return nil
+		}
+		if b.waitCh == nil {
+			b.waitCh = make(chan struct{})
+		}
+		var waitCh = b.waitCh
+		b.mu.Unlock()
+
+		select {
+		case <-waitCh:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Usage returns a snapshot of the MemoryBudget.
+func (b *MemoryBudget) Usage() MemoryUsage {
+	if b == nil {
+		return MemoryUsage{}
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return MemoryUsage{
+		Limit:     b.limit,
+		Combine:   b.usage[MemoryCombine],
+		ReadAhead: b.usage[MemoryReadAhead],
+		Proxy:     b.usage[MemoryProxy],
+	}
+}
+
+// TakePeak returns the peak total usage since the last TakePeak,
+// and resets the peak to the current total usage.
+func (b *MemoryBudget) TakePeak() int64 {
+	if b == nil {
+		return 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var peak = b.peak
+	b.peak = b.totalLocked()
+	return peak
+}
+
+func (b *MemoryBudget) totalLocked() (out int64) {
+	for _, n := range b.usage {
+		out += n
+	}
+	return
+}
+
+func (b *MemoryBudget) exceededLocked() bool {
+	return b.limit != 0 && b.totalLocked() > b.limit
+}
+
+func (b *MemoryBudget) maybeWakeLocked() {
+	if b.waitCh != nil && (!b.exceededLocked() || b.usage[MemoryCombine] == 0) {
+		close(b.waitCh)
+		b.waitCh = nil
+	}
+}
@@ -0,0 +1,86 @@
+package flow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryBudgetAccounting(t *testing.T) {
+	var b = NewMemoryBudget(100)
+
+	b.Acquire(MemoryCombine, 40)
+	b.Acquire(MemoryReadAhead, 30)
+	b.Acquire(MemoryProxy, 20)
+	require.False(t, b.Exceeded())
+	require.Equal(t, MemoryUsage{Limit: 100, Combine: 40, ReadAhead: 30, Proxy: 20}, b.Usage())
+
+	b.Acquire(MemoryReadAhead, 20)
+	require.True(t, b.Exceeded())
+	require.Equal(t, int64(110), b.Usage().Total())
+
+	b.Release(MemoryReadAhead, 50)
+	require.False(t, b.Exceeded())
+	require.Equal(t, int64(110), b.TakePeak())
+	require.Equal(t, int64(60), b.TakePeak())
+
+	b.ReleaseAll(MemoryCombine)
+	require.Equal(t, MemoryUsage{Limit: 100, Proxy: 20}, b.Usage())
+
+	// Releasing more than was acquired clamps usage to zero.
+	b.Release(MemoryProxy, 21)
+	require.Equal(t, MemoryUsage{Limit: 100}, b.Usage())
+
+	// A zero limit is never exceeded.
+	b.SetLimit(0)
+	b.Acquire(MemoryCombine, 1<<40)
+	require.False(t, b.Exceeded())
+
+	// A nil budget is a no-op.
+	var nilBudget *MemoryBudget
+	nilBudget.Acquire(MemoryCombine, 10)
+	require.False(t, nilBudget.Exceeded())
+	require.NoError(t, nilBudget.Wait(context.Background()))
+	require.Equal(t, MemoryUsage{}, nilBudget.Usage())
+}
+
+func TestMemoryBudgetWait(t *testing.T) {
+	var b = NewMemoryBudget(100)
+	var ctx = context.Background()
+
+	// Not exceeded: doesn't block.
+	require.NoError(t, b.Wait(ctx))
+
+	// Exceeded, but without combined memory: doesn't block.
+	b.Acquire(MemoryReadAhead, 150)
+	require.NoError(t, b.Wait(ctx))
+
+	// Exceeded with combined memory: blocks until it's released.
+	b.Acquire(MemoryCombine, 10)
+
+	var doneCh = make(chan error)
+	go func() { doneCh <- b.Wait(ctx) }()
+
+	select {
+	case <-doneCh:
+		t.Fatal("Wait should block")
+	case <-time.After(10 * time.Millisecond):
+	}
+	b.ReleaseAll(MemoryCombine)
+	require.NoError(t, <-doneCh)
+
+	// Wait also returns on context cancellation.
+	b.Acquire(MemoryCombine, 10)
+	var cancelCtx, cancel = context.WithCancel(ctx)
+	go func() { doneCh <- b.Wait(cancelCtx) }()
+	cancel()
+	require.Equal(t, context.Canceled, <-doneCh)
+
+	// Or the limit being raised.
+	go func() { doneCh <- b.Wait(ctx) }()
+	time.Sleep(time.Millisecond)
+	b.SetLimit(1000)
+	require.NoError(t, <-doneCh)
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/client"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/consumer"
+	pc "go.gazette.dev/core/consumer/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+// specsArchive is a versioned backup of the JournalSpecs and ShardSpecs of
+// a data plane. Specs carry their labels, which includes the task and
+// collection labels used by the Flow runtime.
+type specsArchive struct {
+	Version  int              `json:"version"`
+	Created  time.Time        `json:"created"`
+	Journals []pb.JournalSpec `json:"journals"`
+	Shards   []pc.ShardSpec   `json:"shards"`
+}
+
+// specsArchiveVersion is the current version of the specsArchive format.
+// Restores of archives having a different version are refused.
+const specsArchiveVersion = 1
+
+type adminBackup struct {
+	Broker      mbp.ClientConfig      `group:"Broker" namespace:"broker" env-namespace:"BROKER"`
+	Consumer    mbp.ClientConfig      `group:"Consumer" namespace:"consumer" env-namespace:"CONSUMER"`
+	Output      string                `long:"output" default:"-" description:"Path of the archive to write, or '-' for stdout"`
+	Log         mbp.LogConfig         `group:"Logging" namespace:"log" env-namespace:"LOG"`
+	Diagnostics mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
+}
+
+func (cmd adminBackup) execute(ctx context.Context) error {
+	ctx = pb.WithDispatchDefault(ctx)
+
+	rjc, _, err := newJournalClient(ctx, cmd.Broker)
+	if err != nil {
+		return err
+	}
+	sc, _, err := newShardClient(ctx, cmd.Consumer)
+	if err != nil {
+		return err
+	}
+
+	var archive = specsArchive{
+		Version: specsArchiveVersion,
+		Created: time.Now().UTC(),
+	}
+
+	journals, err := client.ListAllJournals(ctx, rjc, pb.ListRequest{})
+	if err != nil {
+		return fmt.Errorf("listing journals: %w", err)
+	}
+	for _, j := range journals.Journals {
+		archive.Journals = append(archive.Journals, j.Spec)
+	}
+
+	shards, err := consumer.ListShards(ctx, sc, &pc.ListRequest{})
+	if err != nil {
+		return fmt.Errorf("listing shards: %w", err)
+	}
+	for _, s := range shards.Shards {
+		archive.Shards = append(archive.Shards, s.Spec)
+	}
+
+	var w = os.Stdout
+	if cmd.Output != "-" {
+		if w, err = os.Create(cmd.Output); err != nil {
+			return fmt.Errorf("creating archive: %w", err)
+		}
+		defer w.Close()
+	}
+
+	var enc = json.NewEncoder(w)
+	enc.SetIndent("", " ")
+
+	if err = enc.Encode(&archive); err != nil {
+		return fmt.Errorf("writing archive: %w", err)
+	}
+	if w != os.Stdout {
+		if err = w.Close(); err != nil {
+			return fmt.Errorf("closing archive: %w", err)
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"journals": len(archive.Journals),
+		"shards":   len(archive.Shards),
+		"output":   cmd.Output,
+	}).Info("wrote specs archive")
+
+	return nil
+}
+
+func (cmd adminBackup) Execute(_ []string) error {
+	defer mbp.InitDiagnosticsAndRecover(cmd.Diagnostics)()
+	mbp.InitLog(cmd.Log)
+	var ctx, cancelFn = context.WithTimeout(context.Background(), executeTimeout)
+	defer cancelFn()
+
+	log.WithFields(log.Fields{
+		"config":    cmd,
+		"version":   mbp.Version,
+		"buildDate": mbp.BuildDate,
+	}).Debug("flowctl configuration")
+	pb.RegisterGRPCDispatcher("local")
+
+	return cmd.execute(ctx)
+}
+
+type adminRestore struct {
+	Broker      mbp.ClientConfig      `group:"Broker" namespace:"broker" env-namespace:"BROKER"`
+	Consumer    mbp.ClientConfig      `group:"Consumer" namespace:"consumer" env-namespace:"CONSUMER"`
+	DryRun      bool                  `long:"dry-run" description:"Print actions that would be taken, but don't actually take them"`
+	Input       string                `long:"input" default:"-" description:"Path of the archive to restore, or '-' for stdin"`
+	OnConflict  string                `long:"on-conflict" default:"fail" choice:"fail" choice:"skip" choice:"overwrite" description:"Behavior when an archived spec already exists: fail the restore, skip the spec, or overwrite the existing spec"`
+	Log         mbp.LogConfig         `group:"Logging" namespace:"log" env-namespace:"LOG"`
+	Diagnostics mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
+}
+
+func (cmd adminRestore) execute(ctx context.Context) error {
+	ctx = pb.WithDispatchDefault(ctx)
+
+	var r io.Reader = os.Stdin
+	if cmd.Input != "-" {
+		var f, err = os.Open(cmd.Input)
+		if err != nil {
+			return fmt.Errorf("opening archive: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var archive specsArchive
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		return fmt.Errorf("reading archive: %w", err)
+	} else if archive.Version != specsArchiveVersion {
+		return fmt.Errorf("archive version %d is not supported (expected version %d)",
+			archive.Version, specsArchiveVersion)
+	}
+	for i := range archive.Journals {
+		if err := archive.Journals[i].Validate(); err != nil {
+			return fmt.Errorf("archived journal %s: %w", archive.Journals[i].Name, err)
+		}
+	}
+	for i := range archive.Shards {
+		if err := archive.Shards[i].Validate(); err != nil {
+			return fmt.Errorf("archived shard %s: %w", archive.Shards[i].Id, err)
+		}
+	}
+
+	rjc, _, err := newJournalClient(ctx, cmd.Broker)
+	if err != nil {
+		return err
+	}
+	sc, _, err := newShardClient(ctx, cmd.Consumer)
+	if err != nil {
+		return err
+	}
+
+	// Index the revisions of specs which already exist in the data plane.
+	var journalRevs = make(map[pb.Journal]int64)
+	var shardRevs = make(map[pc.ShardID]int64)
+
+	journals, err := client.ListAllJournals(ctx, rjc, pb.ListRequest{})
+	if err != nil {
+		return fmt.Errorf("listing journals: %w", err)
+	}
+	for _, j := range journals.Journals {
+		journalRevs[j.Spec.Name] = j.ModRevision
+	}
+	shards, err := consumer.ListShards(ctx, sc, &pc.ListRequest{})
+	if err != nil {
+		return fmt.Errorf("listing shards: %w", err)
+	}
+	for _, s := range shards.Shards {
+		shardRevs[s.Spec.Id] = s.ModRevision
+	}
+
+	var journalChanges []pb.ApplyRequest_Change
+	var shardChanges []pc.ApplyRequest_Change
+
+	for i := range archive.Journals {
+		var spec = &archive.Journals[i]
+		var rev, exists = journalRevs[spec.Name]
+
+		if exists && cmd.OnConflict == "fail" {
+			return fmt.Errorf("journal %s already exists (use --on-conflict to skip or overwrite it)", spec.Name)
+		} else if exists && cmd.OnConflict == "skip" {
+			log.WithField("name", spec.Name).Info("skipping existing journal")
+			continue
+		}
+		journalChanges = append(journalChanges, pb.ApplyRequest_Change{
+			Upsert:            spec,
+			ExpectModRevision: rev,
+		})
+	}
+	for i := range archive.Shards {
+		var spec = &archive.Shards[i]
+		var rev, exists = shardRevs[spec.Id]
+
+		if exists && cmd.OnConflict == "fail" {
+			return fmt.Errorf("shard %s already exists (use --on-conflict to skip or overwrite it)", spec.Id)
+		} else if exists && cmd.OnConflict == "skip" {
+			log.WithField("id", spec.Id).Info("skipping existing shard")
+			continue
+		}
+		shardChanges = append(shardChanges, pc.ApplyRequest_Change{
+			Upsert:            spec,
+			ExpectModRevision: rev,
+		})
+	}
+
+	if err = applyAllChanges(ctx, sc, rjc, shardChanges, journalChanges, cmd.DryRun); err == errNoChangesToApply {
+		log.Info("there are no changes to apply")
+	} else if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"journals": len(journalChanges),
+		"shards":   len(shardChanges),
+		"created":  archive.Created,
+		"dryRun":   cmd.DryRun,
+	}).Info("restored specs archive")
+
+	return nil
+}
+
+func (cmd adminRestore) Execute(_ []string) error {
+	defer mbp.InitDiagnosticsAndRecover(cmd.Diagnostics)()
+	mbp.InitLog(cmd.Log)
+	var ctx, cancelFn = context.WithTimeout(context.Background(), executeTimeout)
+	defer cancelFn()
+
+	log.WithFields(log.Fields{
+		"config":    cmd,
+		"version":   mbp.Version,
+		"buildDate": mbp.BuildDate,
+	}).Debug("flowctl configuration")
+	pb.RegisterGRPCDispatcher("local")
+
+	return cmd.execute(ctx)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/estuary/flow/go/bindings"
 	"github.com/estuary/flow/go/protocols/catalog"
 	pf "github.com/estuary/flow/go/protocols/flow"
+	"github.com/estuary/flow/go/telemetry"
 	"github.com/fatih/color"
 	log "github.com/sirupsen/logrus"
 	pb "go.gazette.dev/core/broker/protocol"
@@ -18,14 +19,16 @@ import (
 )
 
 type apiBuild struct {
-	BuildID     string                `long:"build-id" required:"true" description:"ID of this build"`
-	BuildDB     string                `long:"build-db" required:"true" description:"Output build database"`
-	FileRoot    string                `long:"fs-root" default:"/" description:"Filesystem root of fetched file:// resources"`
-	Network     string                `long:"network" description:"The Docker network that connector containers are given access to."`
-	Source      string                `long:"source" required:"true" description:"Catalog source file or URL to build"`
-	SourceType  string                `long:"source-type" default:"catalog" choice:"catalog" choice:"jsonSchema" description:"Type of the source to build."`
-	Log         mbp.LogConfig         `group:"Logging" namespace:"log" env-namespace:"LOG"`
-	Diagnostics mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
+	Airgapped     bool                  `long:"airgapped" env:"FLOW_AIRGAPPED" description:"Run without access to external resources. Connector images are never pulled and must be pre-loaded, and remote catalog resources are not fetched"`
+	AddressFamily string                `long:"address-family" env:"FLOW_CONNECTOR_ADDRESS_FAMILY" default:"any" choice:"any" choice:"ipv4" choice:"ipv6" description:"Address family used to reach connector containers and the SSH endpoints of their network tunnels. 'any' prefers IPv4 and falls back to IPv6"`
+	BuildID       string                `long:"build-id" required:"true" description:"ID of this build"`
+	BuildDB       string                `long:"build-db" required:"true" description:"Output build database"`
+	FileRoot      string                `long:"fs-root" default:"/" description:"Filesystem root of fetched file:// resources"`
+	Network       string                `long:"network" description:"The Docker network that connector containers are given access to."`
+	Source        string                `long:"source" required:"true" description:"Catalog source file or URL to build"`
+	SourceType    string                `long:"source-type" default:"catalog" choice:"catalog" choice:"jsonSchema" description:"Type of the source to build."`
+	Log           mbp.LogConfig         `group:"Logging" namespace:"log" env-namespace:"LOG"`
+	Diagnostics   mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
 }
 
 func (cmd apiBuild) execute(ctx context.Context) error {
@@ -37,6 +40,9 @@ func (cmd apiBuild) execute(ctx context.Context) error {
 		sourceType = pf.ContentType_JSON_SCHEMA
 	}
 
+	bindings.SetAirgapped(cmd.Airgapped)
+	bindings.SetConnectorAddressFamily(cmd.AddressFamily)
+
 	var args = bindings.BuildArgs{
 		Context: ctx,
 		BuildAPI_Config: pf.BuildAPI_Config{
@@ -48,9 +54,11 @@ func (cmd apiBuild) execute(ctx context.Context) error {
 		},
 		FileRoot: cmd.FileRoot,
 	}
+	var started = time.Now()
 	if err := bindings.BuildCatalog(args); err != nil {
 		return err
 	}
+	telemetry.Observe("build", time.Since(started))
 
 	// We manually open the database, rather than use catalog.Extract,
 	// because we explicitly check for and handle errors.
@@ -1,11 +1,16 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math"
 	"os"
+	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,36 +24,110 @@ import (
 	"github.com/sirupsen/logrus"
 	pb "go.gazette.dev/core/broker/protocol"
 	mbp "go.gazette.dev/core/mainboilerplate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v3"
 )
 
 type apiDiscover struct {
-	Log         mbp.LogConfig         `group:"Logging" namespace:"log" env-namespace:"LOG"`
-	Diagnostics mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
-	Image       string                `long:"image" required:"true" description:"Docker image of the connector to use"`
-	Network     string                `long:"network" description:"The Docker network that connector containers are given access to."`
-	Name        string                `long:"name" description:"The Docker container name."`
-	Config      string                `long:"config" description:"Path to the connector endpoint configuration"`
-	Output      string                `long:"output" choice:"json" choice:"proto" default:"json"`
+	Airgapped       bool                  `long:"airgapped" env:"FLOW_AIRGAPPED" description:"Run without access to external resources. Connector images are never pulled and must be pre-loaded, and remote catalog resources are not fetched"`
+	AddressFamily   string                `long:"address-family" env:"FLOW_CONNECTOR_ADDRESS_FAMILY" default:"any" choice:"any" choice:"ipv4" choice:"ipv6" description:"Address family used to reach the connector container and the SSH endpoint of its network tunnel. 'any' prefers IPv4 and falls back to IPv6"`
+	Log             mbp.LogConfig         `group:"Logging" namespace:"log" env-namespace:"LOG"`
+	Diagnostics     mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
+	Image           string                `long:"image" required:"true" description:"Docker image of the connector to use"`
+	Network         string                `long:"network" description:"The Docker network that connector containers are given access to."`
+	Name            string                `long:"name" description:"The Docker container name."`
+	Config          string                `long:"config" description:"Path to the connector endpoint configuration"`
+	Output          string                `long:"output" choice:"json" choice:"proto" default:"json"`
+	MaxMessageSize  int                   `long:"max-message-size" env:"FLOW_MAX_MESSAGE_SIZE" default:"67108864" description:"Maximum size, in bytes, of a message of the connector's Discover response. Large responses may be sent as many messages"`
+	PageSize        int                   `long:"page-size" default:"0" description:"If non-zero, output at most this many discovered bindings"`
+	Page            int                   `long:"page" default:"0" description:"Zero-based page of discovered bindings to output, if --page-size is set"`
+	IncludeBindings []string              `long:"include-bindings" description:"Glob pattern of recommended binding names to include, such as 'public/orders_*'. May be repeated. If set, only bindings matching a pattern are output"`
+	ExcludeBindings []string              `long:"exclude-bindings" description:"Glob pattern of recommended binding names to exclude. May be repeated. Exclusions apply after inclusions"`
+	Env             []string              `long:"env" description:"Environment variable of the connector container, as 'KEY=VALUE'. May be repeated. The variable must be permitted by FLOW_CONNECTOR_ALLOW_ENV"`
+	Mounts          []string              `long:"mount" description:"Read-only file mount of the connector container, as 'SOURCE:TARGET'. May be repeated. The source must be within a directory of FLOW_CONNECTOR_ALLOW_MOUNTS"`
+	Tmpfs           []string              `long:"tmpfs" description:"tmpfs filesystem of the connector container, as 'TARGET:SIZE' where SIZE is in megabytes. May be repeated. Total sizes may not exceed FLOW_CONNECTOR_MAX_TMPFS"`
 }
 
-func (cmd apiDiscover) execute(ctx context.Context) (*pc.Response_Discovered, error) {
+type connectorMount struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+type connectorTmpfs struct {
+	Target string `json:"target"`
+	Size   uint32 `json:"size"`
+}
+
+// parseInjections parses the --env, --mount, and --tmpfs flags of the command.
+// Whether injections are permitted is verified by the runtime when the
+// connector container is started.
+func (cmd apiDiscover) parseInjections() (env map[string]string, mounts []connectorMount, tmpfs []connectorTmpfs, err error) {
+	for _, kv := range cmd.Env {
+		var key, value, ok = strings.Cut(kv, "=")
+		if !ok || key == "" {
+			return nil, nil, nil, fmt.Errorf("invalid --env %q (expected 'KEY=VALUE')", kv)
+		}
+		if env == nil {
+			env = make(map[string]string)
+		}
+		env[key] = value
+	}
+	for _, m := range cmd.Mounts {
+		var source, target, ok = strings.Cut(m, ":")
+		if !ok || source == "" || target == "" {
+			return nil, nil, nil, fmt.Errorf("invalid --mount %q (expected 'SOURCE:TARGET')", m)
+		}
+		mounts = append(mounts, connectorMount{Source: source, Target: target})
+	}
+	for _, t := range cmd.Tmpfs {
+		var target, size, ok = strings.Cut(t, ":")
+		if !ok || target == "" {
+			return nil, nil, nil, fmt.Errorf("invalid --tmpfs %q (expected 'TARGET:SIZE')", t)
+		}
+		var mb, err = strconv.ParseUint(size, 10, 32)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid --tmpfs size %q: %w", size, err)
+		}
+		tmpfs = append(tmpfs, connectorTmpfs{Target: target, Size: uint32(mb)})
+	}
+	return env, mounts, tmpfs, nil
+}
+
+// execute runs the connector's Discover, and calls |onChunk| with each
+// Discovered message of its response, in order.
+func (cmd apiDiscover) execute(ctx context.Context, onChunk func(*pc.Response_Discovered) error) error {
 	var config, err = readConfig(cmd.Config)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	env, mounts, tmpfs, err := cmd.parseInjections()
+	if err != nil {
+		return err
 	}
 
 	spec, err := json.Marshal(struct {
-		Image  string          `json:"image"`
-		Config json.RawMessage `json:"config"`
+		Image  string            `json:"image"`
+		Config json.RawMessage   `json:"config"`
+		Env    map[string]string `json:"env,omitempty"`
+		Mounts []connectorMount  `json:"mounts,omitempty"`
+		Tmpfs  []connectorTmpfs  `json:"tmpfs,omitempty"`
 	}{
 		Image:  cmd.Image,
 		Config: config,
+		Env:    env,
+		Mounts: mounts,
+		Tmpfs:  tmpfs,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
+	bindings.SetAirgapped(cmd.Airgapped)
+	bindings.SetConnectorAddressFamily(cmd.AddressFamily)
+	bindings.SetMaxMessageSize(cmd.MaxMessageSize)
+
 	svc, err := bindings.NewTaskService(
 		pr.TaskServiceConfig{
 			TaskName:         cmd.Name,
@@ -58,13 +137,13 @@ func (cmd apiDiscover) execute(ctx context.Context) (*pc.Response_Discovered, er
 		ops.NewLocalPublisher(ops.ShardLabeling{TaskName: cmd.Name}),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create task service: %w", err)
+		return fmt.Errorf("failed to create task service: %w", err)
 	}
 	defer svc.Drop()
 
 	stream, err := pfc.NewConnectorClient(svc.Conn()).Capture(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("starting capture: %w", err)
+		return fmt.Errorf("starting capture: %w", err)
 	}
 	stream.Send(&pfc.Request{
 		Discover: &pc.Request_Discover{
@@ -74,11 +153,146 @@ func (cmd apiDiscover) execute(ctx context.Context) (*pc.Response_Discovered, er
 	})
 	stream.CloseSend()
 
-	response, err := stream.Recv()
-	if err != nil {
-		return nil, err
+	// The response may be sent as a sequence of Discovered chunks,
+	// and is complete upon EOF.
+	for chunks := 0; ; chunks++ {
+		var response, err = stream.Recv()
+		if err == io.EOF && chunks != 0 {
+			return nil
+		} else if err == io.EOF {
+			return fmt.Errorf("connector closed its response without sending Discovered")
+		} else if isMessageTooLarge(err) {
+			return fmt.Errorf("a message of the connector's Discover response is larger than the maximum message size of %d bytes (it may be raised using --max-message-size): %w", cmd.MaxMessageSize, err)
+		} else if err != nil {
+			return err
+		} else if response.Discovered == nil {
+			return fmt.Errorf("connector sent an unexpected response (expected Discovered)")
+		}
+
+		if err = onChunk(response.Discovered); err != nil {
+			return err
+		}
+	}
+}
+
+// isMessageTooLarge returns true if |err| is due to a gRPC message which
+// exceeded its maximum size, as enforced by either gRPC-go or the Rust runtime.
+func isMessageTooLarge(err error) bool {
+	if err == nil {
+		return false
+	} else if status.Code(err) == codes.ResourceExhausted {
+		return true
+	}
+	return strings.Contains(err.Error(), "decoded message length too large")
+}
+
+// discoveredOutput writes the bindings of Discovered chunks as they're
+// received, which avoids holding the entirety of a large response in memory.
+// Bindings are filtered by |include| and |exclude| patterns, and then
+// only those within the [begin, end) range of matched bindings are written.
+type discoveredOutput struct {
+	w                *bufio.Writer
+	format           string // "json" or "proto".
+	include, exclude []string
+	begin, end       int
+
+	started bool // Whether output has begun.
+	total   int  // Number of received bindings.
+	matched int  // Number of bindings which matched patterns.
+	written int  // Number of written bindings.
+}
+
+func (o *discoveredOutput) write(chunk *pc.Response_Discovered) error {
+	o.total += len(chunk.Bindings)
+
+	var page []*pc.Response_Discovered_Binding
+	for _, binding := range filterBindings(chunk.Bindings, o.include, o.exclude) {
+		if o.matched >= o.begin && o.matched < o.end {
+			page = append(page, binding)
+		}
+		o.matched++
 	}
-	return response.Discovered, nil
+
+	if o.format == "json" {
+		var m = jsonpb.Marshaler{}
+
+		if !o.started {
+			o.w.WriteString(`{"bindings":[`)
+		}
+		for _, binding := range page {
+			if o.written != 0 {
+				o.w.WriteByte(',')
+			}
+			if err := m.Marshal(o.w, binding); err != nil {
+				return fmt.Errorf("encoding binding %q: %w", binding.RecommendedName, err)
+			}
+			o.written++
+		}
+	} else if o.format == "proto" {
+		// The encodings of messages concatenate into the encoding of their
+		// merge, and the repeated bindings of each chunk are appended in order.
+		var b, err = (&pc.Response_Discovered{Bindings: page}).Marshal()
+		if err != nil {
+			return err
+		}
+		o.w.Write(b)
+		o.written += len(page)
+	} else {
+		panic(o.format)
+	}
+	o.started = true
+
+	return nil
+}
+
+func (o *discoveredOutput) close() error {
+	if o.format == "json" {
+		if !o.started {
+			o.w.WriteString(`{"bindings":[`)
+		}
+		o.w.WriteString("]}\n")
+	}
+	o.started = true
+
+	return o.w.Flush()
+}
+
+// filterBindings returns the |bindings| having a recommended name which
+// matches any |include| pattern (or all bindings, if |include| is empty)
+// and which don't match any |exclude| pattern. Patterns have the syntax of
+// path.Match, and are assumed to be valid.
+func filterBindings(bindings []*pc.Response_Discovered_Binding, include, exclude []string) []*pc.Response_Discovered_Binding {
+	var matchesAny = func(patterns []string, name string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, name); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	var out []*pc.Response_Discovered_Binding
+	for _, binding := range bindings {
+		if len(include) != 0 && !matchesAny(include, binding.RecommendedName) {
+			continue
+		} else if matchesAny(exclude, binding.RecommendedName) {
+			continue
+		}
+		out = append(out, binding)
+	}
+	return out
+}
+
+// pageBounds returns the [begin, end) range of the zero-based |page| having
+// |size|, which is empty at math.MaxInt if the page is beyond that index.
+// If |size| is zero, the range spans all indices.
+func pageBounds(page, size int) (begin, end int) {
+	if size == 0 {
+		return 0, math.MaxInt
+	} else if page >= math.MaxInt/size {
+		return math.MaxInt, math.MaxInt // `(page+1) * size` would overflow.
+	}
+	return page * size, (page + 1) * size
 }
 
 func (cmd apiDiscover) Execute(_ []string) error {
@@ -119,28 +333,60 @@ func (cmd apiDiscover) Execute(_ []string) error {
 	}).Debug("flowctl configuration")
 	pb.RegisterGRPCDispatcher("local")
 
-	var resp, err = cmd.execute(ctx)
+	if cmd.PageSize < 0 || cmd.Page < 0 {
+		return fmt.Errorf("--page and --page-size cannot be negative")
+	} else if cmd.MaxMessageSize <= 0 {
+		return fmt.Errorf("--max-message-size must be positive")
+	}
+	for _, pattern := range append(append([]string(nil), cmd.IncludeBindings...), cmd.ExcludeBindings...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid binding pattern %q: %w", pattern, err)
+		}
+	}
+
+	var out = &discoveredOutput{
+		w:       bufio.NewWriter(os.Stdout),
+		format:  cmd.Output,
+		include: cmd.IncludeBindings,
+		exclude: cmd.ExcludeBindings,
+	}
+	out.begin, out.end = pageBounds(cmd.Page, cmd.PageSize)
+
+	var err = cmd.execute(ctx, out.write)
+	if err == nil {
+		err = out.close()
+	}
 
 	if errors.Is(err, context.DeadlineExceeded) {
 		err = fmt.Errorf("Timeout while communicating with the endpoint. Please verify any address or firewall settings.")
 	}
-	if err != nil {
+	if err != nil && !out.started {
 		fmt.Println(err.Error()) // Write to stdout so the agent can map into a draft error.
 		return err
+	} else if err != nil {
+		return err // Don't mix the error into output which has begun.
 	}
 
-	if cmd.Output == "json" {
-		return (&jsonpb.Marshaler{}).Marshal(os.Stdout, resp)
-	} else if cmd.Output == "proto" {
-		var b, err = resp.Marshal()
-		if err != nil {
-			return err
+	if len(cmd.IncludeBindings) != 0 || len(cmd.ExcludeBindings) != 0 {
+		logrus.WithFields(logrus.Fields{
+			"bindings": out.matched,
+			"total":    out.total,
+		}).Info("filtered discovered bindings")
+	}
+	if cmd.PageSize != 0 {
+		var pages = out.matched / cmd.PageSize
+		if out.matched%cmd.PageSize != 0 {
+			pages++
 		}
-		_, err = os.Stdout.Write(b)
-		return err
-	} else {
-		panic(cmd.Output)
+		logrus.WithFields(logrus.Fields{
+			"page":     cmd.Page,
+			"pages":    pages,
+			"bindings": out.written,
+			"total":    out.matched,
+		}).Info("output page of discovered bindings")
 	}
+
+	return nil
 }
 
 func readConfig(path string) (raw json.RawMessage, err error) {
@@ -0,0 +1,363 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/client"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/consumer"
+	pc "go.gazette.dev/core/consumer/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdMigratePlane struct {
+	FromBroker   mbp.ClientConfig      `group:"Source Broker" namespace:"from-broker" env-namespace:"FROM_BROKER"`
+	FromConsumer mbp.ClientConfig      `group:"Source Consumer" namespace:"from-consumer" env-namespace:"FROM_CONSUMER"`
+	ToBroker     mbp.ClientConfig      `group:"Target Broker" namespace:"to-broker" env-namespace:"TO_BROKER"`
+	ToConsumer   mbp.ClientConfig      `group:"Target Consumer" namespace:"to-consumer" env-namespace:"TO_CONSUMER"`
+	DryRun       bool                  `long:"dry-run" description:"Print the runbook of steps that would be taken, but don't actually take them"`
+	Timeout      time.Duration         `long:"timeout" default:"30m" description:"Maximum duration of the migration"`
+	Log          mbp.LogConfig         `group:"Logging" namespace:"log" env-namespace:"LOG"`
+	Diagnostics  mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
+}
+
+func (cmd cmdMigratePlane) execute(ctx context.Context) error {
+	ctx = pb.WithDispatchDefault(ctx)
+	var rb = &runbook{w: os.Stdout, dryRun: cmd.DryRun}
+
+	fromJC, _, err := newJournalClient(ctx, cmd.FromBroker)
+	if err != nil {
+		return fmt.Errorf("source data plane: %w", err)
+	}
+	fromSC, _, err := newShardClient(ctx, cmd.FromConsumer)
+	if err != nil {
+		return fmt.Errorf("source data plane: %w", err)
+	}
+	toJC, _, err := newJournalClient(ctx, cmd.ToBroker)
+	if err != nil {
+		return fmt.Errorf("target data plane: %w", err)
+	}
+	toSC, _, err := newShardClient(ctx, cmd.ToConsumer)
+	if err != nil {
+		return fmt.Errorf("target data plane: %w", err)
+	}
+
+	rb.stepf("Capture journal and shard specifications of the source data plane")
+
+	journals, err := client.ListAllJournals(ctx, fromJC, pb.ListRequest{})
+	if err != nil {
+		return fmt.Errorf("listing source journals: %w", err)
+	}
+	shards, err := consumer.ListShards(ctx, fromSC, &pc.ListRequest{})
+	if err != nil {
+		return fmt.Errorf("listing source shards: %w", err)
+	}
+	rb.notef("captured %d journals and %d shards", len(journals.Journals), len(shards.Shards))
+
+	rb.stepf("Verify that all journals persist to fragment stores shared with the target")
+
+	for _, j := range journals.Journals {
+		if len(j.Spec.Fragment.Stores) == 0 {
+			return fmt.Errorf("journal %s has no fragment stores, and its content cannot be shared with the target data plane", j.Spec.Name)
+		}
+	}
+	rb.notef("all journals have configured fragment stores")
+
+	rb.stepf("Create journals in the target data plane")
+
+	existingJournals, err := client.ListAllJournals(ctx, toJC, pb.ListRequest{})
+	if err != nil {
+		return fmt.Errorf("listing target journals: %w", err)
+	}
+	var existing = make(map[pb.Journal]struct{})
+	for _, j := range existingJournals.Journals {
+		existing[j.Spec.Name] = struct{}{}
+	}
+
+	var journalChanges []pb.ApplyRequest_Change
+	for i := range journals.Journals {
+		var spec = &journals.Journals[i].Spec
+		if _, ok := existing[spec.Name]; ok {
+			rb.notef("journal %s already exists in the target", spec.Name)
+			continue
+		}
+		journalChanges = append(journalChanges, pb.ApplyRequest_Change{Upsert: spec})
+	}
+	rb.notef("creating %d journals", len(journalChanges))
+
+	if err = applyAllChanges(ctx, toSC, toJC, nil, journalChanges, cmd.DryRun); err != nil && err != errNoChangesToApply {
+		return err
+	}
+	if cmd.DryRun {
+		rb.stepf("Verify the target indexes persisted fragments of each journal")
+		rb.stepf("Replay recent, unpersisted journal content into the target")
+		rb.stepf("Disable the %d shards of the source data plane", len(shards.Shards))
+		rb.stepf("Replay final journal content into the target")
+		rb.stepf("Create shards in the target data plane")
+		rb.finish()
+		return nil
+	}
+
+	rb.stepf("Verify the target indexes persisted fragments of each journal")
+
+	for _, j := range journals.Journals {
+		if err := awaitFragmentIndex(ctx, fromJC, toJC, j.Spec.Name); err != nil {
+			return err
+		}
+	}
+	rb.notef("fragment indices of all %d journals are consistent", len(journals.Journals))
+
+	// Replay content while source shards are still running, so that the final
+	// replay after they're disabled (during which tasks are down) is brief.
+	rb.stepf("Replay recent, unpersisted journal content into the target")
+
+	if err := replayAllJournals(ctx, rb, fromJC, toJC, journals.Journals); err != nil {
+		return err
+	}
+
+	rb.stepf("Disable the %d shards of the source data plane", len(shards.Shards))
+
+	var disables []pc.ApplyRequest_Change
+	for _, s := range shards.Shards {
+		if s.Spec.Disable {
+			continue
+		}
+		var spec = s.Spec
+		spec.Disable = true
+
+		disables = append(disables, pc.ApplyRequest_Change{
+			Upsert:            &spec,
+			ExpectModRevision: s.ModRevision,
+		})
+	}
+	if err = applyAllChanges(ctx, fromSC, fromJC, disables, nil, false); err != nil && err != errNoChangesToApply {
+		return err
+	}
+	if err = awaitShardsReleased(ctx, fromSC); err != nil {
+		return err
+	}
+	rb.notef("disabled %d shards, and all source shards are now unassigned", len(disables))
+
+	rb.stepf("Replay final journal content into the target")
+
+	if err := replayAllJournals(ctx, rb, fromJC, toJC, journals.Journals); err != nil {
+		return err
+	}
+
+	rb.stepf("Create shards in the target data plane")
+
+	var shardChanges []pc.ApplyRequest_Change
+	for i := range shards.Shards {
+		shardChanges = append(shardChanges, pc.ApplyRequest_Change{Upsert: &shards.Shards[i].Spec})
+	}
+	if err = applyAllChanges(ctx, toSC, toJC, shardChanges, nil, false); err != nil && err != errNoChangesToApply {
+		return err
+	}
+	rb.notef("created %d shards, which retain their source disabled status", len(shardChanges))
+
+	rb.finish()
+	return nil
+}
+
+func (cmd cmdMigratePlane) Execute(_ []string) error {
+	defer mbp.InitDiagnosticsAndRecover(cmd.Diagnostics)()
+	mbp.InitLog(cmd.Log)
+	var ctx, cancelFn = context.WithTimeout(context.Background(), cmd.Timeout)
+	defer cancelFn()
+
+	log.WithFields(log.Fields{
+		"config":    cmd,
+		"version":   mbp.Version,
+		"buildDate": mbp.BuildDate,
+	}).Debug("flowctl configuration")
+	pb.RegisterGRPCDispatcher("local")
+
+	return cmd.execute(ctx)
+}
+
+// runbook prints a numbered record of migration steps as they're performed.
+type runbook struct {
+	w      io.Writer
+	dryRun bool
+	step   int
+}
+
+func (r *runbook) stepf(format string, args ...interface{}) {
+	r.step++
+
+	var suffix string
+	if r.dryRun {
+		suffix = " (dry-run)"
+	}
+	fmt.Fprintf(r.w, "Step %d: %s%s\n", r.step, fmt.Sprintf(format, args...), suffix)
+}
+
+func (r *runbook) notef(format string, args ...interface{}) {
+	fmt.Fprintf(r.w, "  - %s\n", fmt.Sprintf(format, args...))
+}
+
+func (r *runbook) finish() {
+	fmt.Fprintf(r.w, `
+Remaining manual steps:
+  - Confirm shards of the target data plane become PRIMARY ('flowctl-go shards list').
+  - Point the control plane and any external readers or writers at the target data plane.
+  - Once satisfied, delete the journals and shards of the source data plane.
+`)
+}
+
+// awaitFragmentIndex waits until the target's fragment index of |journal|
+// covers all fragments which the source has persisted.
+func awaitFragmentIndex(ctx context.Context, from, to pb.RoutedJournalClient, journal pb.Journal) error {
+	var persistedEnd = func(rjc pb.RoutedJournalClient) (int64, error) {
+		var resp, err = client.ListAllFragments(ctx, rjc, pb.FragmentsRequest{Journal: journal})
+		if err != nil {
+			return 0, err
+		}
+		var end int64
+		for _, f := range resp.Fragments {
+			if f.Spec.BackingStore != "" && f.Spec.End > end {
+				end = f.Spec.End
+			}
+		}
+		return end, nil
+	}
+
+	fromEnd, err := persistedEnd(from)
+	if err != nil {
+		return fmt.Errorf("listing source fragments of %s: %w", journal, err)
+	}
+	for {
+		if toEnd, err := persistedEnd(to); err != nil {
+			return fmt.Errorf("listing target fragments of %s: %w", journal, err)
+		} else if toEnd >= fromEnd {
+			return nil
+		}
+		log.WithFields(log.Fields{
+			"journal": journal,
+			"offset":  fromEnd,
+		}).Info("waiting for target to index persisted fragments")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second * 5):
+		}
+	}
+}
+
+// replayAllJournals replays content of each journal which the source has
+// and the target does not.
+func replayAllJournals(ctx context.Context, rb *runbook, from, to pb.RoutedJournalClient, journals []pb.ListResponse_Journal) error {
+	var total int64
+	for _, j := range journals {
+		var n, err = replayJournal(ctx, from, to, j.Spec.Name)
+		if err != nil {
+			return err
+		}
+		total += n
+	}
+	rb.notef("replayed %d bytes across %d journals", total, len(journals))
+	return nil
+}
+
+// replayJournal copies content of |journal| from its target write head through
+// its source write head. Appends begin at an explicit offset, which ensures
+// journal offsets of the target match those of the source.
+func replayJournal(ctx context.Context, from, to pb.RoutedJournalClient, journal pb.Journal) (int64, error) {
+	fromHead, err := fetchWriteHead(ctx, from, journal)
+	if err != nil {
+		return 0, fmt.Errorf("fetching source write head of %s: %w", journal, err)
+	}
+	toHead, err := fetchWriteHead(ctx, to, journal)
+	if err != nil {
+		return 0, fmt.Errorf("fetching target write head of %s: %w", journal, err)
+	}
+
+	if toHead == fromHead {
+		return 0, nil
+	} else if toHead > fromHead {
+		return 0, fmt.Errorf("target write head of %s (%d) is beyond the source write head (%d)",
+			journal, toHead, fromHead)
+	}
+
+	var r = client.NewReader(ctx, from, pb.ReadRequest{
+		Journal:   journal,
+		Offset:    toHead,
+		EndOffset: fromHead,
+		Block:     true,
+	})
+	var a = client.NewAppender(ctx, to, pb.AppendRequest{
+		Journal: journal,
+		Offset:  toHead,
+	})
+
+	n, err := io.Copy(a, r)
+	if err == client.ErrOffsetJump {
+		err = fmt.Errorf("source content is missing at offset %d", r.Request.Offset)
+	}
+	if err != nil {
+		a.Abort()
+		return 0, fmt.Errorf("replaying %s: %w", journal, err)
+	} else if err = a.Close(); err != nil {
+		return 0, fmt.Errorf("replaying %s: %w", journal, err)
+	}
+
+	log.WithFields(log.Fields{
+		"journal": journal,
+		"begin":   toHead,
+		"end":     fromHead,
+	}).Info("replayed journal content")
+
+	return n, nil
+}
+
+// fetchWriteHead returns the current write head of |journal|.
+func fetchWriteHead(ctx context.Context, rjc pb.RoutedJournalClient, journal pb.Journal) (int64, error) {
+	var r = client.NewReader(ctx, rjc, pb.ReadRequest{
+		Journal:      journal,
+		Offset:       -1,
+		MetadataOnly: true,
+	})
+	for {
+		switch _, err := r.Read(nil); err {
+		case nil, client.ErrOffsetJump:
+			// Read the next response.
+		case client.ErrOffsetNotYetAvailable, io.EOF:
+			return r.Response.WriteHead, nil
+		default:
+			return 0, err
+		}
+	}
+}
+
+// awaitShardsReleased waits until no shard of the data plane has an assigned primary.
+func awaitShardsReleased(ctx context.Context, sc pc.ShardClient) error {
+	for {
+		var resp, err = consumer.ListShards(ctx, sc, &pc.ListRequest{})
+		if err != nil {
+			return fmt.Errorf("listing source shards: %w", err)
+		}
+
+		var assigned int
+		for _, s := range resp.Shards {
+			if s.Route.Primary != -1 {
+				assigned++
+			}
+		}
+		if assigned == 0 {
+			return nil
+		}
+		log.WithField("assigned", assigned).Info("waiting for disabled source shards to be released")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
@@ -0,0 +1,389 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/estuary/flow/go/flow"
+	"github.com/estuary/flow/go/labels"
+	"github.com/estuary/flow/go/protocols/catalog"
+	pf "github.com/estuary/flow/go/protocols/flow"
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/consumer"
+	pc "go.gazette.dev/core/consumer/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+type cmdRebuildOnChange struct {
+	Airgapped    bool                  `long:"airgapped" env:"FLOW_AIRGAPPED" description:"Run without access to external resources. Connector images are never pulled and must be pre-loaded, and remote catalog resources are not fetched"`
+	BrokerPort   uint16                `long:"broker-port" default:"8080" description:"Port bound by Gazette broker"`
+	ConsumerPort uint16                `long:"consumer-port" default:"9000" description:"Port bound by Flow consumer"`
+	Network      string                `long:"network" description:"The Docker network that connector containers are given access to."`
+	Poll         time.Duration         `long:"poll" default:"500ms" description:"Interval at which catalog sources are polled for changes"`
+	Source       string                `long:"source" required:"true" description:"Catalog source file or URL to build"`
+	Log          mbp.LogConfig         `group:"Logging" namespace:"log" env-namespace:"LOG"`
+	Diagnostics  mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
+}
+
+func (cmd cmdRebuildOnChange) Execute(_ []string) error {
+	defer mbp.InitDiagnosticsAndRecover(cmd.Diagnostics)()
+	mbp.InitLog(cmd.Log)
+
+	log.WithFields(log.Fields{
+		"config":    cmd,
+		"version":   mbp.Version,
+		"buildDate": mbp.BuildDate,
+	}).Debug("flowctl configuration")
+	protocol.RegisterGRPCDispatcher("local")
+
+	// Create a temporary directory which will contain the Etcd database,
+	// and the builds of each revision of the catalog.
+	tempdir, err := ioutil.TempDir("", "flow-rebuild-on-change")
+	if err != nil {
+		return fmt.Errorf("creating temp directory: %w", err)
+	}
+	defer os.RemoveAll(tempdir)
+
+	// Install a signal handler which will cancel our context.
+	var ctx, cancel = signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	// Start a temporary data plane bound to our context.
+	var dataPlane = cmdTempDataPlane{
+		Airgapped:    cmd.Airgapped,
+		BrokerPort:   cmd.BrokerPort,
+		ConsumerPort: cmd.ConsumerPort,
+		Log: mbp.LogConfig{
+			Level:  "warn",
+			Format: cmd.Log.Format,
+		},
+	}
+	_, brokerAddr, consumerAddr, err := dataPlane.start(ctx, tempdir)
+	if err != nil {
+		return fmt.Errorf("starting local data plane: %w", err)
+	}
+
+	var revision int
+	var buildID string
+	var watched map[string]time.Time
+
+	// Build and activate each revision of the catalog. A revision which fails
+	// to build or activate is reported, and we continue to watch for changes.
+	for {
+		var nextID = fmt.Sprintf("rebuild-%d", revision+1)
+
+		if err := cmd.buildAndActivate(ctx, tempdir, buildID, nextID, brokerAddr, consumerAddr); err != nil {
+			if ctx.Err() != nil {
+				break
+			} else if watched == nil {
+				// We don't yet know which sources to watch.
+				return err
+			}
+			log.WithField("err", err).Error("failed to build and activate the catalog (will retry on next change)")
+
+			// Continue to watch the sources of the last build, from their current state.
+			for path := range watched {
+				watched[path] = modTime(path)
+			}
+		} else {
+			buildID = nextID
+			log.WithFields(log.Fields{
+				"build":    buildID,
+				"revision": revision,
+			}).Info("activated catalog derivations")
+
+			if watched, err = watchedSources(filepath.Join(tempdir, "builds", buildID)); err != nil {
+				return err
+			}
+		}
+		revision++
+
+		if !awaitChange(ctx, watched, cmd.Poll) {
+			break
+		}
+		log.Info("catalog sources changed; rebuilding")
+	}
+
+	if buildID == "" {
+		return nil // Nothing was activated.
+	}
+
+	// Delete derivations and collections of the last activated build.
+	var delete = apiDelete{
+		BuildID:        buildID,
+		Network:        cmd.Network,
+		AllDerivations: true,
+	}
+	delete.Broker.Address = protocol.Endpoint(brokerAddr)
+	delete.Consumer.Address = protocol.Endpoint(consumerAddr)
+
+	// Our signal context is cancelled, so use a fresh one for the deletion.
+	if err = delete.execute(context.Background()); err != nil && err != errNoChangesToApply {
+		return err
+	}
+
+	// Stop the data plane. It exits as we've removed all entities.
+	dataPlane.gracefulStop()
+
+	return nil
+}
+
+// buildAndActivate builds the catalog as |buildID| and activates its derivations.
+// If the derivations of |buildID| differ from those of |prevID| only in their
+// lambdas, then their transforms are instead reloaded within running shards.
+func (cmd cmdRebuildOnChange) buildAndActivate(ctx context.Context, tempdir, prevID, buildID, brokerAddr, consumerAddr string) error {
+	if err := (apiBuild{
+		Airgapped: cmd.Airgapped,
+		BuildID:   buildID,
+		// Build directly into the temp dataplane's build directory.
+		BuildDB:    filepath.Join(tempdir, "builds", buildID),
+		FileRoot:   "/",
+		Network:    cmd.Network,
+		Source:     cmd.Source,
+		SourceType: "catalog",
+	}.execute(ctx)); err != nil {
+		return err
+	}
+
+	if prevID != "" {
+		if reloaded, err := reloadTransforms(ctx, tempdir, prevID, buildID, consumerAddr); err != nil {
+			return fmt.Errorf("reloading transforms: %w", err)
+		} else if reloaded {
+			return nil
+		}
+	}
+
+	var activate = apiActivate{
+		BuildID:        buildID,
+		Network:        cmd.Network,
+		InitialSplits:  1,
+		AllDerivations: true,
+	}
+	activate.Broker.Address = protocol.Endpoint(brokerAddr)
+	activate.Consumer.Address = protocol.Endpoint(consumerAddr)
+
+	if err := activate.execute(ctx); err != nil && err != errNoChangesToApply {
+		return err
+	}
+	return nil
+}
+
+// reloadTransforms reloads the transforms of running derivation shards from
+// |buildID|, if its collections differ from those of |prevID| only in their
+// lambdas. The build label of each shard is updated in place, which restarts
+// its task term at its next transaction boundary. The shard re-opens its
+// derivation with the new transforms over its current registers, and
+// collections, journals, and shards are not re-activated.
+//
+// reloadTransforms returns false if the builds differ in other ways,
+// and a full activation of |buildID| is required.
+func reloadTransforms(ctx context.Context, tempdir, prevID, buildID, consumerAddr string) (bool, error) {
+	prev, err := loadBuildCollections(filepath.Join(tempdir, "builds", prevID))
+	if err != nil {
+		return false, err
+	}
+	next, err := loadBuildCollections(filepath.Join(tempdir, "builds", buildID))
+	if err != nil {
+		return false, err
+	}
+	if len(prev) != len(next) {
+		return false, nil
+	}
+
+	for _, collection := range next {
+		var before, ok = prev[collection.Name]
+		if !ok {
+			return false, nil
+		} else if equal, err := equalExceptLambdas(before, collection); err != nil {
+			return false, err
+		} else if !equal {
+			return false, nil
+		}
+	}
+
+	ctx = protocol.WithDispatchDefault(ctx)
+
+	var cfg mbp.ClientConfig
+	cfg.Address = protocol.Endpoint(consumerAddr)
+
+	sc, _, err := newShardClient(ctx, cfg)
+	if err != nil {
+		return false, err
+	}
+
+	var changes []pc.ApplyRequest_Change
+	for _, collection := range next {
+		if collection.Derivation == nil {
+			continue
+		}
+		var req = flow.ListShardsRequest(collection)
+		var resp, err = consumer.ListShards(ctx, sc, &req)
+		if err != nil {
+			return false, fmt.Errorf("listing shards of %s: %w", collection.Name, err)
+		}
+
+		for _, shard := range resp.Shards {
+			var spec = shard.Spec
+			spec.LabelSet = pf.LabelSet{Labels: append([]pf.Label(nil), shard.Spec.LabelSet.Labels...)}
+			spec.LabelSet.SetValue(labels.Build, buildID)
+
+			log.WithFields(log.Fields{"id": spec.Id, "rev": shard.ModRevision}).Info("reload shard transforms")
+
+			changes = append(changes, pc.ApplyRequest_Change{
+				Upsert:            &spec,
+				ExpectModRevision: shard.ModRevision,
+			})
+		}
+	}
+
+	if len(changes) == 0 {
+		return true, nil
+	} else if _, err = consumer.ApplyShardsInBatches(ctx, sc, &pc.ApplyRequest{Changes: changes}, maxEtcdTxnSize); err != nil {
+		return false, fmt.Errorf("applying shards: %w", err)
+	}
+	return true, nil
+}
+
+// loadBuildCollections loads all collections of |buildDB|, indexed on name.
+func loadBuildCollections(buildDB string) (map[pf.Collection]*pf.CollectionSpec, error) {
+	var db, err = sql.Open("sqlite3", fmt.Sprintf("file://%s?mode=ro", buildDB))
+	if err != nil {
+		return nil, fmt.Errorf("opening DB: %w", err)
+	}
+	defer db.Close()
+
+	collections, err := catalog.LoadAllCollections(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var out = make(map[pf.Collection]*pf.CollectionSpec, len(collections))
+	for _, collection := range collections {
+		out[collection.Name] = collection
+	}
+	return out, nil
+}
+
+// equalExceptLambdas returns true if collections |a| and |b| are equal,
+// ignoring their lambdas and the build labels of their templates.
+func equalExceptLambdas(a, b *pf.CollectionSpec) (bool, error) {
+	var aa, err = withoutLambdas(a)
+	if err != nil {
+		return false, err
+	}
+	bb, err := withoutLambdas(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(aa, bb), nil
+}
+
+// withoutLambdas returns the encoding of a copy of |spec| having no lambdas,
+// derivation configuration, or template build labels.
+func withoutLambdas(spec *pf.CollectionSpec) ([]byte, error) {
+	var b, err = spec.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	var clone pf.CollectionSpec
+	if err = clone.Unmarshal(b); err != nil {
+		return nil, err
+	}
+
+	var strip func(*pf.CollectionSpec)
+	strip = func(spec *pf.CollectionSpec) {
+		if spec.PartitionTemplate != nil {
+			spec.PartitionTemplate.LabelSet.Remove(labels.Build)
+		}
+		var derivation = spec.Derivation
+		if derivation == nil {
+			return
+		}
+		derivation.ConfigJson = nil
+
+		if derivation.ShardTemplate != nil {
+			derivation.ShardTemplate.LabelSet.Remove(labels.Build)
+		}
+		if derivation.RecoveryLogTemplate != nil {
+			derivation.RecoveryLogTemplate.LabelSet.Remove(labels.Build)
+		}
+		for i := range derivation.Transforms {
+			derivation.Transforms[i].LambdaConfigJson = nil
+			derivation.Transforms[i].ShuffleLambdaConfigJson = nil
+			strip(&derivation.Transforms[i].Collection)
+		}
+	}
+	strip(&clone)
+
+	return clone.Marshal()
+}
+
+// watchedSources returns the local files of the resources of |buildDB|,
+// which include its lambda sources, and their current modification times.
+func watchedSources(buildDB string) (map[string]time.Time, error) {
+	var db, err = sql.Open("sqlite3", fmt.Sprintf("file://%s?mode=ro", buildDB))
+	if err != nil {
+		return nil, fmt.Errorf("opening DB: %w", err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT resource FROM resources")
+	if err != nil {
+		return nil, fmt.Errorf("loading build resources: %w", err)
+	}
+	defer rows.Close()
+
+	var out = make(map[string]time.Time)
+	for rows.Next() {
+		var resource string
+		if err = rows.Scan(&resource); err != nil {
+			return nil, fmt.Errorf("scanning build resource: %w", err)
+		}
+		var u, err = url.Parse(resource)
+		if err != nil || u.Scheme != "file" {
+			continue // Only local files are watched.
+		}
+		out[u.Path] = modTime(u.Path)
+	}
+	return out, rows.Err()
+}
+
+// awaitChange polls the |watched| files at |interval| until one of them
+// is modified, returning true, or until |ctx| is cancelled (returning false).
+func awaitChange(ctx context.Context, watched map[string]time.Time, interval time.Duration) bool {
+	var ticker = time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+		for path, last := range watched {
+			if !modTime(path).Equal(last) {
+				log.WithField("path", path).Debug("source was modified")
+				return true
+			}
+		}
+	}
+}
+
+// modTime returns the modification time of |path|,
+// or a zero time if it cannot be read.
+func modTime(path string) time.Time {
+	if info, err := os.Stat(path); err == nil {
+		return info.ModTime()
+	}
+	return time.Time{}
+}
@@ -31,6 +31,11 @@ func init() {
 	// Automatically register this command under the shards command
 	gazctlcmd.CommandRegistry.AddCommand("shards", "split", "Split a Flow processing shard", `
 Split a Flow processing shard into two, either on shuffled key or rotated clock.
+
+Capture shards may only be split on key. Connectors which advertise range
+splitting (through the dev.estuary.range-splitting image label) capture only
+the portion of each binding within their shard's key range, so that each
+split shard backfills its portion of a large table in parallel.
 `, &cmdShardsSplit{})
 }
 
@@ -77,6 +82,9 @@ func (cmd cmdShardsSplit) execute(ctx context.Context) error {
 	labeling, err := labels.ParseShardLabels(shardSpec.LabelSet)
 	if err != nil {
 		return err
+	} else if cmd.SplitOnRClock && labeling.TaskType == ops.TaskType_capture {
+		// Captures don't read journals, and have no clock to split upon.
+		return fmt.Errorf("capture shards may be split only on key, not on rotated clock")
 	}
 	var build = builds.Open(labeling.Build)
 	defer build.Close()
@@ -0,0 +1,441 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/estuary/flow/go/flow"
+	"github.com/estuary/flow/go/labels"
+	"github.com/estuary/flow/go/protocols/catalog"
+	pf "github.com/estuary/flow/go/protocols/flow"
+	"github.com/estuary/flow/go/protocols/ops"
+	"github.com/jgraettinger/gorocksdb"
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/client"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/cmd/gazctl/gazctlcmd"
+	"go.gazette.dev/core/consumer"
+	pc "go.gazette.dev/core/consumer/protocol"
+	"go.gazette.dev/core/consumer/recoverylog"
+	mbp "go.gazette.dev/core/mainboilerplate"
+)
+
+// This command will be under the shards command which leverages the gazctlcmd.ShardsCfg config.
+type cmdShardsExportState struct {
+	Shard       string                `long:"shard" required:"true" description:"Capture shard having the connector state to export"`
+	Output      string                `long:"output" short:"o" required:"true" description:"Path of the exported state file"`
+	Diagnostics mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
+}
+
+// This command will be under the shards command which leverages the gazctlcmd.ShardsCfg config.
+type cmdShardsImportState struct {
+	Shard                string                `long:"shard" required:"true" description:"Disabled capture shard into which connector state is imported"`
+	Input                string                `long:"input" short:"i" required:"true" description:"Path of a state file produced by 'shards export-state'"`
+	AllowConnectorChange bool                  `long:"allow-connector-change" description:"Allow importing into a capture which uses a different connector image than the exported capture"`
+	Diagnostics          mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
+}
+
+func init() {
+	// Automatically register these commands under the shards command
+	gazctlcmd.CommandRegistry.AddCommand("shards", "export-state", "Export the connector state of a capture shard", `
+Export the connector state of a capture shard into a portable file.
+
+The shard's recovery log is played into a temporary directory, from which
+its connector state is read. The shard may continue to run while its state
+is exported, and the export reflects its last committed transaction.
+`, &cmdShardsExportState{})
+
+	gazctlcmd.CommandRegistry.AddCommand("shards", "import-state", "Import connector state into a capture shard", `
+Import a connector state file, produced by 'export-state', into a capture shard.
+
+This allows a capture to be renamed or moved to another data plane without
+re-capturing its source from the beginning. The target shard must be disabled,
+and must not have run since it was created. Create the target capture with
+disabled shards, import the state of each shard, and then enable it.
+
+The target capture must use the same connector image as the exported capture,
+ignoring its tag, unless --allow-connector-change is set. Bindings of the
+target capture should have the same resource paths and backfill counters,
+as connector states are keyed on each binding's state key.
+`, &cmdShardsImportState{})
+}
+
+// shardStateExport is the portable file format of an exported connector state.
+type shardStateExport struct {
+	// Version of the file format.
+	Version int `json:"version"`
+	// Capture task having the exported state.
+	Task string `json:"task"`
+	// Shard having the exported state.
+	Shard pc.ShardID `json:"shard"`
+	// Build of the capture at the time of export.
+	Build string `json:"build"`
+	// Connector image of the capture.
+	Image string `json:"image,omitempty"`
+	// ExportedAt is the time of the export.
+	ExportedAt time.Time `json:"exportedAt"`
+	// State is the exported connector state.
+	State json.RawMessage `json:"state"`
+}
+
+// Current version of the shardStateExport file format.
+const shardStateExportVersion = 1
+
+func (cmd cmdShardsExportState) execute(ctx context.Context) error {
+	ctx = pb.WithDispatchDefault(ctx)
+
+	var shard, err = loadStateShard(ctx, cmd.Shard)
+	if err != nil {
+		return err
+	}
+	dir, err := ioutil.TempDir("", "flow-export-state")
+	if err != nil {
+		return fmt.Errorf("creating temp directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var player = recoverylog.NewPlayer()
+	player.FinishAtWriteHead()
+
+	if err = shard.play(ctx, player, dir); err != nil {
+		return err
+	}
+
+	var state json.RawMessage
+	if _, err = os.Stat(filepath.Join(dir, "CURRENT")); err == nil {
+		state, err = readRocksDBConnectorState(dir)
+	} else {
+		// The shard has no RocksDB, and may have a legacy state.json.
+		state, err = readLegacyConnectorState(dir)
+	}
+	if err != nil {
+		return err
+	}
+
+	var export = shardStateExport{
+		Version:    shardStateExportVersion,
+		Task:       shard.labeling.TaskName,
+		Shard:      shard.spec.Id,
+		Build:      shard.labeling.Build,
+		Image:      shard.image,
+		ExportedAt: time.Now().UTC(),
+		State:      state,
+	}
+	b, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	} else if err = ioutil.WriteFile(cmd.Output, append(b, '\n'), 0600); err != nil {
+		return fmt.Errorf("writing state file: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"shard":  export.Shard,
+		"output": cmd.Output,
+		"bytes":  len(state),
+	}).Info("exported connector state")
+
+	return nil
+}
+
+func (cmd cmdShardsImportState) execute(ctx context.Context) error {
+	ctx = pb.WithDispatchDefault(ctx)
+
+	b, err := ioutil.ReadFile(cmd.Input)
+	if err != nil {
+		return fmt.Errorf("reading state file: %w", err)
+	}
+	var export shardStateExport
+	if err = json.Unmarshal(b, &export); err != nil {
+		return fmt.Errorf("decoding state file: %w", err)
+	} else if export.Version != shardStateExportVersion {
+		return fmt.Errorf("state file has unsupported version %d", export.Version)
+	} else if !strings.HasPrefix(strings.TrimSpace(string(export.State)), "{") {
+		return fmt.Errorf("state file doesn't have a connector state object")
+	}
+
+	shard, err := loadStateShard(ctx, cmd.Shard)
+	if err != nil {
+		return err
+	} else if !shard.spec.Disable {
+		return fmt.Errorf("shard %s must be disabled while its state is imported", shard.spec.Id)
+	} else if imageName(shard.image) != imageName(export.Image) && !cmd.AllowConnectorChange {
+		return fmt.Errorf("shard %s uses connector %s, but the state was exported from %s (see --allow-connector-change)",
+			shard.spec.Id, shard.image, export.Image)
+	}
+
+	dir, err := ioutil.TempDir("", "flow-import-state")
+	if err != nil {
+		return fmt.Errorf("creating temp directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Play the recovery log, and then inject a hand-off which fences any
+	// other recorder of the log.
+	var author = recoverylog.NewRandomAuthor()
+	var player = recoverylog.NewPlayer()
+	player.InjectHandoff(author)
+
+	if err = shard.play(ctx, player, dir); err != nil {
+		return err
+	}
+	// Imported state is an initial connector state, which is used only
+	// if the shard has never opened its RocksDB.
+	if _, err = os.Stat(filepath.Join(dir, "CURRENT")); err == nil {
+		return fmt.Errorf("shard %s has already run, and its connector state cannot be replaced", shard.spec.Id)
+	}
+
+	var recorder = recoverylog.NewRecorder(
+		shard.spec.RecoveryLog(),
+		player.Resolved.FSM,
+		author,
+		player.Resolved.Dir,
+		client.NewAppendService(ctx, shard.rjc),
+	)
+
+	// Record a legacy state.json, which the runtime reads as the
+	// initial connector state of a shard.
+	var state struct {
+		DriverCheckpoint json.RawMessage
+	}
+	store, err := consumer.NewJSONFileStore(recorder, &state)
+	if err != nil {
+		return fmt.Errorf("opening recorded state: %w", err)
+	}
+	state.DriverCheckpoint = export.State
+
+	var op = store.StartCommit(nil, pc.Checkpoint{}, nil)
+	if err = op.Err(); err != nil {
+		return fmt.Errorf("recording imported state: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"shard": shard.spec.Id,
+		"from":  export.Shard,
+		"input": cmd.Input,
+	}).Info("imported connector state (enable the shard to resume its capture)")
+
+	return nil
+}
+
+// stateShard is a capture shard having a connector state.
+type stateShard struct {
+	spec     *pc.ShardSpec
+	labeling ops.ShardLabeling
+	image    string
+	rjc      pb.RoutedJournalClient
+	sc       pc.ShardClient
+}
+
+// loadStateShard loads the capture shard |id| and the connector image of its build.
+func loadStateShard(ctx context.Context, id string) (*stateShard, error) {
+	rjc, _, err := newJournalClient(ctx, gazctlcmd.ShardsCfg.Broker)
+	if err != nil {
+		return nil, err
+	}
+	sc, _, err := newShardClient(ctx, gazctlcmd.ShardsCfg.Consumer)
+	if err != nil {
+		return nil, err
+	}
+	buildsRoot, err := getBuildsRoot(ctx, gazctlcmd.ShardsCfg.Consumer)
+	if err != nil {
+		return nil, err
+	}
+	builds, err := flow.NewBuildService(buildsRoot.String())
+	if err != nil {
+		return nil, err
+	}
+
+	shardsList, err := consumer.ListShards(ctx, sc, &pc.ListRequest{
+		Selector: pf.LabelSelector{Include: pb.MustLabelSet("id", id)},
+	})
+	if err != nil {
+		return nil, err
+	} else if len(shardsList.Shards) != 1 {
+		return nil, fmt.Errorf("shard %s not found", id)
+	}
+	var spec = &shardsList.Shards[0].Spec
+
+	labeling, err := labels.ParseShardLabels(spec.LabelSet)
+	if err != nil {
+		return nil, err
+	} else if labeling.TaskType != ops.TaskType_capture {
+		return nil, fmt.Errorf("shard %s is a %s, not a capture", id, labeling.TaskType)
+	}
+
+	var build = builds.Open(labeling.Build)
+	defer build.Close()
+
+	var capture *pf.CaptureSpec
+	if err := build.Extract(func(db *sql.DB) (err error) {
+		capture, err = catalog.LoadCapture(db, labeling.TaskName)
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	var image string
+	if capture.ConnectorType == pf.CaptureSpec_IMAGE {
+		var config struct {
+			Image string `json:"image"`
+		}
+		if err := json.Unmarshal(capture.ConfigJson, &config); err != nil {
+			return nil, fmt.Errorf("decoding connector config: %w", err)
+		}
+		image = config.Image
+	}
+
+	return &stateShard{
+		spec:     spec,
+		labeling: labeling,
+		image:    image,
+		rjc:      rjc,
+		sc:       sc,
+	}, nil
+}
+
+// play the recovery log of the shard into |dir| using |player|.
+func (s *stateShard) play(ctx context.Context, player *recoverylog.Player, dir string) error {
+	var hints, err = consumer.FetchHints(ctx, s.sc, &pc.GetHintsRequest{Shard: s.spec.Id})
+	if err != nil {
+		return fmt.Errorf("fetching hints of shard %s: %w", s.spec.Id, err)
+	}
+	var fsmHints = consumer.PickFirstHints(hints, s.spec.RecoveryLog())
+
+	if err = player.Play(ctx, fsmHints, dir, client.NewAppendService(ctx, s.rjc)); err != nil {
+		return fmt.Errorf("playing recovery log %s: %w", s.spec.RecoveryLog(), err)
+	}
+	return nil
+}
+
+// readRocksDBConnectorState reads the connector state of a recovered RocksDB at |dir|.
+func readRocksDBConnectorState(dir string) (json.RawMessage, error) {
+	var opts = gorocksdb.NewDefaultOptions()
+	defer opts.Destroy()
+
+	// Column families must all be opened, though only the default is used.
+	names, err := gorocksdb.ListColumnFamilies(opts, dir)
+	if err != nil {
+		return nil, fmt.Errorf("listing RocksDB column families: %w", err)
+	}
+	var cfOpts = make([]*gorocksdb.Options, len(names))
+	var defaultCF = -1
+
+	for i, name := range names {
+		cfOpts[i] = gorocksdb.NewDefaultOptions()
+		defer cfOpts[i].Destroy()
+
+		if name == "default" {
+			cfOpts[i].SetMergeOperator(connectorStateMerger{})
+			defaultCF = i
+		}
+	}
+	if defaultCF == -1 {
+		return nil, fmt.Errorf("RocksDB is missing its default column family")
+	}
+
+	db, handles, err := gorocksdb.OpenDbForReadOnlyColumnFamilies(opts, dir, names, cfOpts, false)
+	if err != nil {
+		return nil, fmt.Errorf("opening RocksDB: %w", err)
+	}
+	defer db.Close()
+	for _, handle := range handles {
+		defer handle.Destroy()
+	}
+
+	var ro = gorocksdb.NewDefaultReadOptions()
+	defer ro.Destroy()
+
+	slice, err := db.GetCF(ro, handles[defaultCF], []byte(connectorStateKey))
+	if err != nil {
+		return nil, fmt.Errorf("reading connector state: %w", err)
+	}
+	defer slice.Free()
+
+	if !slice.Exists() {
+		return json.RawMessage("{}"), nil
+	}
+	return json.RawMessage(append([]byte(nil), slice.Data()...)), nil
+}
+
+// readLegacyConnectorState reads the connector state of a recovered legacy state.json at |dir|.
+func readLegacyConnectorState(dir string) (json.RawMessage, error) {
+	var state struct {
+		DriverCheckpoint json.RawMessage
+	}
+	var b, err = ioutil.ReadFile(filepath.Join(dir, "state.json"))
+	if os.IsNotExist(err) {
+		return json.RawMessage("{}"), nil
+	} else if err != nil {
+		return nil, err
+	} else if err = json.Unmarshal(b, &state); err != nil {
+		return nil, fmt.Errorf("decoding legacy state.json: %w", err)
+	}
+	if len(state.DriverCheckpoint) == 0 || string(state.DriverCheckpoint) == "null" {
+		return json.RawMessage("{}"), nil
+	}
+	return state.DriverCheckpoint, nil
+}
+
+// Key of the connector state in the RocksDB of a task.
+const connectorStateKey = "connector-state"
+
+// connectorStateMerger reduces merge-patches of the connector state,
+// equivalent to the merge operator which is used by the runtime.
+type connectorStateMerger struct{}
+
+func (connectorStateMerger) Name() string { return "json-schema" }
+
+func (connectorStateMerger) FullMerge(_, existing []byte, operands [][]byte) ([]byte, bool) {
+	var state = pf.ConnectorState{UpdatedJson: existing}
+
+	for _, operand := range operands {
+		if err := state.Reduce(pf.ConnectorState{UpdatedJson: operand, MergePatch: true}); err != nil {
+			log.WithField("err", err).Error("failed to merge connector state")
+			return nil, false
+		}
+	}
+	return state.UpdatedJson, true
+}
+
+// imageName returns the connector |image| without its tag.
+func imageName(image string) string {
+	if ind := strings.LastIndexByte(image, ':'); ind > strings.LastIndexByte(image, '/') {
+		return image[:ind]
+	}
+	return image
+}
+
+func (cmd cmdShardsExportState) Execute(_ []string) error {
+	defer mbp.InitDiagnosticsAndRecover(cmd.Diagnostics)()
+	mbp.InitLog(gazctlcmd.ShardsCfg.Log)
+
+	log.WithFields(log.Fields{
+		"config":    cmd,
+		"version":   mbp.Version,
+		"buildDate": mbp.BuildDate,
+	}).Debug("flowctl configuration")
+	pb.RegisterGRPCDispatcher(gazctlcmd.ShardsCfg.Zone)
+
+	return cmd.execute(context.Background())
+}
+
+func (cmd cmdShardsImportState) Execute(_ []string) error {
+	defer mbp.InitDiagnosticsAndRecover(cmd.Diagnostics)()
+	mbp.InitLog(gazctlcmd.ShardsCfg.Log)
+
+	log.WithFields(log.Fields{
+		"config":    cmd,
+		"version":   mbp.Version,
+		"buildDate": mbp.BuildDate,
+	}).Debug("flowctl configuration")
+	pb.RegisterGRPCDispatcher(gazctlcmd.ShardsCfg.Zone)
+
+	return cmd.execute(context.Background())
+}
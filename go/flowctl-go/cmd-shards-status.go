@@ -0,0 +1,557 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/estuary/flow/go/flow"
+	"github.com/estuary/flow/go/labels"
+	"github.com/estuary/flow/go/protocols/catalog"
+	pf "github.com/estuary/flow/go/protocols/flow"
+	"github.com/estuary/flow/go/protocols/ops"
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/client"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/cmd/gazctl/gazctlcmd"
+	"go.gazette.dev/core/consumer"
+	pc "go.gazette.dev/core/consumer/protocol"
+	mbp "go.gazette.dev/core/mainboilerplate"
+	"go.gazette.dev/core/message"
+)
+
+// This command will be under the shards command which leverages the gazctlcmd.ShardsCfg config.
+type cmdShardsStatus struct {
+	Task        string                `long:"task" required:"true" description:"Name of the task to report"`
+	Window      time.Duration         `long:"window" default:"5m" description:"Trailing window of task stats and logs over which rates and errors are reported"`
+	Errors      int                   `long:"errors" default:"3" description:"Maximum number of recent errors to report for each binding"`
+	Format      string                `long:"format" choice:"table" choice:"json" default:"table" description:"Output format"`
+	Diagnostics mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
+}
+
+func init() {
+	// Automatically register this command under the shards command
+	gazctlcmd.CommandRegistry.AddCommand("shards", "status", "Show the status of a task's shards and bindings", `
+Show the status of each shard of a task, and the throughput and recent errors
+of each of its bindings.
+
+Binding throughput is read from the committed documents of the ops stats
+collection of the task, over the trailing --window. Read and write rates are the documents and bytes per second
+read from the source and written to the target of the binding. For captures,
+the source is the connector and the target is the captured collection. For
+materializations, the source is the materialized collection and the target is
+the endpoint. For derivations, each transform reports the documents it read.
+
+Errors and warnings are read from the committed documents of the ops logs
+collection of the task, and are attributed to a binding when the fields of the log identify its collection,
+transform, or resource.
+`, &cmdShardsStatus{})
+}
+
+// taskStatus is the reported status of a task.
+type taskStatus struct {
+	Task     string          `json:"task"`
+	Type     string          `json:"type"`
+	Build    string          `json:"build"`
+	Window   string          `json:"window"`
+	Shards   []shardStatus   `json:"shards"`
+	Bindings []bindingStatus `json:"bindings"`
+	// Errors which aren't attributed to a specific binding.
+	Errors []statusError `json:"errors,omitempty"`
+}
+
+// shardStatus is the reported status of a task shard.
+type shardStatus struct {
+	Shard  pc.ShardID `json:"shard"`
+	Status string     `json:"status"`
+	Errors []string   `json:"errors,omitempty"`
+}
+
+// bindingStatus is the reported status of a task binding.
+type bindingStatus struct {
+	// Index of the binding within the task.
+	Index int `json:"index"`
+	// Resource path of a capture or materialization binding,
+	// or name of a derivation transform.
+	Resource []string `json:"resource"`
+	// Collection which is captured, materialized, or transformed.
+	Collection string `json:"collection"`
+	// Read and write rates per second over the window.
+	ReadDocsPerSec   float64 `json:"readDocsPerSec"`
+	ReadBytesPerSec  float64 `json:"readBytesPerSec"`
+	WriteDocsPerSec  float64 `json:"writeDocsPerSec"`
+	WriteBytesPerSec float64 `json:"writeBytesPerSec"`
+	// Last transaction of the window in which the binding processed documents.
+	LastDocumentAt *time.Time `json:"lastDocumentAt,omitempty"`
+	// Number of errors or warnings attributed to the binding over the window.
+	ErrorCount int `json:"errorCount"`
+	// Most recent errors or warnings attributed to the binding.
+	Errors []statusError `json:"errors,omitempty"`
+
+	// Key of the binding within task stats documents: the state key of a
+	// capture or materialization binding, or the name of a derivation transform.
+	statsKey string
+	// Values of log fields which identify this binding.
+	identifiers []string
+	readDocs    uint64
+	readBytes   uint64
+	writeDocs   uint64
+	writeBytes  uint64
+}
+
+// statusError is an error or warning logged by the task.
+type statusError struct {
+	Timestamp time.Time `json:"ts"`
+	Shard     string    `json:"shard"`
+	Level     string    `json:"level"`
+	Message   string    `json:"message"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// statusStatsDoc is the subset of an ops.Stats document used in reporting.
+type statusStatsDoc struct {
+	Shard *struct {
+		Name string `json:"name"`
+	} `json:"shard"`
+	Timestamp time.Time                     `json:"ts"`
+	Bindings  map[string]statusStatsBinding `json:"bindings"`
+	Derive    *struct {
+		Transforms map[string]struct {
+			Input statusDocsAndBytes `json:"input"`
+		} `json:"transforms"`
+	} `json:"derive"`
+}
+
+type statusStatsBinding struct {
+	Left  statusDocsAndBytes `json:"left"`
+	Right statusDocsAndBytes `json:"right"`
+	Out   statusDocsAndBytes `json:"out"`
+}
+
+type statusDocsAndBytes struct {
+	DocsTotal  uint64 `json:"docsTotal"`
+	BytesTotal uint64 `json:"bytesTotal"`
+}
+
+// statusLogDoc is the subset of an ops.Log document used in reporting.
+type statusLogDoc struct {
+	Shard *struct {
+		Name        string `json:"name"`
+		KeyBegin    string `json:"keyBegin"`
+		RClockBegin string `json:"rClockBegin"`
+	} `json:"shard"`
+	Timestamp time.Time                  `json:"ts"`
+	Level     string                     `json:"level"`
+	Message   string                     `json:"message"`
+	Fields    map[string]json.RawMessage `json:"fields"`
+}
+
+func (cmd cmdShardsStatus) execute(ctx context.Context) error {
+	ctx = pb.WithDispatchDefault(ctx)
+
+	rjc, _, err := newJournalClient(ctx, gazctlcmd.ShardsCfg.Broker)
+	if err != nil {
+		return err
+	}
+	sc, _, err := newShardClient(ctx, gazctlcmd.ShardsCfg.Consumer)
+	if err != nil {
+		return err
+	}
+	buildsRoot, err := getBuildsRoot(ctx, gazctlcmd.ShardsCfg.Consumer)
+	if err != nil {
+		return err
+	}
+	builds, err := flow.NewBuildService(buildsRoot.String())
+	if err != nil {
+		return err
+	}
+
+	shardsList, err := consumer.ListShards(ctx, sc, &pc.ListRequest{
+		Selector: pf.LabelSelector{Include: pb.MustLabelSet(labels.TaskName, cmd.Task)},
+	})
+	if err != nil {
+		return err
+	} else if len(shardsList.Shards) == 0 {
+		return fmt.Errorf("task %s has no shards", cmd.Task)
+	}
+
+	labeling, err := labels.ParseShardLabels(shardsList.Shards[0].Spec.LabelSet)
+	if err != nil {
+		return err
+	}
+	var status = taskStatus{
+		Task:   cmd.Task,
+		Type:   labeling.TaskType.String(),
+		Build:  labeling.Build,
+		Window: cmd.Window.String(),
+	}
+
+	for _, shard := range shardsList.Shards {
+		var s = shardStatus{Shard: shard.Spec.Id, Status: "UNASSIGNED"}
+		if shard.Route.Primary != -1 {
+			var primary = shard.Status[shard.Route.Primary]
+			s.Status = primary.Code.String()
+			s.Errors = primary.Errors
+		} else if shard.Spec.Disable {
+			s.Status = "DISABLED"
+		}
+		status.Shards = append(status.Shards, s)
+	}
+
+	if status.Bindings, err = loadStatusBindings(builds, labeling); err != nil {
+		return err
+	}
+
+	var since = time.Now().Add(-cmd.Window)
+
+	if err = readOpsDocuments(ctx, rjc, ops.StatsCollection(cmd.Task), cmd.Task, since, func(line []byte) error {
+		var doc statusStatsDoc
+		if err := json.Unmarshal(line, &doc); err != nil {
+			return fmt.Errorf("decoding stats document: %w", err)
+		}
+		if doc.Shard == nil || doc.Shard.Name != cmd.Task || doc.Timestamp.Before(since) {
+			return nil // Not a stats document of this task and window.
+		}
+		status.reduceStats(&doc)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err = readOpsDocuments(ctx, rjc, ops.LogCollection(cmd.Task), cmd.Task, since, func(line []byte) error {
+		var doc statusLogDoc
+		if err := json.Unmarshal(line, &doc); err != nil {
+			return fmt.Errorf("decoding log document: %w", err)
+		}
+		if doc.Shard == nil || doc.Shard.Name != cmd.Task || doc.Timestamp.Before(since) {
+			return nil
+		} else if doc.Level != "error" && doc.Level != "warn" {
+			return nil
+		}
+		status.reduceLog(&doc, cmd.Errors)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	var seconds = cmd.Window.Seconds()
+	for i := range status.Bindings {
+		var b = &status.Bindings[i]
+		b.ReadDocsPerSec = float64(b.readDocs) / seconds
+		b.ReadBytesPerSec = float64(b.readBytes) / seconds
+		b.WriteDocsPerSec = float64(b.writeDocs) / seconds
+		b.WriteBytesPerSec = float64(b.writeBytes) / seconds
+	}
+
+	if cmd.Format == "json" {
+		var enc = json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(status)
+	}
+	return status.writeTable(os.Stdout)
+}
+
+// loadStatusBindings loads the bindings of the task of |labeling|.
+func loadStatusBindings(builds *flow.BuildService, labeling ops.ShardLabeling) ([]bindingStatus, error) {
+	var build = builds.Open(labeling.Build)
+	defer build.Close()
+
+	var out []bindingStatus
+	var err = build.Extract(func(db *sql.DB) error {
+		switch labeling.TaskType {
+		case ops.TaskType_capture:
+			var spec, err = catalog.LoadCapture(db, labeling.TaskName)
+			if err != nil {
+				return err
+			}
+			for i, b := range spec.Bindings {
+				out = append(out, newBindingStatus(i, b.ResourcePath, b.Collection.Name.String(), b.StateKey))
+			}
+		case ops.TaskType_derivation:
+			var spec, err = catalog.LoadCollection(db, labeling.TaskName)
+			if err != nil {
+				return err
+			} else if spec.Derivation == nil {
+				return fmt.Errorf("collection %s is not a derivation", labeling.TaskName)
+			}
+			for i, t := range spec.Derivation.Transforms {
+				out = append(out, newBindingStatus(i, []string{t.Name.String()}, t.Collection.Name.String(), t.Name.String()))
+			}
+		case ops.TaskType_materialization:
+			var spec, err = catalog.LoadMaterialization(db, labeling.TaskName)
+			if err != nil {
+				return err
+			}
+			for i, b := range spec.Bindings {
+				out = append(out, newBindingStatus(i, b.ResourcePath, b.Collection.Name.String(), b.StateKey))
+			}
+		default:
+			return fmt.Errorf("task %s has unexpected type %s", labeling.TaskName, labeling.TaskType)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("loading task %s: %w", labeling.TaskName, err)
+	}
+	return out, nil
+}
+
+func newBindingStatus(index int, resource []string, collection, statsKey string) bindingStatus {
+	var identifiers = append([]string{collection}, resource...)
+	if len(resource) > 1 {
+		identifiers = append(identifiers, strings.Join(resource, "."))
+	}
+	return bindingStatus{
+		Index:       index,
+		Resource:    resource,
+		Collection:  collection,
+		statsKey:    statsKey,
+		identifiers: identifiers,
+	}
+}
+
+// reduceStats reduces a stats |doc| into the status of each binding.
+func (s *taskStatus) reduceStats(doc *statusStatsDoc) {
+	for i := range s.Bindings {
+		var b = &s.Bindings[i]
+		var read, write statusDocsAndBytes
+
+		// Bindings of captures and materializations are keyed on state key,
+		// which distinguishes bindings which share a collection.
+		if stats, ok := doc.Bindings[b.statsKey]; ok {
+			read, write = stats.Right, stats.Out
+		} else if doc.Derive != nil {
+			read = doc.Derive.Transforms[b.statsKey].Input
+		}
+
+		b.readDocs += read.DocsTotal
+		b.readBytes += read.BytesTotal
+		b.writeDocs += write.DocsTotal
+		b.writeBytes += write.BytesTotal
+
+		if read.DocsTotal+write.DocsTotal == 0 {
+			continue
+		} else if b.LastDocumentAt == nil || b.LastDocumentAt.Before(doc.Timestamp) {
+			var ts = doc.Timestamp
+			b.LastDocumentAt = &ts
+		}
+	}
+}
+
+// reduceLog attributes an error or warning log |doc| to the bindings it identifies,
+// or to the task if it identifies no binding, retaining up to |limit| recent errors.
+func (s *taskStatus) reduceLog(doc *statusLogDoc, limit int) {
+	var err = statusError{
+		Timestamp: doc.Timestamp,
+		Shard:     fmt.Sprintf("%s/%s-%s", doc.Shard.Name, doc.Shard.KeyBegin, doc.Shard.RClockBegin),
+		Level:     doc.Level,
+		Message:   doc.Message,
+	}
+	var values = make(map[string]struct{}, len(doc.Fields))
+	for name, raw := range doc.Fields {
+		var str string
+		if json.Unmarshal(raw, &str) != nil {
+			str = string(raw) // Not a string, such as a binding index.
+		}
+		if name == "error" || name == "first_error" {
+			err.Error = str
+		}
+		values[str] = struct{}{}
+	}
+
+	var attributed bool
+	for i := range s.Bindings {
+		var b = &s.Bindings[i]
+
+		if !b.identifiedBy(values, doc.Fields["binding"]) {
+			continue
+		}
+		b.ErrorCount++
+		b.Errors = appendRecentError(b.Errors, err, limit)
+		attributed = true
+	}
+	if !attributed {
+		s.Errors = appendRecentError(s.Errors, err, limit)
+	}
+}
+
+// identifiedBy returns true if log field |values|, or a |binding| index field, identify the binding.
+func (b *bindingStatus) identifiedBy(values map[string]struct{}, binding json.RawMessage) bool {
+	if binding != nil && string(binding) == fmt.Sprint(b.Index) {
+		return true
+	}
+	for _, id := range b.identifiers {
+		if _, ok := values[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// appendRecentError appends |err| to |errors|, retaining only the |limit| most recent.
+func appendRecentError(errors []statusError, err statusError, limit int) []statusError {
+	errors = append(errors, err)
+	sort.SliceStable(errors, func(i, j int) bool { return errors[i].Timestamp.After(errors[j].Timestamp) })
+
+	if len(errors) > limit {
+		errors = errors[:limit]
+	}
+	return errors
+}
+
+// readOpsDocuments invokes |onLine| with each committed document of the ops
+// |collection| partition of |task|, within fragments modified after |since|.
+// Documents are read through the current write head. Documents of transactions
+// which haven't yet committed, and acknowledgements, are not included.
+func readOpsDocuments(
+	ctx context.Context,
+	rjc pb.RoutedJournalClient,
+	collection pf.Collection,
+	task string,
+	since time.Time,
+	onLine func(line []byte) error,
+) error {
+	var journals, err = client.ListAllJournals(ctx, rjc, pb.ListRequest{
+		Selector: pb.LabelSelector{Include: pb.MustLabelSet(
+			labels.Collection, collection.String(),
+			labels.FieldPrefix+"name", string(labels.EncodePartitionValue(nil, task)),
+		)},
+	})
+	if err != nil {
+		return fmt.Errorf("listing journals of %s: %w", collection, err)
+	}
+
+	for _, journal := range journals.Journals {
+		var rr = client.NewRetryReader(ctx, rjc, pb.ReadRequest{
+			Journal:      journal.Spec.Name,
+			Offset:       0,
+			Block:        false,
+			BeginModTime: since.Unix(),
+		})
+		var it = message.NewReadCommittedIter(rr, newOpsDocument,
+			message.NewSequencer(nil, nil, 1024))
+
+		for done := false; !done; {
+			var env, err = it.Next()
+
+			switch {
+			case err == nil:
+				if message.GetFlags(env.Message.GetUUID()) == message.Flag_ACK_TXN {
+					continue
+				} else if err = onLine(env.Message.(*opsDocument).doc); err != nil {
+					return fmt.Errorf("reading %s: %w", journal.Spec.Name, err)
+				}
+			case err == io.EOF, errors.Is(err, client.ErrOffsetNotYetAvailable):
+				done = true
+			default:
+				return fmt.Errorf("reading %s: %w", journal.Spec.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// opsDocument is a message.Message of an ops collection document,
+// which retains the document's JSON.
+type opsDocument struct {
+	uuid message.UUID
+	doc  json.RawMessage
+}
+
+func newOpsDocument(*pb.JournalSpec) (message.Message, error) { return new(opsDocument), nil }
+
+func (d *opsDocument) UnmarshalJSON(b []byte) error {
+	var meta struct {
+		Meta struct {
+			UUID message.UUID `json:"uuid"`
+		} `json:"_meta"`
+	}
+	if err := json.Unmarshal(b, &meta); err != nil {
+		return err
+	}
+	d.uuid, d.doc = meta.Meta.UUID, append(json.RawMessage(nil), b...)
+	return nil
+}
+
+func (d *opsDocument) GetUUID() message.UUID                         { return d.uuid }
+func (d *opsDocument) SetUUID(uuid message.UUID)                     { d.uuid = uuid }
+func (d *opsDocument) NewAcknowledgement(pb.Journal) message.Message { return new(opsDocument) }
+
+// writeTable writes the task status as human-readable tables.
+func (s *taskStatus) writeTable(w io.Writer) error {
+	var tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Task %s (%s) of build %s, over the last %s.\n\n", s.Task, s.Type, s.Build, s.Window)
+
+	fmt.Fprintln(tw, "SHARD\tSTATUS\tERRORS")
+	for _, shard := range s.Shards {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", shard.Shard, shard.Status, strings.Join(shard.Errors, "; "))
+	}
+	fmt.Fprintln(tw)
+
+	fmt.Fprintln(tw, "BINDING\tRESOURCE\tCOLLECTION\tREAD DOCS/S\tREAD BYTES/S\tWRITE DOCS/S\tWRITE BYTES/S\tLAST DOCUMENT\tERRORS")
+	for _, b := range s.Bindings {
+		var last = "-"
+		if b.LastDocumentAt != nil {
+			last = b.LastDocumentAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%.1f\t%.0f\t%.1f\t%.0f\t%s\t%d\n",
+			b.Index,
+			strings.Join(b.Resource, "."),
+			b.Collection,
+			b.ReadDocsPerSec,
+			b.ReadBytesPerSec,
+			b.WriteDocsPerSec,
+			b.WriteBytesPerSec,
+			last,
+			b.ErrorCount,
+		)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, b := range s.Bindings {
+		writeStatusErrors(w, fmt.Sprintf("binding %d (%s)", b.Index, strings.Join(b.Resource, ".")), b.Errors)
+	}
+	writeStatusErrors(w, "task", s.Errors)
+
+	return nil
+}
+
+func writeStatusErrors(w io.Writer, title string, errors []statusError) {
+	if len(errors) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\nRecent errors of %s:\n", title)
+
+	for _, err := range errors {
+		fmt.Fprintf(w, "  %s %s [%s] %s", err.Timestamp.Format(time.RFC3339), err.Shard, err.Level, err.Message)
+		if err.Error != "" {
+			fmt.Fprintf(w, ": %s", err.Error)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func (cmd cmdShardsStatus) Execute(_ []string) error {
+	defer mbp.InitDiagnosticsAndRecover(cmd.Diagnostics)()
+	mbp.InitLog(gazctlcmd.ShardsCfg.Log)
+
+	log.WithFields(log.Fields{
+		"config":    cmd,
+		"version":   mbp.Version,
+		"buildDate": mbp.BuildDate,
+	}).Debug("flowctl configuration")
+	pb.RegisterGRPCDispatcher(gazctlcmd.ShardsCfg.Zone)
+
+	return cmd.execute(context.Background())
+}
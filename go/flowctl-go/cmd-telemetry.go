@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/estuary/flow/go/telemetry"
+	"github.com/jessevdk/go-flags"
+)
+
+type telemetryStatus struct{}
+
+func (cmd telemetryStatus) Execute(_ []string) error {
+	var path, err = telemetry.ConfigPath()
+	if err != nil {
+		return err
+	}
+	cfg, err := telemetry.LoadConfig()
+	if err != nil {
+		return err
+	}
+	var endpoint, reason = cfg.Resolve()
+
+	fmt.Printf("config:    %s\n", path)
+	fmt.Printf("enabled:   %t\n", cfg.Enabled)
+	fmt.Printf("installId: %s\n", cfg.InstallID)
+
+	if endpoint != "" {
+		fmt.Printf("reporting: to %s\n", endpoint)
+	} else {
+		fmt.Printf("reporting: no (%s)\n", reason)
+	}
+	return nil
+}
+
+type telemetryEnable struct {
+	Endpoint string `long:"endpoint" description:"Endpoint to which anonymous usage events are reported. Required if an endpoint isn't already configured"`
+}
+
+func (cmd telemetryEnable) Execute(_ []string) error {
+	var cfg, err = telemetry.LoadConfig()
+	if err != nil {
+		return err
+	}
+	if cmd.Endpoint != "" {
+		cfg.Endpoint = cmd.Endpoint
+	}
+	if cfg.Endpoint == "" {
+		return fmt.Errorf("--endpoint is required")
+	}
+	cfg.Enabled = true
+
+	if err = cfg.Save(); err != nil {
+		return err
+	}
+	fmt.Printf("Telemetry is enabled, and anonymous usage events are reported to %s.\n", cfg.Endpoint)
+	return nil
+}
+
+type telemetryDisable struct{}
+
+func (cmd telemetryDisable) Execute(_ []string) error {
+	var cfg, err = telemetry.LoadConfig()
+	if err != nil {
+		return err
+	}
+	cfg.Enabled = false
+
+	if err = cfg.Save(); err != nil {
+		return err
+	}
+	fmt.Println("Telemetry is disabled.")
+	return nil
+}
+
+// reportingCommandHandler is a flags.Parser CommandHandler which executes
+// the active command, and reports its telemetry.
+func reportingCommandHandler(parser *flags.Parser) func(flags.Commander, []string) error {
+	return func(command flags.Commander, args []string) error {
+		if command == nil {
+			return nil
+		}
+		var started = time.Now()
+		var err = command.Execute(args)
+
+		telemetry.Report(activeCommandName(parser), time.Since(started), err)
+		return err
+	}
+}
+
+// activeCommandName returns the space-separated name of the active
+// (sub-)command of the |parser|, such as "api build".
+func activeCommandName(parser *flags.Parser) string {
+	var name string
+	for c := parser.Active; c != nil; c = c.Active {
+		if name != "" {
+			name += " "
+		}
+		name += c.Name
+	}
+	return name
+}
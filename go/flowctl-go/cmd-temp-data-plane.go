@@ -12,20 +12,23 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/estuary/flow/go/bindings"
 	"github.com/estuary/flow/go/pkgbin"
 	log "github.com/sirupsen/logrus"
 	mbp "go.gazette.dev/core/mainboilerplate"
 )
 
 type cmdTempDataPlane struct {
-	BrokerPort   uint16                `long:"broker-port" default:"8080" description:"Port bound by Gazette broker"`
-	ConsumerPort uint16                `long:"consumer-port" default:"9000" description:"Port bound by Flow consumer"`
-	Network      string                `long:"network" description:"The Docker network that connector containers are given access to."`
-	Sigterm      bool                  `long:"sigterm" hidden:"true" description:"Send SIGTERM rather than SIGKILL on exit"`
-	Tempdir      string                `long:"tempdir" description:"Directory for data plane files. If not set, a temporary directory is created and then deleted upon exit"`
-	UnixSockets  bool                  `long:"unix-sockets" description:"Bind Gazette to 'gazette.sock' and Flow to 'consumer.sock' within the --tempdir (instead of TCP ports)"`
-	Log          mbp.LogConfig         `group:"Logging" namespace:"log" env-namespace:"LOG"`
-	Diagnostics  mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
+	Airgapped     bool                  `long:"airgapped" env:"FLOW_AIRGAPPED" description:"Run without access to external resources. Connector images are never pulled and must be pre-loaded, and remote catalog resources are not fetched"`
+	AddressFamily string                `long:"address-family" env:"FLOW_CONNECTOR_ADDRESS_FAMILY" default:"any" choice:"any" choice:"ipv4" choice:"ipv6" description:"Address family used to reach connector containers and the SSH endpoints of their network tunnels. 'any' prefers IPv4 and falls back to IPv6"`
+	BrokerPort    uint16                `long:"broker-port" default:"8080" description:"Port bound by Gazette broker"`
+	ConsumerPort  uint16                `long:"consumer-port" default:"9000" description:"Port bound by Flow consumer"`
+	Network       string                `long:"network" description:"The Docker network that connector containers are given access to."`
+	Sigterm       bool                  `long:"sigterm" hidden:"true" description:"Send SIGTERM rather than SIGKILL on exit"`
+	Tempdir       string                `long:"tempdir" description:"Directory for data plane files. If not set, a temporary directory is created and then deleted upon exit"`
+	UnixSockets   bool                  `long:"unix-sockets" description:"Bind Gazette to 'gazette.sock' and Flow to 'consumer.sock' within the --tempdir (instead of TCP ports)"`
+	Log           mbp.LogConfig         `group:"Logging" namespace:"log" env-namespace:"LOG"`
+	Diagnostics   mbp.DiagnosticsConfig `group:"Debug" namespace:"debug" env-namespace:"DEBUG"`
 
 	etcd     *exec.Cmd
 	gazette  *exec.Cmd
@@ -89,6 +92,10 @@ func (cmd *cmdTempDataPlane) start(ctx context.Context, tempdir string) (etcdAdd
 	}
 	buildsRoot = "file://" + buildsRoot + "/"
 
+	// Child processes inherit our environment, including air-gapped operation.
+	bindings.SetAirgapped(cmd.Airgapped)
+	bindings.SetConnectorAddressFamily(cmd.AddressFamily)
+
 	// Shell out to start etcd, gazette, and the flow consumer.
 	cmd.etcd, etcdAddr = cmd.etcdCmd(ctx, tempdir)
 	cmd.gazette, brokerAddr = cmd.gazetteCmd(ctx, tempdir, etcdAddr)
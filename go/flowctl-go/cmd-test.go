@@ -15,6 +15,7 @@ import (
 )
 
 type cmdTest struct {
+	Airgapped   bool                  `long:"airgapped" env:"FLOW_AIRGAPPED" description:"Run without access to external resources. Connector images are never pulled and must be pre-loaded, and remote catalog resources are not fetched"`
 	Network     string                `long:"network" description:"The Docker network that connector containers are given access to."`
 	Source      string                `long:"source" required:"true" description:"Catalog source file or URL to build"`
 	Snapshot    string                `long:"snapshot" description:"When set, failed test verifications produce snapshots into the given base directory"`
@@ -47,6 +48,7 @@ func (cmd cmdTest) Execute(_ []string) (retErr error) {
 
 	// Start a temporary data plane bound to our context.
 	var dataPlane = cmdTempDataPlane{
+		Airgapped:   cmd.Airgapped,
 		UnixSockets: true,
 		Log: mbp.LogConfig{
 			Level:  "warn",
@@ -61,7 +63,8 @@ func (cmd cmdTest) Execute(_ []string) (retErr error) {
 	var buildID = "test-build-id"
 
 	if err := (apiBuild{
-		BuildID: buildID,
+		Airgapped: cmd.Airgapped,
+		BuildID:   buildID,
 		// Build directly into the temp dataplane's build directory.
 		BuildDB:    filepath.Join(tempdir, "builds", buildID),
 		FileRoot:   "/",
@@ -16,6 +16,20 @@ func main() {
 Locally test a Flow catalog.
 		`, &cmdTest{})
 
+	addCmd(parser, "rebuild-on-change", "Rebuild a Flow catalog as its sources change, and reload its derivation transforms", `
+Build a Flow catalog and run its derivations in an ephemeral, local data plane.
+
+The local files of the catalog, including the SQL and TypeScript sources of
+derivation lambdas, are watched for changes. Upon a change the catalog is
+rebuilt. If only derivation lambdas changed, then running derivation shards
+reload their transforms in place at their next transaction boundary, and
+continue from their current registers and checkpoints. Other changes are
+applied by re-activating the derivations with the new build.
+
+A build which fails is reported, and the prior build continues to run until the
+next change. Upon exit, all data is discarded.
+`, &cmdRebuildOnChange{})
+
 	addCmd(parser, "temp-data-plane", "Run an ephemeral, temporary local data plane", `
 Run a local data plane by shelling out to start Etcd, Gazette, and the Flow consumer.
 A local data plane is intended for local development and testing, and doesn't persist
@@ -42,6 +56,56 @@ its responsible shards and will exit only when it can safely do so.
 	mbp.Must(err, "failed to add shards command")
 	mbp.Must(gazctlcmd.CommandRegistry.AddCommands("shards", shards, true), "failed to add commands")
 
+	admin, err := parser.Command.AddCommand("admin", "Administer a Flow data plane", "", &struct{}{})
+	mbp.Must(err, "failed to add command")
+
+	addCmd(admin, "backup", "Back up the journal and shard specs of a data plane", `
+Back up all journal and shard specifications of a data plane, including their
+task and collection labels, into a versioned archive. The archive may later be
+restored into the same or a fresh Etcd for disaster recovery.
+`, &adminBackup{})
+
+	addCmd(admin, "restore", "Restore journal and shard specs from a backup archive", `
+Restore journal and shard specifications from an archive produced by
+'admin backup'. Specs which already exist in the data plane are handled
+according to --on-conflict: the restore may fail, skip them, or overwrite them.
+`, &adminRestore{})
+
+	addCmd(parser, "migrate-plane", "Migrate journals and shards to a new data plane", `
+Migrate all journals and shards from a source data plane to a target data plane.
+
+The migration copies journal specifications, verifies that the target indexes
+the fragments which the source has persisted, and replays recent journal
+content which is not yet persisted. It then disables shards of the source,
+replays remaining content, and creates shards in the target, which minimizes
+the time in which tasks are not running.
+
+Both data planes must share the fragment stores of migrated journals.
+A runbook of each step performed is printed to stdout.
+`, &cmdMigratePlane{})
+
+	telemetryCmd, err := parser.Command.AddCommand("telemetry", "Manage anonymous usage telemetry", `
+Telemetry is opt-in, and is disabled unless enabled by 'telemetry enable'.
+When enabled, each command invocation reports an anonymous event having the
+command name, the class of its error (if any), and histograms of durations.
+Events never include arguments, error messages, catalog contents, or host names.
+
+Telemetry is always suppressed if DO_NOT_TRACK or FLOW_AIRGAPPED are set.
+`, &struct{}{})
+	mbp.Must(err, "failed to add command")
+
+	addCmd(telemetryCmd, "status", "Show the telemetry configuration", `
+Show whether telemetry is enabled, and where events are reported.
+`, &telemetryStatus{})
+
+	addCmd(telemetryCmd, "enable", "Opt into anonymous usage telemetry", `
+Opt into anonymous usage telemetry.
+`, &telemetryEnable{})
+
+	addCmd(telemetryCmd, "disable", "Opt out of anonymous usage telemetry", `
+Opt out of anonymous usage telemetry.
+`, &telemetryDisable{})
+
 	mbp.AddPrintConfigCmd(parser, iniFilename)
 
 	apis, err := parser.Command.AddCommand("api", "Low-level APIs for automation", `
@@ -75,7 +139,8 @@ Delete tasks and collections of a built Flow catalog.
 Discover resources of a capture connector using a configuration.
 `, &apiDiscover{})
 
-	// Parse config and start command
+	// Parse config and start command, reporting its telemetry.
+	parser.CommandHandler = reportingCommandHandler(parser)
 	mbp.MustParseConfig(parser, iniFilename)
 }
 
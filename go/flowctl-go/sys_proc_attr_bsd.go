@@ -1,10 +1,10 @@
-//go:build aix || darwin || (js && wasm) || (solaris && !illumos)
+//go:build aix || darwin || (js && wasm) || (solaris && !illumos) || windows
 
 package main
 
 import "syscall"
 
-// Darwin does not support Pdeathsig so we just leave a default SysProcAttr
+// Darwin and Windows do not support Pdeathsig so we just leave a default SysProcAttr
 func SysProcAttr() *syscall.SysProcAttr {
 	return &syscall.SysProcAttr{}
 }
@@ -68,6 +68,16 @@ const (
 	ExposePort       = "estuary.dev/expose-port"
 	PortProtoPrefix  = "estuary.dev/port-proto/"
 	PortPublicPrefix = "estuary.dev/port-public/"
+
+	// MemoryLimit is an optional limit, in bytes, of the memory which may be
+	// held by the combine buffers, read-ahead queues, and connector proxies
+	// of the shard. It overrides the default limit of the Flow consumer.
+	MemoryLimit = "estuary.dev/memory-limit"
+
+	// RediscoverInterval is an optional interval, as a Go duration, at which
+	// a capture shard re-discovers its endpoint in the background and reports
+	// bindings which are stale with respect to the discovered resources.
+	RediscoverInterval = "estuary.dev/rediscover-interval"
 )
 
 // A re-exported subset of Gazette labels, defined in go.gazette.dev/core/labels/labels.go.
@@ -2,6 +2,8 @@ package labels
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	pf "github.com/estuary/flow/go/protocols/flow"
 	"github.com/estuary/flow/go/protocols/ops"
@@ -57,6 +59,34 @@ func ParseShardLabels(set pf.LabelSet) (ops.ShardLabeling, error) {
 	return out, nil
 }
 
+// ParseMemoryLimit parses the optional MemoryLimit label of a ShardSpec,
+// returning its limit in bytes or zero if the label isn't set.
+func ParseMemoryLimit(set pf.LabelSet) (int64, error) {
+	if str, err := maybeOne(set, MemoryLimit); err != nil {
+		return 0, err
+	} else if str == "" {
+		return 0, nil
+	} else if limit, err := strconv.ParseInt(str, 10, 64); err != nil || limit < 0 {
+		return 0, fmt.Errorf("%q is not a valid memory limit", str)
+	} else {
+		return limit, nil
+	}
+}
+
+// ParseRediscoverInterval parses the optional RediscoverInterval label of a
+// ShardSpec, returning its interval or zero if the label isn't set.
+func ParseRediscoverInterval(set pf.LabelSet) (time.Duration, error) {
+	if str, err := maybeOne(set, RediscoverInterval); err != nil {
+		return 0, err
+	} else if str == "" {
+		return 0, nil
+	} else if interval, err := time.ParseDuration(str); err != nil || interval <= 0 {
+		return 0, fmt.Errorf("%q is not a valid rediscover interval", str)
+	} else {
+		return interval, nil
+	}
+}
+
 // ExpectOne extracts label |name| from the |set|.
 // The label is expected to exist with a single non-empty value.
 func ExpectOne(set pf.LabelSet, name string) (string, error) {
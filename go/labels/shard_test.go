@@ -2,6 +2,7 @@ package labels
 
 import (
 	"testing"
+	"time"
 
 	pf "github.com/estuary/flow/go/protocols/flow"
 	"github.com/estuary/flow/go/protocols/ops"
@@ -108,3 +109,49 @@ func TestParsingShardLabels(t *testing.T) {
 	require.EqualError(t, err,
 		"expected estuary.dev/key-begin to be a 4-byte, hex encoded integer; got whoops")
 }
+
+func TestParsingMemoryLimit(t *testing.T) {
+	var set = pb.MustLabelSet()
+
+	var limit, err = ParseMemoryLimit(set)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), limit)
+
+	set.SetValue(MemoryLimit, "1073741824")
+	limit, err = ParseMemoryLimit(set)
+	require.NoError(t, err)
+	require.Equal(t, int64(1<<30), limit)
+
+	set.SetValue(MemoryLimit, "-1")
+	_, err = ParseMemoryLimit(set)
+	require.EqualError(t, err, "\"-1\" is not a valid memory limit")
+
+	set.SetValue(MemoryLimit, "lots")
+	_, err = ParseMemoryLimit(set)
+	require.EqualError(t, err, "\"lots\" is not a valid memory limit")
+
+	set.AddValue(MemoryLimit, "1234")
+	_, err = ParseMemoryLimit(set)
+	require.EqualError(t, err, "expected one label for \"estuary.dev/memory-limit\" (got [1234 lots])")
+}
+
+func TestParsingRediscoverInterval(t *testing.T) {
+	var set = pb.MustLabelSet()
+
+	var interval, err = ParseRediscoverInterval(set)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), interval)
+
+	set.SetValue(RediscoverInterval, "6h")
+	interval, err = ParseRediscoverInterval(set)
+	require.NoError(t, err)
+	require.Equal(t, 6*time.Hour, interval)
+
+	set.SetValue(RediscoverInterval, "-1h")
+	_, err = ParseRediscoverInterval(set)
+	require.EqualError(t, err, "\"-1h\" is not a valid rediscover interval")
+
+	set.SetValue(RediscoverInterval, "often")
+	_, err = ParseRediscoverInterval(set)
+	require.EqualError(t, err, "\"often\" is not a valid rediscover interval")
+}
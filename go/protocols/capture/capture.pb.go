@@ -532,6 +532,9 @@ func (m *Response_Spec) XXX_DiscardUnknown() {
 var xxx_messageInfo_Response_Spec proto.InternalMessageInfo
 
 // Discovered responds to Request.Discover.
+// A large response may be sent as a sequence of Discovered messages,
+// each having a chunk of the discovered bindings, which are concatenated.
+// The response is complete when the connector closes its stream.
 type Response_Discovered struct {
 	Bindings             []*Response_Discovered_Binding `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
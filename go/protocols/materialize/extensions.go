@@ -177,6 +177,16 @@ func (m *Request_Acknowledge) Validate() error {
 	return nil
 }
 
+// Validate returns an error if the message is not well-formed.
+func (m *Request_TypeMapping) Validate_() error {
+	if m.Validate == nil {
+		return pb.NewValidationError("expected Validate")
+	} else if err := m.Validate.Validate(); err != nil {
+		return pb.ExtendContext(err, "Validate")
+	}
+	return nil
+}
+
 // Validate returns an error if the message is not well-formed.
 func (m *Response_Opened) Validate() error {
 	// FlowCheckpoint may be empty.
@@ -205,6 +215,19 @@ func (m *Response_Acknowledged) Validate() error {
 	return nil
 }
 
+// Validate returns an error if the message is not well-formed.
+func (m *Response_TypeMapped) Validate() error {
+	for i, binding := range m.Bindings {
+		for field, mapping := range binding.Mappings {
+			if mapping == nil || mapping.ColumnType == "" {
+				return pb.ExtendContext(
+					pb.NewValidationError("expected ColumnType"), "Bindings[%d].Mappings[%s]", i, field)
+			}
+		}
+	}
+	return nil
+}
+
 // Validate returns an error if the message is not well-formed.
 func (m *Request) Validate_() error {
 	var count int
@@ -262,9 +285,15 @@ func (m *Request) Validate_() error {
 		}
 		count += 1
 	}
+	if m.TypeMapping != nil {
+		if err := m.TypeMapping.Validate_(); err != nil {
+			return pb.ExtendContext(err, "TypeMapping")
+		}
+		count += 1
+	}
 
 	if count != 1 {
-		return pb.NewValidationError("expected one of Spec, Validate, Apply, Open, Load, Prepare, Store, Commit, or Acknowledge")
+		return pb.NewValidationError("expected one of Spec, Validate, Apply, Open, Load, Prepare, Store, Commit, Acknowledge, or TypeMapping")
 	}
 	return nil
 }
@@ -320,9 +349,15 @@ func (m *Response) Validate() error {
 		}
 		count += 1
 	}
+	if m.TypeMapped != nil {
+		if err := m.TypeMapped.Validate(); err != nil {
+			return pb.ExtendContext(err, "TypeMapped")
+		}
+		count += 1
+	}
 
 	if count != 1 {
-		return pb.NewValidationError("expected one of Spec, Validated, Applied, Opened, Loaded, Flushed, StartedCommit, or Acknowledged")
+		return pb.NewValidationError("expected one of Spec, Validated, Applied, Opened, Loaded, Flushed, StartedCommit, Acknowledged, or TypeMapped")
 	}
 	return nil
 }
@@ -337,6 +372,8 @@ func (m *Request) InvokeConfig() (*json.RawMessage, string) {
 		return &m.Apply.Materialization.ConfigJson, m.Apply.Materialization.ConnectorType.String()
 	case m.Open != nil:
 		return &m.Open.Materialization.ConfigJson, m.Open.Materialization.ConnectorType.String()
+	case m.TypeMapping != nil:
+		return &m.TypeMapping.Validate.ConfigJson, m.TypeMapping.Validate.ConnectorType.String()
 	default:
 		panic("invalid request")
 	}
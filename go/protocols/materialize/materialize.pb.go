@@ -90,6 +90,7 @@ type Request struct {
 	Store       *Request_Store       `protobuf:"bytes,7,opt,name=store,proto3" json:"store,omitempty"`
 	StartCommit *Request_StartCommit `protobuf:"bytes,8,opt,name=start_commit,json=startCommit,proto3" json:"start_commit,omitempty"`
 	Acknowledge *Request_Acknowledge `protobuf:"bytes,9,opt,name=acknowledge,proto3" json:"acknowledge,omitempty"`
+	TypeMapping *Request_TypeMapping `protobuf:"bytes,10,opt,name=type_mapping,json=typeMapping,proto3" json:"type_mapping,omitempty"`
 	// Reserved for internal use.
 	Internal             []byte   `protobuf:"bytes,100,opt,name=internal,json=$internal,proto3" json:"internal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -634,6 +635,51 @@ func (m *Request_Acknowledge) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Request_Acknowledge proto.InternalMessageInfo
 
+// TypeMapping requests the destination column types of the projections of
+// proposed bindings, and the conversions of their values into those types.
+// TypeMapping is run out-of-band with ongoing connector invocations,
+// and is used to report the type mapping of a materialization to users.
+type Request_TypeMapping struct {
+	// Validate request of the proposed configuration and bindings.
+	Validate             *Request_Validate `protobuf:"bytes,1,opt,name=validate,proto3" json:"validate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Request_TypeMapping) Reset()         { *m = Request_TypeMapping{} }
+func (m *Request_TypeMapping) String() string { return proto.CompactTextString(m) }
+func (*Request_TypeMapping) ProtoMessage()    {}
+func (*Request_TypeMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8b62b327f34bc6, []int{0, 9}
+}
+func (m *Request_TypeMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Request_TypeMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Request_TypeMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Request_TypeMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Request_TypeMapping.Merge(m, src)
+}
+func (m *Request_TypeMapping) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Request_TypeMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_Request_TypeMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Request_TypeMapping proto.InternalMessageInfo
+
 type Response struct {
 	Spec          *Response_Spec          `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
 	Validated     *Response_Validated     `protobuf:"bytes,2,opt,name=validated,proto3" json:"validated,omitempty"`
@@ -643,6 +689,7 @@ type Response struct {
 	Flushed       *Response_Flushed       `protobuf:"bytes,6,opt,name=flushed,proto3" json:"flushed,omitempty"`
 	StartedCommit *Response_StartedCommit `protobuf:"bytes,7,opt,name=started_commit,json=startedCommit,proto3" json:"started_commit,omitempty"`
 	Acknowledged  *Response_Acknowledged  `protobuf:"bytes,8,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	TypeMapped    *Response_TypeMapped    `protobuf:"bytes,9,opt,name=type_mapped,json=typeMapped,proto3" json:"type_mapped,omitempty"`
 	// Reserved for internal use.
 	Internal             []byte   `protobuf:"bytes,100,opt,name=internal,json=$internal,proto3" json:"internal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1169,6 +1216,136 @@ func (m *Response_Acknowledged) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Response_Acknowledged proto.InternalMessageInfo
 
+// TypeMapped responds to Request.TypeMapping.
+type Response_TypeMapped struct {
+	Bindings             []*Response_TypeMapped_Binding `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *Response_TypeMapped) Reset()         { *m = Response_TypeMapped{} }
+func (m *Response_TypeMapped) String() string { return proto.CompactTextString(m) }
+func (*Response_TypeMapped) ProtoMessage()    {}
+func (*Response_TypeMapped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8b62b327f34bc6, []int{1, 8}
+}
+func (m *Response_TypeMapped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Response_TypeMapped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Response_TypeMapped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Response_TypeMapped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Response_TypeMapped.Merge(m, src)
+}
+func (m *Response_TypeMapped) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Response_TypeMapped) XXX_DiscardUnknown() {
+	xxx_messageInfo_Response_TypeMapped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Response_TypeMapped proto.InternalMessageInfo
+
+// Mapping of a projection into its destination column.
+type Response_TypeMapped_Mapping struct {
+	// Destination column type of the projection, such as "VARCHAR(256)".
+	ColumnType string `protobuf:"bytes,1,opt,name=column_type,json=columnType,proto3" json:"column_type,omitempty"`
+	// Conversions of the projection's values into the column type which may
+	// lose information, such as truncations or a loss of numeric precision.
+	Conversions          []string `protobuf:"bytes,2,rep,name=conversions,proto3" json:"conversions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Response_TypeMapped_Mapping) Reset()         { *m = Response_TypeMapped_Mapping{} }
+func (m *Response_TypeMapped_Mapping) String() string { return proto.CompactTextString(m) }
+func (*Response_TypeMapped_Mapping) ProtoMessage()    {}
+func (*Response_TypeMapped_Mapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8b62b327f34bc6, []int{1, 8, 0}
+}
+func (m *Response_TypeMapped_Mapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Response_TypeMapped_Mapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Response_TypeMapped_Mapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Response_TypeMapped_Mapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Response_TypeMapped_Mapping.Merge(m, src)
+}
+func (m *Response_TypeMapped_Mapping) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Response_TypeMapped_Mapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_Response_TypeMapped_Mapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Response_TypeMapped_Mapping proto.InternalMessageInfo
+
+// Type mappings of each binding of the request, and matching the request
+// ordering.
+type Response_TypeMapped_Binding struct {
+	// Mappings of collection projections, keyed by the projection field name.
+	// Projections which the connector cannot materialize are omitted.
+	Mappings             map[string]*Response_TypeMapped_Mapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *Response_TypeMapped_Binding) Reset()         { *m = Response_TypeMapped_Binding{} }
+func (m *Response_TypeMapped_Binding) String() string { return proto.CompactTextString(m) }
+func (*Response_TypeMapped_Binding) ProtoMessage()    {}
+func (*Response_TypeMapped_Binding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e8b62b327f34bc6, []int{1, 8, 1}
+}
+func (m *Response_TypeMapped_Binding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Response_TypeMapped_Binding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Response_TypeMapped_Binding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Response_TypeMapped_Binding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Response_TypeMapped_Binding.Merge(m, src)
+}
+func (m *Response_TypeMapped_Binding) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Response_TypeMapped_Binding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Response_TypeMapped_Binding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Response_TypeMapped_Binding proto.InternalMessageInfo
+
 // Extra messages used by connectors
 // TODO(johnny): Do we still need this?
 type Extra struct {
@@ -1310,6 +1487,7 @@ func init() {
 	proto.RegisterType((*Request_Store)(nil), "materialize.Request.Store")
 	proto.RegisterType((*Request_StartCommit)(nil), "materialize.Request.StartCommit")
 	proto.RegisterType((*Request_Acknowledge)(nil), "materialize.Request.Acknowledge")
+	proto.RegisterType((*Request_TypeMapping)(nil), "materialize.Request.TypeMapping")
 	proto.RegisterType((*Response)(nil), "materialize.Response")
 	proto.RegisterType((*Response_Spec)(nil), "materialize.Response.Spec")
 	proto.RegisterType((*Response_Validated)(nil), "materialize.Response.Validated")
@@ -1322,6 +1500,10 @@ func init() {
 	proto.RegisterType((*Response_Flushed)(nil), "materialize.Response.Flushed")
 	proto.RegisterType((*Response_StartedCommit)(nil), "materialize.Response.StartedCommit")
 	proto.RegisterType((*Response_Acknowledged)(nil), "materialize.Response.Acknowledged")
+	proto.RegisterType((*Response_TypeMapped)(nil), "materialize.Response.TypeMapped")
+	proto.RegisterType((*Response_TypeMapped_Mapping)(nil), "materialize.Response.TypeMapped.Mapping")
+	proto.RegisterType((*Response_TypeMapped_Binding)(nil), "materialize.Response.TypeMapped.Binding")
+	proto.RegisterMapType((map[string]*Response_TypeMapped_Mapping)(nil), "materialize.Response.TypeMapped.Binding.MappingsEntry")
 	proto.RegisterType((*Extra)(nil), "materialize.Extra")
 	proto.RegisterType((*Extra_ValidateExistingProjectionRequest)(nil), "materialize.Extra.ValidateExistingProjectionRequest")
 	proto.RegisterType((*Extra_ValidateBindingAgainstConstraints)(nil), "materialize.Extra.ValidateBindingAgainstConstraints")
@@ -1333,114 +1515,122 @@ func init() {
 }
 
 var fileDescriptor_3e8b62b327f34bc6 = []byte{
-	// 1699 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0x0e, 0x65, 0xfd, 0x7c, 0x92, 0x6d, 0x79, 0xa2, 0xb4, 0x2c, 0x37, 0x9b, 0x38, 0xde, 0x5d,
-	0xac, 0x91, 0x62, 0xe5, 0xc0, 0x69, 0xb1, 0x49, 0x16, 0x29, 0x2a, 0xc9, 0x12, 0xa0, 0x54, 0xb6,
-	0xbc, 0xe3, 0x24, 0x0b, 0xec, 0x45, 0x98, 0x90, 0x63, 0x99, 0x31, 0xc5, 0x61, 0xc9, 0x51, 0xb2,
-	0xea, 0xa9, 0x28, 0x50, 0x14, 0xe8, 0xad, 0x40, 0xd1, 0x4b, 0x51, 0xa0, 0xb7, 0xfe, 0x05, 0xbd,
-	0xf4, 0x5a, 0x14, 0xc8, 0xb1, 0xb7, 0xde, 0x52, 0x74, 0xfb, 0x17, 0xf4, 0xba, 0x87, 0xa2, 0x98,
-	0x1f, 0xa4, 0x28, 0x47, 0x92, 0xb5, 0x40, 0x9a, 0x8b, 0xc1, 0x79, 0xf3, 0x7d, 0x8f, 0x8f, 0x6f,
-	0xde, 0xbc, 0xf7, 0xc9, 0x70, 0x7b, 0xc8, 0xf6, 0x82, 0x90, 0x71, 0x66, 0x33, 0x2f, 0xda, 0x1b,
-	0x11, 0x4e, 0x43, 0x97, 0x78, 0xee, 0xcf, 0x68, 0xfa, 0xb9, 0x2e, 0x11, 0xa8, 0x9c, 0x32, 0x59,
-	0xdb, 0x36, 0xf3, 0xa3, 0xf1, 0x88, 0x86, 0x09, 0x3d, 0x79, 0x50, 0x70, 0xeb, 0xfa, 0x8c, 0xeb,
-	0x53, 0x8f, 0xbd, 0x94, 0x7f, 0xf4, 0x6e, 0x6d, 0xc8, 0x86, 0x4c, 0x3e, 0xee, 0x89, 0x27, 0x65,
-	0xdd, 0xf9, 0xc5, 0x55, 0x28, 0x60, 0xfa, 0xd3, 0x31, 0x8d, 0x38, 0xfa, 0x04, 0xb2, 0x51, 0x40,
-	0x6d, 0xd3, 0xd8, 0x36, 0x76, 0xcb, 0xfb, 0xdf, 0xab, 0xa7, 0x03, 0xd2, 0x98, 0xfa, 0x49, 0x40,
-	0x6d, 0x2c, 0x61, 0xe8, 0x3e, 0x14, 0x5f, 0x10, 0xcf, 0x75, 0x08, 0xa7, 0x66, 0x46, 0x52, 0xde,
-	0x9f, 0x4b, 0x79, 0xaa, 0x41, 0x38, 0x81, 0xa3, 0x3b, 0x90, 0x23, 0x41, 0xe0, 0x4d, 0xcc, 0x35,
-	0xc9, 0xb3, 0xe6, 0xf2, 0x1a, 0x02, 0x81, 0x15, 0x50, 0xc4, 0xc6, 0x02, 0xea, 0x9b, 0xd9, 0x25,
-	0xb1, 0xf5, 0x03, 0xea, 0x63, 0x09, 0x13, 0x70, 0x8f, 0x11, 0xc7, 0xcc, 0x2d, 0x81, 0xf7, 0x18,
-	0x71, 0xb0, 0x84, 0x89, 0x78, 0x4e, 0xbd, 0x71, 0x74, 0x66, 0xe6, 0x97, 0xc4, 0xd3, 0x11, 0x08,
-	0xac, 0x80, 0x82, 0x11, 0x71, 0x16, 0x52, 0xb3, 0xb0, 0x84, 0x71, 0x22, 0x10, 0x58, 0x01, 0x51,
-	0x0b, 0x2a, 0x11, 0x27, 0x21, 0x1f, 0xd8, 0x6c, 0x34, 0x72, 0xb9, 0x59, 0x94, 0xc4, 0xed, 0x05,
-	0x44, 0x12, 0xf2, 0x96, 0xc4, 0xe1, 0x72, 0x34, 0x5d, 0xa0, 0x26, 0x94, 0x89, 0x7d, 0xee, 0xb3,
-	0x97, 0x1e, 0x75, 0x86, 0xd4, 0x2c, 0x2d, 0xf1, 0xd1, 0x98, 0xe2, 0x70, 0x9a, 0x84, 0xde, 0x83,
-	0xa2, 0xeb, 0x73, 0x1a, 0xfa, 0xc4, 0x33, 0x9d, 0x6d, 0x63, 0xb7, 0x82, 0x4b, 0x1f, 0xc6, 0x06,
-	0xeb, 0x37, 0x06, 0x64, 0xc5, 0x19, 0xa3, 0x23, 0xd8, 0xb0, 0x99, 0xef, 0x53, 0x9b, 0xb3, 0x70,
-	0xc0, 0x27, 0x01, 0x95, 0x65, 0xb1, 0xb1, 0xff, 0x71, 0x5d, 0xd6, 0xd4, 0x61, 0xf2, 0x46, 0xc2,
-	0x5d, 0xe6, 0x0b, 0x4a, 0xbd, 0x15, 0xe3, 0x1f, 0x4f, 0x02, 0x8a, 0xd7, 0xed, 0xf4, 0x12, 0xdd,
-	0x87, 0xb2, 0xcd, 0xfc, 0x53, 0x77, 0x38, 0x78, 0x1e, 0x31, 0x5f, 0x16, 0x4c, 0xa9, 0x79, 0xfd,
-	0x9b, 0xd7, 0x37, 0x4d, 0xea, 0xdb, 0xcc, 0x71, 0xfd, 0xe1, 0x9e, 0xd8, 0xa8, 0x63, 0xf2, 0xf2,
-	0x90, 0x46, 0x11, 0x19, 0x52, 0x9c, 0x57, 0x04, 0xeb, 0x0f, 0x79, 0x28, 0xc6, 0x45, 0x84, 0x3e,
-	0x87, 0xac, 0x4f, 0x46, 0x2a, 0x9a, 0x52, 0xf3, 0xe1, 0x37, 0xaf, 0x6f, 0xde, 0x1f, 0xba, 0xfc,
-	0x6c, 0xfc, 0xac, 0x6e, 0xb3, 0xd1, 0x1e, 0x8d, 0xf8, 0x98, 0x84, 0x13, 0x55, 0xfc, 0x6f, 0x5c,
-	0x87, 0x8b, 0x51, 0x63, 0xe9, 0x6a, 0xce, 0xa7, 0x66, 0xde, 0xe6, 0xa7, 0xae, 0xad, 0xfe, 0xa9,
-	0xa8, 0x01, 0xc5, 0x67, 0xae, 0x2f, 0x20, 0x91, 0x99, 0xdd, 0x5e, 0xdb, 0x2d, 0xef, 0x7f, 0xb4,
-	0xf4, 0x4e, 0xd5, 0x9b, 0x0a, 0x8d, 0x13, 0x1a, 0xea, 0x41, 0xcd, 0x23, 0x11, 0x1f, 0x8c, 0x66,
-	0xc3, 0x4e, 0xae, 0xc2, 0xa2, 0x6f, 0xc2, 0x57, 0x05, 0xed, 0xc2, 0x06, 0xba, 0x05, 0x15, 0xe9,
-	0xed, 0x05, 0x0d, 0x23, 0xe1, 0x45, 0x5c, 0x90, 0x12, 0x2e, 0x0b, 0xdb, 0x53, 0x65, 0xb2, 0xfe,
-	0x9b, 0x81, 0x82, 0x0e, 0x03, 0x3d, 0x82, 0x5a, 0x48, 0x23, 0x36, 0x0e, 0x6d, 0x3a, 0x48, 0xe7,
-	0xc0, 0x58, 0x21, 0x07, 0x1b, 0x31, 0xb3, 0xa5, 0x72, 0xf1, 0x00, 0xc0, 0x66, 0x9e, 0x47, 0x6d,
-	0x19, 0xbe, 0xea, 0x30, 0x35, 0x15, 0x7e, 0x2b, 0xb1, 0x8b, 0xc8, 0x9b, 0xd9, 0x57, 0xaf, 0x6f,
-	0x5e, 0xc1, 0x29, 0x34, 0xfa, 0x95, 0x01, 0xd7, 0x4e, 0x5d, 0xea, 0x39, 0xe9, 0x28, 0x06, 0x23,
-	0x12, 0x98, 0x6b, 0x32, 0xab, 0x0f, 0x57, 0xca, 0x6a, 0xbd, 0x23, 0x5c, 0xa8, 0x70, 0x1e, 0x45,
-	0xcc, 0x3f, 0x24, 0x41, 0xdb, 0xe7, 0xe1, 0xa4, 0x79, 0xfd, 0xd7, 0xff, 0x5c, 0xf2, 0x21, 0xe5,
-	0xd3, 0x29, 0x0d, 0x59, 0x50, 0x7c, 0x46, 0xec, 0xf3, 0x53, 0xd7, 0xf3, 0x64, 0xf3, 0x5a, 0xc7,
-	0xc9, 0xda, 0x6a, 0xc3, 0x77, 0x17, 0xbc, 0x01, 0x55, 0x61, 0xed, 0x9c, 0x4e, 0x54, 0xde, 0xb0,
-	0x78, 0x44, 0x35, 0xc8, 0xbd, 0x20, 0xde, 0x58, 0x15, 0x67, 0x09, 0xab, 0xc5, 0x83, 0xcc, 0x3d,
-	0xc3, 0xfa, 0x87, 0x01, 0x39, 0xd9, 0x2c, 0x51, 0x0b, 0x36, 0x2f, 0x1e, 0xbb, 0x71, 0xd9, 0xb1,
-	0x5f, 0x64, 0x20, 0x13, 0x0a, 0xf1, 0x69, 0xab, 0x57, 0xc5, 0xcb, 0x85, 0xa5, 0x95, 0x7d, 0x2b,
-	0xa5, 0x95, 0x7b, 0xb3, 0xb4, 0xfe, 0x66, 0x40, 0x56, 0x74, 0xf5, 0xff, 0xf7, 0x87, 0x7d, 0x04,
-	0xb9, 0x90, 0xf8, 0x43, 0xaa, 0xe7, 0xd1, 0xa6, 0x72, 0x8a, 0x85, 0x49, 0xba, 0x52, 0xbb, 0xe8,
-	0x53, 0x80, 0x88, 0x13, 0x4e, 0x55, 0x4d, 0x67, 0x57, 0xa8, 0xe9, 0x9c, 0xc4, 0x5b, 0x1c, 0xb2,
-	0x62, 0xda, 0x88, 0x08, 0xf4, 0x3d, 0x95, 0xe1, 0xaf, 0xe3, 0x78, 0x89, 0xee, 0x42, 0xf1, 0x9c,
-	0x4e, 0x56, 0xef, 0x8d, 0xb2, 0x24, 0xde, 0x07, 0x10, 0xa4, 0x80, 0xd8, 0xe7, 0xd4, 0x91, 0xb1,
-	0x57, 0x70, 0xe9, 0x9c, 0x4e, 0x8e, 0xa5, 0xc1, 0x2a, 0x40, 0x4e, 0xce, 0x2c, 0xeb, 0x2f, 0x19,
-	0xc8, 0xc9, 0x59, 0xf4, 0x6e, 0x03, 0x10, 0x8d, 0x50, 0x56, 0x69, 0xb4, 0x7a, 0xc2, 0xf2, 0x8a,
-	0x80, 0x3e, 0x80, 0x75, 0x4d, 0xd5, 0xce, 0x73, 0xd2, 0x79, 0x45, 0x19, 0xb5, 0xff, 0xbb, 0x50,
-	0x74, 0x98, 0xad, 0x9c, 0xe7, 0x57, 0x89, 0xd9, 0x61, 0x36, 0xfa, 0x0e, 0xe4, 0xe9, 0x57, 0x6e,
-	0xc4, 0x23, 0x39, 0xba, 0x8b, 0x58, 0xaf, 0x84, 0xdd, 0xa1, 0x1e, 0xe5, 0x54, 0x4e, 0xe6, 0x22,
-	0xd6, 0x2b, 0x0b, 0x43, 0x39, 0x35, 0x8e, 0x51, 0x0b, 0x50, 0x38, 0xf6, 0xb9, 0x3b, 0xa2, 0x03,
-	0xfb, 0x8c, 0xda, 0xe7, 0x01, 0x73, 0x7d, 0xae, 0x8b, 0xb1, 0x56, 0x8f, 0x35, 0x5a, 0xbd, 0x95,
-	0xec, 0xe1, 0x2d, 0x8d, 0x9f, 0x9a, 0xac, 0x75, 0x28, 0xa7, 0xc6, 0xf3, 0xce, 0x9f, 0x36, 0xa0,
-	0x88, 0x69, 0x14, 0x30, 0x3f, 0xa2, 0xa8, 0x3e, 0xa3, 0xc2, 0x2e, 0x0a, 0x0b, 0x05, 0x4a, 0xcb,
-	0xb0, 0x87, 0x50, 0x8a, 0x75, 0x95, 0xa3, 0xbb, 0xe4, 0xcd, 0xf9, 0xa4, 0xb8, 0xbd, 0x39, 0x78,
-	0xca, 0x40, 0x9f, 0x42, 0x41, 0x28, 0x2c, 0x57, 0x9f, 0xdf, 0x9b, 0x22, 0x4e, 0x93, 0x1b, 0x0a,
-	0x84, 0x63, 0x34, 0xfa, 0x01, 0xe4, 0x85, 0xd4, 0xa2, 0x8e, 0xbe, 0xfe, 0xd7, 0xe7, 0xf3, 0xfa,
-	0x12, 0x83, 0x35, 0x56, 0xb0, 0x84, 0xe2, 0xa2, 0xb1, 0x34, 0x5b, 0xc0, 0xea, 0x49, 0x0c, 0xd6,
-	0x58, 0x11, 0xa4, 0x94, 0x5d, 0xd4, 0xd1, 0x0a, 0x6d, 0x41, 0x90, 0x1d, 0x05, 0xc2, 0x31, 0x1a,
-	0x3d, 0x82, 0x0d, 0x29, 0x9f, 0xa8, 0x13, 0xcb, 0x2e, 0xa5, 0xd7, 0x3e, 0x58, 0x90, 0x56, 0x85,
-	0xd5, 0xca, 0x6b, 0x3d, 0x4a, 0x2f, 0x51, 0x07, 0x2a, 0x29, 0x19, 0xe5, 0x68, 0x01, 0xb7, 0xb3,
-	0x20, 0x5d, 0x29, 0x24, 0x9e, 0xe1, 0x2d, 0xd7, 0x5f, 0xbf, 0xcb, 0x68, 0xfd, 0x65, 0x41, 0x31,
-	0x16, 0x2f, 0xfa, 0xaa, 0x26, 0x6b, 0xd4, 0x01, 0xa4, 0xc7, 0x5a, 0x64, 0x9f, 0xd1, 0x11, 0x59,
-	0xfd, 0xd6, 0x56, 0x14, 0xef, 0x44, 0xd2, 0xd0, 0x17, 0xf0, 0xde, 0xc5, 0x69, 0x9d, 0x76, 0xb8,
-	0x8a, 0x70, 0xa9, 0xcd, 0x0e, 0x6d, 0xed, 0xf8, 0xfb, 0xb0, 0xe5, 0x30, 0x7b, 0x3c, 0xa2, 0x3e,
-	0x97, 0xad, 0x77, 0x30, 0x0e, 0xd5, 0xf4, 0x2b, 0xe1, 0xea, 0xcc, 0xc6, 0x93, 0xd0, 0x43, 0x1f,
-	0x42, 0x9e, 0x91, 0x31, 0x3f, 0xdb, 0xd7, 0x25, 0x51, 0x51, 0xdd, 0xb7, 0xdf, 0x10, 0x36, 0xac,
-	0xf7, 0xac, 0xff, 0x64, 0xa1, 0x94, 0x14, 0x30, 0x6a, 0xa5, 0x74, 0x92, 0x21, 0x27, 0xfa, 0xc7,
-	0x97, 0xd4, 0xfc, 0x9b, 0x4a, 0xc9, 0xfa, 0x79, 0x06, 0xa0, 0xc5, 0xfc, 0x88, 0x87, 0xc4, 0xf5,
-	0xc5, 0xcd, 0xce, 0xa6, 0xc4, 0xdf, 0xde, 0x65, 0xfe, 0xa6, 0xcc, 0xba, 0x14, 0x81, 0x92, 0x2c,
-	0xba, 0x48, 0x48, 0x49, 0x92, 0x3d, 0xac, 0x57, 0x3b, 0xbf, 0x35, 0x20, 0x2b, 0xc5, 0x61, 0x19,
-	0x0a, 0xdd, 0xa3, 0xa7, 0x8d, 0x5e, 0xf7, 0xa0, 0x7a, 0x05, 0x21, 0xd8, 0xe8, 0x74, 0xdb, 0xbd,
-	0x83, 0x01, 0x6e, 0x7f, 0xfe, 0xa4, 0x8b, 0xdb, 0x07, 0x55, 0x03, 0x5d, 0x83, 0xad, 0x5e, 0xbf,
-	0xd5, 0x78, 0xdc, 0xed, 0x1f, 0x4d, 0xcd, 0x19, 0x64, 0x42, 0x2d, 0x65, 0x6e, 0xf5, 0x0f, 0x0f,
-	0xdb, 0x47, 0x07, 0xed, 0x83, 0xea, 0xda, 0xd4, 0x49, 0xff, 0x58, 0xec, 0x36, 0x7a, 0xd5, 0x2c,
-	0xba, 0x0a, 0x9b, 0xca, 0xd6, 0xe9, 0xe3, 0x66, 0xf7, 0xe0, 0xa0, 0x7d, 0x54, 0xcd, 0xa1, 0x2d,
-	0x58, 0x7f, 0x72, 0x74, 0xd2, 0x78, 0xdc, 0x3d, 0xe9, 0x74, 0x1b, 0xcd, 0x5e, 0xbb, 0x9a, 0xb7,
-	0x7e, 0x9f, 0xd2, 0x6e, 0x5f, 0x4a, 0xd9, 0xaa, 0xbf, 0x29, 0x4e, 0xeb, 0xbd, 0x15, 0xd3, 0x9a,
-	0x4a, 0x47, 0x24, 0x15, 0x0c, 0x4e, 0x3b, 0x13, 0xed, 0x3c, 0xa9, 0xb4, 0x80, 0xf0, 0x33, 0x33,
-	0xb3, 0xbd, 0xb6, 0x5b, 0xc2, 0x95, 0xd8, 0x78, 0x4c, 0xf8, 0x99, 0x00, 0x39, 0xd4, 0xe3, 0x64,
-	0x30, 0x0e, 0x84, 0xef, 0x48, 0xa6, 0xb0, 0x88, 0x2b, 0xd2, 0xf8, 0x44, 0xd9, 0xac, 0xe7, 0x50,
-	0xbd, 0xf8, 0xaa, 0x39, 0x62, 0xe9, 0xc7, 0x69, 0xb1, 0x54, 0xde, 0xbf, 0xbd, 0xfa, 0x61, 0xa6,
-	0x85, 0xd5, 0x3d, 0x28, 0xe8, 0xb6, 0x87, 0x3e, 0x01, 0x44, 0xa4, 0xb4, 0x1c, 0x38, 0x34, 0xb2,
-	0x43, 0x37, 0x48, 0x34, 0x48, 0x09, 0x6f, 0xa9, 0x9d, 0x83, 0xe9, 0x86, 0x75, 0x08, 0x79, 0xd5,
-	0xf8, 0xde, 0xce, 0xbc, 0xf8, 0x02, 0xf2, 0xaa, 0x23, 0x2e, 0x1f, 0xe0, 0xc9, 0x30, 0xcc, 0xac,
-	0x38, 0x0c, 0xad, 0x1f, 0x42, 0x41, 0xf7, 0x4c, 0x74, 0x1b, 0x94, 0x58, 0x49, 0x62, 0xd3, 0x4a,
-	0x5b, 0xff, 0xb2, 0x39, 0x11, 0x7b, 0xb1, 0x9e, 0xf9, 0x0c, 0xd6, 0x67, 0x5a, 0xe5, 0xb7, 0x22,
-	0x3f, 0x80, 0x4a, 0xba, 0x3b, 0x7e, 0x1b, 0xee, 0xce, 0x2f, 0xb3, 0x90, 0x6b, 0x7f, 0xc5, 0x43,
-	0x62, 0xfd, 0xd5, 0x80, 0x5b, 0xf1, 0xf9, 0xb5, 0xc5, 0x04, 0x77, 0xfd, 0xe1, 0x71, 0xc8, 0x9e,
-	0xab, 0x1f, 0x00, 0xf1, 0xbf, 0x34, 0x7a, 0x50, 0xa5, 0x7a, 0x73, 0x90, 0xce, 0x5b, 0x79, 0xff,
-	0xd6, 0xe2, 0x1f, 0x77, 0x71, 0xa7, 0xd8, 0x8c, 0xa9, 0xf1, 0x0d, 0x39, 0x86, 0x6a, 0x10, 0xb2,
-	0x80, 0x45, 0xd4, 0x49, 0xbc, 0xa9, 0x02, 0x5b, 0xf1, 0x57, 0xda, 0x66, 0x4c, 0xd7, 0x06, 0xeb,
-	0xcf, 0x99, 0xe9, 0x57, 0x68, 0x5b, 0x63, 0x48, 0x5c, 0x3f, 0xe2, 0xa9, 0x22, 0x47, 0x9f, 0xcd,
-	0x1e, 0xfa, 0x4a, 0xc1, 0x27, 0x75, 0x31, 0x9c, 0xbd, 0xd6, 0x19, 0x79, 0xad, 0xdb, 0x33, 0xf1,
-	0xca, 0x8c, 0xd6, 0x2f, 0x8d, 0x63, 0xf9, 0x1d, 0x7f, 0x97, 0x37, 0x73, 0xff, 0x27, 0x50, 0x4a,
-	0x0a, 0x04, 0xfd, 0x08, 0xca, 0xd3, 0x4c, 0x50, 0x54, 0x9b, 0x77, 0x16, 0xd6, 0xb5, 0xb9, 0x2f,
-	0xda, 0x35, 0xee, 0x18, 0xcd, 0xe6, 0xab, 0x7f, 0xdd, 0xb8, 0xf2, 0xea, 0xeb, 0x1b, 0xc6, 0xdf,
-	0xbf, 0xbe, 0x61, 0xfc, 0xf1, 0xdf, 0x37, 0x8c, 0x2f, 0xef, 0xac, 0xf4, 0x2f, 0x85, 0x94, 0xc3,
-	0x67, 0x79, 0x69, 0xbe, 0xfb, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x65, 0x75, 0xc0, 0xdf,
-	0x13, 0x00, 0x00,
+	// 1834 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x52, 0xfc, 0x7c, 0x24, 0x25, 0x6a, 0x42, 0xb7, 0xdb, 0x8d, 0x63, 0xc9, 0x4a, 0x82,
+	0x08, 0x2e, 0x42, 0x19, 0x72, 0xdb, 0xd8, 0x0e, 0x1c, 0x94, 0x5f, 0x42, 0xe5, 0x52, 0x1f, 0x19,
+	0xd9, 0x0e, 0x90, 0x0b, 0x31, 0xde, 0x1d, 0x51, 0x6b, 0x2d, 0x77, 0xb6, 0xbb, 0x43, 0x3b, 0xea,
+	0xa9, 0x97, 0xa2, 0x40, 0x6f, 0x01, 0x8a, 0x5e, 0x8a, 0x02, 0xfd, 0x27, 0x7a, 0xe9, 0xb5, 0x2d,
+	0xe0, 0x63, 0x0f, 0x05, 0x7a, 0x73, 0xd1, 0xf4, 0xd0, 0x73, 0xaf, 0x39, 0x14, 0xc5, 0x7c, 0xec,
+	0x72, 0x29, 0x91, 0x14, 0x05, 0x38, 0xb9, 0x10, 0x3b, 0x6f, 0x7e, 0xbf, 0xb7, 0x6f, 0xdf, 0xbe,
+	0x79, 0xef, 0xb7, 0x84, 0xdb, 0x03, 0xb6, 0x15, 0x84, 0x8c, 0x33, 0x9b, 0x79, 0xd1, 0xd6, 0x90,
+	0x70, 0x1a, 0xba, 0xc4, 0x73, 0x7f, 0x4e, 0xd3, 0xd7, 0x0d, 0x89, 0x40, 0xe5, 0x94, 0xc9, 0x5a,
+	0xb7, 0x99, 0x1f, 0x8d, 0x86, 0x34, 0x4c, 0xe8, 0xc9, 0x85, 0x82, 0x5b, 0x37, 0x26, 0x5c, 0x1f,
+	0x7b, 0xec, 0xa5, 0xfc, 0xd1, 0xbb, 0xf5, 0x01, 0x1b, 0x30, 0x79, 0xb9, 0x25, 0xae, 0x94, 0x75,
+	0xe3, 0xcb, 0x3a, 0x14, 0x30, 0xfd, 0xd9, 0x88, 0x46, 0x1c, 0x7d, 0x08, 0xd9, 0x28, 0xa0, 0xb6,
+	0x69, 0xac, 0x1b, 0x9b, 0xe5, 0xed, 0xef, 0x35, 0xd2, 0x01, 0x69, 0x4c, 0xe3, 0x28, 0xa0, 0x36,
+	0x96, 0x30, 0x74, 0x1f, 0x8a, 0x2f, 0x88, 0xe7, 0x3a, 0x84, 0x53, 0x33, 0x23, 0x29, 0xef, 0x4c,
+	0xa5, 0x3c, 0xd5, 0x20, 0x9c, 0xc0, 0xd1, 0x1d, 0xc8, 0x91, 0x20, 0xf0, 0xce, 0xcc, 0x25, 0xc9,
+	0xb3, 0xa6, 0xf2, 0x9a, 0x02, 0x81, 0x15, 0x50, 0xc4, 0xc6, 0x02, 0xea, 0x9b, 0xd9, 0x39, 0xb1,
+	0x1d, 0x04, 0xd4, 0xc7, 0x12, 0x26, 0xe0, 0x1e, 0x23, 0x8e, 0x99, 0x9b, 0x03, 0xef, 0x31, 0xe2,
+	0x60, 0x09, 0x13, 0xf1, 0x1c, 0x7b, 0xa3, 0xe8, 0xc4, 0xcc, 0xcf, 0x89, 0x67, 0x47, 0x20, 0xb0,
+	0x02, 0x0a, 0x46, 0xc4, 0x59, 0x48, 0xcd, 0xc2, 0x1c, 0xc6, 0x91, 0x40, 0x60, 0x05, 0x44, 0x6d,
+	0xa8, 0x44, 0x9c, 0x84, 0xbc, 0x6f, 0xb3, 0xe1, 0xd0, 0xe5, 0x66, 0x51, 0x12, 0xd7, 0x67, 0x10,
+	0x49, 0xc8, 0xdb, 0x12, 0x87, 0xcb, 0xd1, 0x78, 0x81, 0x5a, 0x50, 0x26, 0xf6, 0xa9, 0xcf, 0x5e,
+	0x7a, 0xd4, 0x19, 0x50, 0xb3, 0x34, 0xc7, 0x47, 0x73, 0x8c, 0xc3, 0x69, 0x92, 0x08, 0x84, 0x9f,
+	0x05, 0xb4, 0x3f, 0x24, 0x41, 0xe0, 0xfa, 0x03, 0x13, 0xe6, 0x38, 0x79, 0x7c, 0x16, 0xd0, 0x3d,
+	0x85, 0xc3, 0x65, 0x3e, 0x5e, 0xa0, 0xb7, 0xa1, 0xe8, 0xfa, 0x9c, 0x86, 0x3e, 0xf1, 0x4c, 0x67,
+	0xdd, 0xd8, 0xac, 0xe0, 0xd2, 0x7b, 0xb1, 0xc1, 0xfa, 0xd2, 0x80, 0xac, 0x28, 0x14, 0xb4, 0x0f,
+	0xcb, 0x36, 0xf3, 0x7d, 0x6a, 0x73, 0x16, 0xf6, 0x05, 0x5d, 0xd6, 0xd6, 0xf2, 0xf6, 0x07, 0x0d,
+	0x59, 0x98, 0x7b, 0xc9, 0x1d, 0x09, 0x77, 0x99, 0x2f, 0x28, 0x8d, 0x76, 0x8c, 0x17, 0xb7, 0xc6,
+	0x55, 0x3b, 0xbd, 0x44, 0xf7, 0xa1, 0x6c, 0x33, 0xff, 0xd8, 0x1d, 0xf4, 0x9f, 0x47, 0xcc, 0x97,
+	0x55, 0x57, 0x6a, 0xdd, 0xf8, 0xfa, 0xf5, 0x9a, 0x49, 0x7d, 0x9b, 0x39, 0xae, 0x3f, 0xd8, 0x12,
+	0x1b, 0x0d, 0x4c, 0x5e, 0xee, 0xd1, 0x28, 0x22, 0x03, 0x8a, 0xf3, 0x8a, 0x60, 0xfd, 0x3e, 0x0f,
+	0xc5, 0xb8, 0x12, 0xd1, 0xa7, 0x90, 0xf5, 0xc9, 0x50, 0x45, 0x53, 0x6a, 0x3d, 0xfc, 0xfa, 0xf5,
+	0xda, 0xfd, 0x81, 0xcb, 0x4f, 0x46, 0xcf, 0x1a, 0x36, 0x1b, 0x6e, 0xd1, 0x88, 0x8f, 0x48, 0x78,
+	0xa6, 0x4e, 0xd0, 0x85, 0x33, 0x75, 0x3e, 0x6a, 0x2c, 0x5d, 0x4d, 0x79, 0xd4, 0xcc, 0x9b, 0x7c,
+	0xd4, 0xa5, 0xc5, 0x1f, 0x15, 0x35, 0xa1, 0xf8, 0xcc, 0xf5, 0x05, 0x24, 0x32, 0xb3, 0xeb, 0x4b,
+	0x9b, 0xe5, 0xed, 0xf7, 0xe7, 0x1e, 0xcc, 0x46, 0x4b, 0xa1, 0x71, 0x42, 0x43, 0x3d, 0xa8, 0x7b,
+	0x24, 0xe2, 0xfd, 0xe1, 0x64, 0xd8, 0xc9, 0x79, 0x9a, 0xf5, 0x4c, 0xf8, 0x2d, 0x41, 0x3b, 0xb7,
+	0x81, 0x6e, 0x41, 0x45, 0x7a, 0x7b, 0x41, 0xc3, 0x48, 0x78, 0x11, 0xa7, 0xac, 0x84, 0xcb, 0xc2,
+	0xf6, 0x54, 0x99, 0xac, 0xff, 0x65, 0xa0, 0xa0, 0xc3, 0x40, 0x8f, 0xa0, 0x1e, 0xd2, 0x88, 0x8d,
+	0x42, 0x9b, 0xf6, 0xd3, 0x39, 0x30, 0x16, 0xc8, 0xc1, 0x72, 0xcc, 0x6c, 0xab, 0x5c, 0x3c, 0x00,
+	0xb0, 0x99, 0xe7, 0x51, 0x5b, 0x86, 0xaf, 0xda, 0x54, 0x5d, 0x85, 0xdf, 0x4e, 0xec, 0x22, 0xf2,
+	0x56, 0xf6, 0xd5, 0xeb, 0xb5, 0x6b, 0x38, 0x85, 0x46, 0xbf, 0x32, 0xe0, 0xfa, 0xb1, 0x4b, 0x3d,
+	0x27, 0x1d, 0x85, 0x38, 0x36, 0xe6, 0x92, 0xcc, 0xea, 0xc3, 0x85, 0xb2, 0xda, 0xd8, 0x11, 0x2e,
+	0x54, 0x38, 0x8f, 0x22, 0xe6, 0xef, 0x91, 0xa0, 0xeb, 0xf3, 0xf0, 0xac, 0x75, 0xe3, 0xd7, 0xff,
+	0x9c, 0xf3, 0x20, 0xe5, 0xe3, 0x31, 0x0d, 0x59, 0x50, 0x7c, 0x46, 0xec, 0xd3, 0x63, 0xd7, 0xf3,
+	0x64, 0x07, 0xac, 0xe2, 0x64, 0x6d, 0x75, 0xe1, 0xbb, 0x33, 0xee, 0x80, 0x6a, 0xb0, 0x74, 0x4a,
+	0xcf, 0x54, 0xde, 0xb0, 0xb8, 0x44, 0x75, 0xc8, 0xbd, 0x20, 0xde, 0x48, 0x15, 0x67, 0x09, 0xab,
+	0xc5, 0x83, 0xcc, 0x3d, 0xc3, 0xfa, 0x87, 0x01, 0x39, 0xd9, 0x71, 0x51, 0x1b, 0x56, 0xce, 0xbf,
+	0x76, 0xe3, 0xb2, 0xd7, 0x7e, 0x9e, 0x81, 0x4c, 0x28, 0xc4, 0x6f, 0x5b, 0xdd, 0x2a, 0x5e, 0xce,
+	0x2c, 0xad, 0xec, 0x1b, 0x29, 0xad, 0xdc, 0xc5, 0xd2, 0xfa, 0xab, 0x01, 0x59, 0x31, 0x1a, 0xbe,
+	0xe9, 0x07, 0x7b, 0x1f, 0x72, 0x21, 0xf1, 0x07, 0x54, 0x0f, 0xb5, 0x15, 0xe5, 0x14, 0x0b, 0x93,
+	0x74, 0xa5, 0x76, 0xd1, 0x47, 0x00, 0x11, 0x27, 0x9c, 0xaa, 0x9a, 0xce, 0x2e, 0x50, 0xd3, 0x39,
+	0x89, 0xb7, 0x38, 0x64, 0xc5, 0xc8, 0x12, 0x11, 0xe8, 0x73, 0x2a, 0xc3, 0xaf, 0xe2, 0x78, 0x89,
+	0xee, 0x42, 0xf1, 0x94, 0x9e, 0x2d, 0xde, 0x1b, 0x65, 0x49, 0xbc, 0x03, 0x20, 0x48, 0x01, 0xb1,
+	0x4f, 0xa9, 0x23, 0x63, 0xaf, 0xe0, 0xd2, 0x29, 0x3d, 0x3b, 0x94, 0x06, 0xab, 0x00, 0x39, 0x39,
+	0xf8, 0xac, 0x3f, 0x65, 0x20, 0x27, 0x07, 0xda, 0xb7, 0x1b, 0x80, 0x68, 0x84, 0xb2, 0x4a, 0xa3,
+	0xc5, 0x13, 0x96, 0x57, 0x04, 0xf4, 0x2e, 0x54, 0x35, 0x55, 0x3b, 0xcf, 0x49, 0xe7, 0x15, 0x65,
+	0xd4, 0xfe, 0xef, 0x42, 0xd1, 0x61, 0xb6, 0x72, 0x9e, 0x5f, 0x24, 0x66, 0x87, 0xd9, 0xe8, 0x3b,
+	0x90, 0xa7, 0x5f, 0xb8, 0x11, 0x8f, 0xe4, 0xfc, 0x2f, 0x62, 0xbd, 0x12, 0x76, 0x87, 0x7a, 0x94,
+	0x53, 0x39, 0xde, 0x8b, 0x58, 0xaf, 0x2c, 0x0c, 0xe5, 0xd4, 0x4c, 0x47, 0x6d, 0x40, 0xe1, 0xc8,
+	0xe7, 0xee, 0x90, 0xf6, 0xed, 0x13, 0x6a, 0x9f, 0x06, 0xcc, 0xf5, 0xb9, 0x2e, 0xc6, 0x7a, 0x23,
+	0x16, 0x7a, 0x8d, 0x76, 0xb2, 0x87, 0x57, 0x35, 0x7e, 0x6c, 0xb2, 0xaa, 0x50, 0x4e, 0xcd, 0x78,
+	0xeb, 0x27, 0x50, 0x4e, 0x4d, 0xeb, 0x09, 0x75, 0x66, 0x5c, 0x49, 0x9d, 0x6d, 0xfc, 0x7d, 0x15,
+	0x8a, 0x98, 0x46, 0x01, 0xf3, 0x23, 0x8a, 0x1a, 0x13, 0xa2, 0xf0, 0xbc, 0xce, 0x51, 0xa0, 0xb4,
+	0x2a, 0x7c, 0x08, 0xa5, 0xd8, 0x91, 0xa3, 0xfb, 0xed, 0xda, 0x74, 0x52, 0x7c, 0x67, 0x07, 0x8f,
+	0x19, 0xe8, 0x23, 0x28, 0x08, 0xc1, 0xe7, 0xea, 0x4a, 0xb8, 0x18, 0xb5, 0x26, 0x37, 0x15, 0x08,
+	0xc7, 0x68, 0xf4, 0x03, 0xc8, 0xb3, 0x80, 0xfa, 0xd4, 0xd1, 0x8d, 0xe4, 0xc6, 0x74, 0xde, 0x81,
+	0xc4, 0x60, 0x8d, 0x15, 0x2c, 0x21, 0x00, 0x69, 0xac, 0x14, 0x67, 0xb0, 0x7a, 0x12, 0x83, 0x35,
+	0x56, 0x04, 0x29, 0x55, 0x20, 0x75, 0xcc, 0xfc, 0xbc, 0x20, 0x77, 0x14, 0x08, 0xc7, 0x68, 0xf4,
+	0x08, 0x96, 0xa5, 0x9a, 0xa3, 0x4e, 0xac, 0x02, 0x95, 0x7c, 0x7c, 0x77, 0x46, 0x5a, 0x15, 0x56,
+	0x0b, 0xc1, 0x6a, 0x94, 0x5e, 0xa2, 0x1d, 0xa8, 0xa4, 0x54, 0x9d, 0xa3, 0xf5, 0xe4, 0xc6, 0x8c,
+	0x74, 0xa5, 0x90, 0x78, 0x82, 0x87, 0x9a, 0x50, 0x4e, 0xe4, 0x20, 0x75, 0x66, 0x48, 0x4a, 0xed,
+	0x26, 0x2e, 0x30, 0xea, 0x60, 0xe0, 0xc9, 0xf5, 0x7c, 0x31, 0xf8, 0xdb, 0x8c, 0x16, 0x83, 0x16,
+	0x14, 0x63, 0x25, 0xa5, 0xfb, 0x46, 0xb2, 0x46, 0x3b, 0x80, 0xf4, 0x8c, 0x8d, 0xec, 0x13, 0x3a,
+	0x24, 0x8b, 0xb7, 0x90, 0x8a, 0xe2, 0x1d, 0x49, 0x1a, 0xfa, 0x0c, 0xde, 0x3e, 0x2f, 0x1d, 0xd2,
+	0x0e, 0x17, 0x51, 0x51, 0xf5, 0x49, 0x05, 0xa1, 0x1d, 0x7f, 0x1f, 0x56, 0x1d, 0x66, 0x8f, 0x86,
+	0xd4, 0xe7, 0x72, 0x0e, 0xf4, 0x47, 0xa1, 0x1a, 0xc5, 0x25, 0x5c, 0x9b, 0xd8, 0x78, 0x12, 0x7a,
+	0xe8, 0x3d, 0xc8, 0x33, 0x32, 0xe2, 0x27, 0xdb, 0xba, 0xaa, 0x2a, 0x6a, 0x14, 0x1c, 0x34, 0x85,
+	0x0d, 0xeb, 0x3d, 0xeb, 0xbf, 0x59, 0x28, 0x25, 0x67, 0x00, 0xb5, 0x53, 0xa2, 0xcd, 0x90, 0xf2,
+	0xe2, 0x83, 0x4b, 0x8e, 0xcd, 0x45, 0xd9, 0x66, 0xfd, 0x22, 0x03, 0xd0, 0x66, 0x7e, 0xc4, 0x43,
+	0xe2, 0xfa, 0xa2, 0xcd, 0x64, 0x53, 0x4a, 0x74, 0xeb, 0x32, 0x7f, 0x63, 0xa6, 0x7c, 0xd1, 0x58,
+	0x92, 0x45, 0x4b, 0x0b, 0x29, 0x49, 0xb2, 0x87, 0xf5, 0x6a, 0xe3, 0x37, 0x06, 0x64, 0x05, 0x0c,
+	0x95, 0xa1, 0xb0, 0xbb, 0xff, 0xb4, 0xd9, 0xdb, 0xed, 0xd4, 0xae, 0x21, 0x04, 0xcb, 0x3b, 0xbb,
+	0xdd, 0x5e, 0xa7, 0x8f, 0xbb, 0x9f, 0x3e, 0xd9, 0xc5, 0xdd, 0x4e, 0xcd, 0x40, 0xd7, 0x61, 0xb5,
+	0x77, 0xd0, 0x6e, 0x3e, 0xde, 0x3d, 0xd8, 0x1f, 0x9b, 0x33, 0xc8, 0x84, 0x7a, 0xca, 0xdc, 0x3e,
+	0xd8, 0xdb, 0xeb, 0xee, 0x77, 0xba, 0x9d, 0xda, 0xd2, 0xd8, 0xc9, 0xc1, 0xa1, 0xd8, 0x6d, 0xf6,
+	0x6a, 0x59, 0xf4, 0x16, 0xac, 0x28, 0xdb, 0xce, 0x01, 0x6e, 0xed, 0x76, 0x3a, 0xdd, 0xfd, 0x5a,
+	0x0e, 0xad, 0x42, 0xf5, 0xc9, 0xfe, 0x51, 0xf3, 0xf1, 0xee, 0xd1, 0xce, 0x6e, 0xb3, 0xd5, 0xeb,
+	0xd6, 0xf2, 0xd6, 0xef, 0x52, 0x42, 0xf2, 0x73, 0xa9, 0xa1, 0xf5, 0x33, 0xc5, 0x69, 0xbd, 0xb7,
+	0x60, 0x5a, 0x53, 0xe9, 0x88, 0xa4, 0x9c, 0xc2, 0x69, 0x67, 0x62, 0xb6, 0x24, 0x95, 0x16, 0x10,
+	0x7e, 0x62, 0x66, 0xd6, 0x97, 0x36, 0x4b, 0xb8, 0x12, 0x1b, 0x0f, 0x09, 0x3f, 0x11, 0x20, 0x87,
+	0x7a, 0x9c, 0xf4, 0x47, 0x81, 0xf0, 0x1d, 0xc9, 0x14, 0x16, 0x71, 0x45, 0x1a, 0x9f, 0x28, 0x9b,
+	0xf5, 0x1c, 0x6a, 0xe7, 0x6f, 0x35, 0x45, 0xb9, 0xfd, 0x38, 0xad, 0xdc, 0xca, 0xdb, 0xb7, 0x17,
+	0x7f, 0x99, 0x69, 0x95, 0x77, 0x0f, 0x0a, 0xba, 0x73, 0xa2, 0x0f, 0x01, 0x11, 0xa9, 0x73, 0xfb,
+	0x0e, 0x8d, 0xec, 0xd0, 0x0d, 0x12, 0x41, 0x54, 0xc2, 0xab, 0x6a, 0xa7, 0x33, 0xde, 0xb0, 0xf6,
+	0x20, 0xaf, 0x7a, 0xe7, 0x9b, 0x19, 0x5e, 0x9f, 0x41, 0x5e, 0x35, 0xd5, 0xf9, 0x6a, 0x22, 0x99,
+	0xcc, 0x99, 0x05, 0x27, 0xb3, 0xf5, 0x43, 0x28, 0xe8, 0xb6, 0x8b, 0x6e, 0x83, 0x52, 0x4e, 0x49,
+	0x6c, 0x5a, 0xf6, 0xeb, 0xcf, 0xac, 0x23, 0xb1, 0x17, 0x8b, 0xab, 0x8f, 0xa1, 0x3a, 0xd1, 0x6d,
+	0xaf, 0x44, 0x7e, 0x00, 0x95, 0x74, 0x83, 0xbd, 0x12, 0xf7, 0x3f, 0x19, 0x80, 0x71, 0x5b, 0x45,
+	0x9d, 0x0b, 0x6d, 0x60, 0xf3, 0xb2, 0x56, 0x3c, 0xa5, 0x0f, 0xf4, 0xa0, 0x10, 0xeb, 0x80, 0x35,
+	0x71, 0x06, 0xbc, 0xd1, 0xd0, 0x1f, 0x7f, 0x7f, 0x97, 0xe4, 0x57, 0xce, 0x68, 0xe8, 0xcb, 0xe3,
+	0xbb, 0x2e, 0x00, 0xbe, 0x16, 0xb1, 0x91, 0x2e, 0xe3, 0xb4, 0xc9, 0xfa, 0x8b, 0x31, 0x3e, 0x52,
+	0x18, 0x8a, 0xfa, 0x7f, 0x83, 0x38, 0xbe, 0x1f, 0x2d, 0x1a, 0x5f, 0x43, 0x87, 0xa4, 0x4f, 0x53,
+	0xe2, 0xc7, 0xa2, 0x50, 0x9d, 0xd8, 0x9a, 0x52, 0xfd, 0x9f, 0x4c, 0x56, 0xff, 0xe5, 0x39, 0xd1,
+	0x0e, 0x53, 0xb5, 0xbf, 0xf1, 0xcb, 0x2c, 0xe4, 0xba, 0x5f, 0xf0, 0x90, 0x58, 0x7f, 0x36, 0xe0,
+	0x56, 0x7c, 0x52, 0xba, 0x42, 0xb8, 0xb9, 0xfe, 0xe0, 0x30, 0x64, 0xcf, 0xd5, 0x77, 0x5f, 0xfc,
+	0x77, 0x58, 0x0f, 0x6a, 0x54, 0x6f, 0xf6, 0xd3, 0x15, 0x5a, 0xde, 0xbe, 0x35, 0xfb, 0x9b, 0x3e,
+	0x7e, 0x17, 0x2b, 0x31, 0x35, 0x4e, 0xdc, 0x21, 0xd4, 0x82, 0x90, 0x05, 0x2c, 0xa2, 0x4e, 0xe2,
+	0x4d, 0x3d, 0xcc, 0x82, 0x1f, 0xe7, 0x2b, 0x31, 0x5d, 0x1b, 0xac, 0x3f, 0x66, 0xc6, 0x4f, 0xa1,
+	0x6d, 0xcd, 0x01, 0x71, 0xfd, 0x88, 0xa7, 0xda, 0x09, 0xfa, 0x18, 0x0a, 0x57, 0x0e, 0x3e, 0x39,
+	0x81, 0x83, 0xc9, 0x06, 0x9a, 0x91, 0x2f, 0xbc, 0x3b, 0x11, 0xaf, 0xcc, 0x68, 0xe3, 0xd2, 0x38,
+	0xe6, 0x77, 0xd3, 0x6f, 0xb3, 0x07, 0x6e, 0xff, 0x14, 0x4a, 0xc9, 0x51, 0x44, 0x9f, 0x40, 0x79,
+	0x9c, 0x09, 0x8a, 0xea, 0xd3, 0xde, 0x85, 0x75, 0x7d, 0xea, 0x8d, 0x36, 0x8d, 0x3b, 0x46, 0xab,
+	0xf5, 0xea, 0x5f, 0x37, 0xaf, 0xbd, 0xfa, 0xea, 0xa6, 0xf1, 0xb7, 0xaf, 0x6e, 0x1a, 0x7f, 0xf8,
+	0xf7, 0x4d, 0xe3, 0xf3, 0x3b, 0x0b, 0xfd, 0x93, 0x94, 0x72, 0xf8, 0x2c, 0x2f, 0xcd, 0x77, 0xff,
+	0x3f, 0x00, 0xbc, 0xbe, 0x6d, 0x21, 0x1b, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1588,6 +1778,18 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.TypeMapping != nil {
+		{
+			size, err := m.TypeMapping.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaterialize(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Acknowledge != nil {
 		{
 			size, err := m.Acknowledge.MarshalToSizedBuffer(dAtA[:i])
@@ -2241,6 +2443,45 @@ func (m *Request_Acknowledge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Request_TypeMapping) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Request_TypeMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request_TypeMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Validate != nil {
+		{
+			size, err := m.Validate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaterialize(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Response) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2274,6 +2515,18 @@ func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa2
 	}
+	if m.TypeMapped != nil {
+		{
+			size, err := m.TypeMapped.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMaterialize(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Acknowledged != nil {
 		{
 			size, err := m.Acknowledged.MarshalToSizedBuffer(dAtA[:i])
@@ -2819,6 +3072,143 @@ func (m *Response_Acknowledged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Response_TypeMapped) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Response_TypeMapped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_TypeMapped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Bindings) > 0 {
+		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMaterialize(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Response_TypeMapped_Mapping) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Response_TypeMapped_Mapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_TypeMapped_Mapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Conversions) > 0 {
+		for iNdEx := len(m.Conversions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Conversions[iNdEx])
+			copy(dAtA[i:], m.Conversions[iNdEx])
+			i = encodeVarintMaterialize(dAtA, i, uint64(len(m.Conversions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ColumnType) > 0 {
+		i -= len(m.ColumnType)
+		copy(dAtA[i:], m.ColumnType)
+		i = encodeVarintMaterialize(dAtA, i, uint64(len(m.ColumnType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Response_TypeMapped_Binding) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Response_TypeMapped_Binding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_TypeMapped_Binding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Mappings) > 0 {
+		for k := range m.Mappings {
+			v := m.Mappings[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintMaterialize(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMaterialize(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMaterialize(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Extra) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -3015,7 +3405,11 @@ func (m *Request) ProtoSize() (n int) {
 		l = m.Acknowledge.ProtoSize()
 		n += 1 + l + sovMaterialize(uint64(l))
 	}
-	l = len(m.Internal)
+	if m.TypeMapping != nil {
+		l = m.TypeMapping.ProtoSize()
+		n += 1 + l + sovMaterialize(uint64(l))
+	}
+	l = len(m.Internal)
 	if l > 0 {
 		n += 2 + l + sovMaterialize(uint64(l))
 	}
@@ -3270,6 +3664,22 @@ func (m *Request_Acknowledge) ProtoSize() (n int) {
 	return n
 }
 
+func (m *Request_TypeMapping) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Validate != nil {
+		l = m.Validate.ProtoSize()
+		n += 1 + l + sovMaterialize(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Response) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3308,6 +3718,10 @@ func (m *Response) ProtoSize() (n int) {
 		l = m.Acknowledged.ProtoSize()
 		n += 1 + l + sovMaterialize(uint64(l))
 	}
+	if m.TypeMapped != nil {
+		l = m.TypeMapped.ProtoSize()
+		n += 1 + l + sovMaterialize(uint64(l))
+	}
 	l = len(m.Internal)
 	if l > 0 {
 		n += 2 + l + sovMaterialize(uint64(l))
@@ -3519,6 +3933,71 @@ func (m *Response_Acknowledged) ProtoSize() (n int) {
 	return n
 }
 
+func (m *Response_TypeMapped) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.ProtoSize()
+			n += 1 + l + sovMaterialize(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Response_TypeMapped_Mapping) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ColumnType)
+	if l > 0 {
+		n += 1 + l + sovMaterialize(uint64(l))
+	}
+	if len(m.Conversions) > 0 {
+		for _, s := range m.Conversions {
+			l = len(s)
+			n += 1 + l + sovMaterialize(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Response_TypeMapped_Binding) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mappings) > 0 {
+		for k, v := range m.Mappings {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.ProtoSize()
+				l += 1 + sovMaterialize(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovMaterialize(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMaterialize(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Extra) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3939,6 +4418,42 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeMapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaterialize
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TypeMapping == nil {
+				m.TypeMapping = &Request_TypeMapping{}
+			}
+			if err := m.TypeMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Internal", wireType)
@@ -5568,6 +6083,93 @@ func (m *Request_Acknowledge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Request_TypeMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaterialize
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TypeMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TypeMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaterialize
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Validate == nil {
+				m.Validate = &Request_Validate{}
+			}
+			if err := m.Validate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaterialize(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5885,6 +6487,42 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeMapped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaterialize
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TypeMapped == nil {
+				m.TypeMapped = &Response_TypeMapped{}
+			}
+			if err := m.TypeMapped.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Internal", wireType)
@@ -7095,6 +7733,386 @@ func (m *Response_Acknowledged) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Response_TypeMapped) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaterialize
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TypeMapped: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TypeMapped: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaterialize
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bindings = append(m.Bindings, &Response_TypeMapped_Binding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaterialize(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response_TypeMapped_Mapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaterialize
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaterialize
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaterialize
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conversions = append(m.Conversions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaterialize(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response_TypeMapped_Binding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaterialize
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Binding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Binding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaterialize
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mappings == nil {
+				m.Mappings = make(map[string]*Response_TypeMapped_Mapping)
+			}
+			var mapkey string
+			var mapvalue *Response_TypeMapped_Mapping
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMaterialize
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaterialize
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMaterialize
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMaterialize
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMaterialize
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthMaterialize
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthMaterialize
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Response_TypeMapped_Mapping{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMaterialize(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthMaterialize
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mappings[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaterialize(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaterialize
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Extra) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
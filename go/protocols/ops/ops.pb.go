@@ -319,8 +319,11 @@ type Stats struct {
 	Capture map[string]*Stats_Binding `protobuf:"bytes,6,rep,name=capture,proto3" json:"capture,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Derive  *Stats_Derive             `protobuf:"bytes,7,opt,name=derive,proto3" json:"derive,omitempty"`
 	// Materialization metrics.
-	Materialize          map[string]*Stats_Binding `protobuf:"bytes,8,rep,name=materialize,proto3" json:"materialize,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Interval             *Stats_Interval           `protobuf:"bytes,9,opt,name=interval,proto3" json:"interval,omitempty"`
+	Materialize map[string]*Stats_Binding `protobuf:"bytes,8,rep,name=materialize,proto3" json:"materialize,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Interval    *Stats_Interval           `protobuf:"bytes,9,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Per-binding metrics of a capture or materialization, keyed on the
+	// state key of each binding.
+	Bindings             map[string]*Stats_Binding `protobuf:"bytes,10,rep,name=bindings,proto3" json:"bindings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -497,7 +500,12 @@ type Stats_Derive_Transform struct {
 	// The name of the collection that this transform sourced from.
 	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
 	// Input documents that were read by this transform.
-	Input                *Stats_DocsAndBytes `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	Input *Stats_DocsAndBytes `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	// Input documents which this transform failed to process, and skipped.
+	Skipped *Stats_DocsAndBytes `protobuf:"bytes,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// Input documents which this transform failed to process,
+	// and published to its dead-letter collection.
+	DeadLettered         *Stats_DocsAndBytes `protobuf:"bytes,4,opt,name=dead_lettered,json=deadLettered,proto3" json:"dead_lettered,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -549,7 +557,16 @@ type Stats_Interval struct {
 	// At present, capture and materialization tasks always use a fixed value of 1.0,
 	// while derivation tasks use a fixed value of 0.0.
 	// The choice of `usage_rate` MAY have more critera in the future.
-	UsageRate            float32  `protobuf:"fixed32,2,opt,name=usage_rate,json=usageRate,proto3" json:"usage_rate,omitempty"`
+	UsageRate float32 `protobuf:"fixed32,2,opt,name=usage_rate,json=usageRate,proto3" json:"usage_rate,omitempty"`
+	// Bytes of accounted memory held by the task shard at the time of the
+	// interval. Accounted memory includes combine buffers, read-ahead queues,
+	// and connector network proxy buffers.
+	MemoryUsageBytes uint64 `protobuf:"varint,3,opt,name=memory_usage_bytes,json=memoryUsageBytes,proto3" json:"memory_usage_bytes,omitempty"`
+	// Peak bytes of accounted memory held by the task shard over the interval.
+	MemoryPeakBytes uint64 `protobuf:"varint,4,opt,name=memory_peak_bytes,json=memoryPeakBytes,proto3" json:"memory_peak_bytes,omitempty"`
+	// Limit of the task shard's accounted memory, in bytes.
+	// Zero if the task shard's memory is unlimited.
+	MemoryLimitBytes     uint64   `protobuf:"varint,5,opt,name=memory_limit_bytes,json=memoryLimitBytes,proto3" json:"memory_limit_bytes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -599,6 +616,7 @@ func init() {
 	proto.RegisterType((*Stats)(nil), "ops.Stats")
 	proto.RegisterMapType((map[string]*Stats_Binding)(nil), "ops.Stats.CaptureEntry")
 	proto.RegisterMapType((map[string]*Stats_Binding)(nil), "ops.Stats.MaterializeEntry")
+	proto.RegisterMapType((map[string]*Stats_Binding)(nil), "ops.Stats.BindingsEntry")
 	proto.RegisterType((*Stats_DocsAndBytes)(nil), "ops.Stats.DocsAndBytes")
 	proto.RegisterType((*Stats_Binding)(nil), "ops.Stats.Binding")
 	proto.RegisterType((*Stats_Derive)(nil), "ops.Stats.Derive")
@@ -610,77 +628,84 @@ func init() {
 func init() { proto.RegisterFile("go/protocols/ops/ops.proto", fileDescriptor_37de94a5cb9d0036) }
 
 var fileDescriptor_37de94a5cb9d0036 = []byte{
-	// 1112 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x93, 0x38, 0x8d, 0x9f, 0xb7, 0xad, 0x99, 0x5d, 0x81, 0x95, 0x5d, 0xd2, 0x6e, 0x00,
-	0x29, 0xbb, 0x0b, 0x89, 0x36, 0x08, 0x09, 0xad, 0x84, 0xc4, 0xa6, 0x0b, 0x12, 0xa8, 0xad, 0x60,
-	0x9a, 0x53, 0x2f, 0xd6, 0xc4, 0x9e, 0xb8, 0x26, 0xce, 0x8c, 0xe5, 0x19, 0xb7, 0x1b, 0x8e, 0x1c,
-	0x39, 0xf1, 0x13, 0x38, 0xf3, 0x4b, 0x7a, 0x42, 0xf0, 0x03, 0x00, 0xb1, 0xfc, 0x11, 0x34, 0x33,
-	0x76, 0xea, 0x6d, 0xbb, 0xac, 0x10, 0x07, 0x0e, 0xad, 0xde, 0x7c, 0xef, 0x9b, 0x99, 0xf7, 0xde,
-	0xf7, 0xde, 0x38, 0xd0, 0x8d, 0xf9, 0x28, 0xcb, 0xb9, 0xe4, 0x21, 0x4f, 0xc5, 0x88, 0x67, 0xfa,
-	0x6f, 0xa8, 0x11, 0xd4, 0xe4, 0x99, 0xe8, 0xde, 0x7b, 0x89, 0x30, 0x4f, 0xf9, 0xb9, 0xfe, 0x67,
-	0x28, 0xdd, 0x3b, 0x31, 0x8f, 0xb9, 0x36, 0x47, 0xca, 0x2a, 0xd1, 0xdd, 0x98, 0xf3, 0x38, 0xa5,
-	0x66, 0xdf, 0xac, 0x98, 0x8f, 0x64, 0xb2, 0xa4, 0x42, 0x92, 0x65, 0x66, 0x08, 0xfd, 0x9f, 0x1a,
-	0xb0, 0x75, 0x7c, 0x4a, 0xf2, 0xe8, 0x80, 0xcc, 0x68, 0x9a, 0xb0, 0x18, 0xdd, 0x01, 0x7b, 0x56,
-	0x24, 0x69, 0xe4, 0x5b, 0x7b, 0xd6, 0xc0, 0xc1, 0x66, 0x81, 0xba, 0xd0, 0x39, 0xe5, 0x42, 0x32,
-	0xb2, 0xa4, 0x7e, 0x43, 0x3b, 0xd6, 0x6b, 0xf4, 0x08, 0x9c, 0x94, 0xc7, 0x41, 0x4a, 0xcf, 0x68,
-	0xea, 0x37, 0xf7, 0xac, 0xc1, 0xf6, 0x78, 0x7b, 0xa8, 0x82, 0x3f, 0xe0, 0xf1, 0xf0, 0x40, 0xa1,
-	0xb8, 0x93, 0xf2, 0x58, 0x5b, 0xe8, 0x11, 0xd8, 0x39, 0x61, 0x31, 0xf5, 0xed, 0x3d, 0x6b, 0xe0,
-	0x8e, 0x77, 0x86, 0x3a, 0x07, 0xac, 0xa0, 0xe3, 0x8c, 0x86, 0x93, 0xd6, 0xc5, 0xef, 0xbb, 0x1b,
-	0xd8, 0x70, 0xd0, 0x7d, 0xb8, 0x25, 0xb2, 0x34, 0x91, 0x81, 0xe0, 0x45, 0x1e, 0x52, 0xbf, 0xad,
-	0x6f, 0x76, 0x35, 0x76, 0xac, 0xa1, 0x4b, 0x8a, 0x24, 0x79, 0x4c, 0xa5, 0xbf, 0x59, 0xa3, 0x4c,
-	0x35, 0x84, 0xee, 0x82, 0x23, 0x89, 0x58, 0x04, 0x3a, 0xf8, 0x8e, 0x09, 0x5e, 0x01, 0x47, 0x2a,
-	0xf8, 0x87, 0xa5, 0x53, 0xae, 0x32, 0xea, 0x3b, 0x3a, 0xf8, 0x2d, 0x1d, 0xfc, 0x94, 0x88, 0xc5,
-	0x74, 0x95, 0x51, 0xc3, 0x55, 0x56, 0xff, 0x3b, 0x0b, 0x3a, 0xba, 0x58, 0x98, 0xce, 0xd1, 0x7d,
-	0x68, 0x2d, 0x12, 0x66, 0xca, 0x74, 0x6d, 0x8f, 0x76, 0x21, 0x04, 0xad, 0x5a, 0xc1, 0xb4, 0xad,
-	0x82, 0x59, 0xd0, 0x55, 0x30, 0xa3, 0x71, 0xc2, 0x74, 0xb1, 0x1c, 0xdc, 0x59, 0xd0, 0xd5, 0x44,
-	0xad, 0x51, 0x1f, 0xb6, 0xf2, 0x20, 0x4c, 0x79, 0xb8, 0x28, 0x09, 0x2d, 0x93, 0x4d, 0xbe, 0xaf,
-	0x30, 0xcd, 0xe9, 0x77, 0xa1, 0x75, 0x48, 0x25, 0x51, 0x87, 0x17, 0x45, 0x52, 0xc9, 0xa4, 0xed,
-	0xfe, 0xcf, 0x4d, 0x68, 0x1e, 0xf0, 0x18, 0xf5, 0xa0, 0xb5, 0xa4, 0x92, 0x68, 0x9f, 0x3b, 0x76,
-	0x74, 0x6c, 0x6a, 0x13, 0xb6, 0x03, 0x85, 0xa3, 0x77, 0xc0, 0x16, 0x2a, 0x0f, 0x1d, 0x99, 0x5b,
-	0x06, 0x5f, 0x65, 0x86, 0x8d, 0x0f, 0x3d, 0x06, 0x67, 0xdd, 0x2d, 0x3a, 0x52, 0x77, 0xdc, 0x1d,
-	0x9a, 0x7e, 0x1a, 0x56, 0xfd, 0x34, 0x9c, 0x56, 0x0c, 0xdc, 0x90, 0x02, 0xbd, 0x0b, 0xb6, 0xe9,
-	0x82, 0xd6, 0x8d, 0x5d, 0x60, 0x9c, 0xc8, 0x87, 0xcd, 0x25, 0x15, 0x82, 0x94, 0x4d, 0xe0, 0xe0,
-	0x6a, 0x89, 0x4e, 0x60, 0x67, 0x9e, 0xd0, 0x34, 0x12, 0xc1, 0x37, 0x82, 0xb3, 0x60, 0x49, 0x32,
-	0xbf, 0xbd, 0xd7, 0x1c, 0xb8, 0xe3, 0xbb, 0xeb, 0x93, 0x3e, 0xd7, 0xfe, 0x2f, 0x05, 0x67, 0x87,
-	0x24, 0xfb, 0x8c, 0xc9, 0x7c, 0x35, 0xb9, 0xf7, 0xfd, 0x1f, 0xbb, 0x3e, 0x65, 0x21, 0x8f, 0x12,
-	0x16, 0x8f, 0xd4, 0xce, 0x21, 0x26, 0xe7, 0x87, 0xe6, 0x4c, 0xdc, 0x36, 0x27, 0xa2, 0x1e, 0xd8,
-	0x22, 0x23, 0x4c, 0xf8, 0x9b, 0xfa, 0xc4, 0x4e, 0x75, 0x22, 0x36, 0x70, 0xf7, 0x53, 0x40, 0xd7,
-	0xcf, 0x46, 0x1e, 0x34, 0x17, 0x74, 0x55, 0x16, 0x59, 0x99, 0x6a, 0x3e, 0xce, 0x48, 0x5a, 0x54,
-	0xaa, 0x9a, 0xc5, 0x93, 0xc6, 0xc7, 0x56, 0xff, 0x6b, 0xb0, 0x4d, 0x8f, 0xdf, 0x86, 0x9d, 0x82,
-	0x45, 0x74, 0x9e, 0x30, 0x1a, 0x99, 0xb1, 0xf0, 0x36, 0x90, 0x03, 0x36, 0xcd, 0x73, 0x9e, 0x7b,
-	0x16, 0xea, 0x40, 0xeb, 0x9c, 0xe4, 0xcc, 0x6b, 0x28, 0x2b, 0x61, 0x73, 0xee, 0x35, 0x95, 0x3b,
-	0xa2, 0xb3, 0x22, 0xf6, 0x5a, 0xca, 0x94, 0x39, 0x09, 0xa9, 0x67, 0xf7, 0x7f, 0x75, 0xc0, 0x3e,
-	0x96, 0x44, 0x8a, 0xff, 0x4d, 0xd2, 0xf7, 0x01, 0xf1, 0x8c, 0xb2, 0x40, 0xd0, 0x90, 0xb3, 0x48,
-	0x04, 0x92, 0x4b, 0x62, 0xf4, 0xb5, 0xb0, 0xa7, 0x3c, 0xc7, 0xc6, 0x31, 0x55, 0xb8, 0x1e, 0xb5,
-	0xe7, 0x2c, 0x08, 0x79, 0xc1, 0xa4, 0x16, 0x77, 0x0b, 0x77, 0xe4, 0x73, 0xb6, 0xaf, 0xd6, 0xe8,
-	0x31, 0x6c, 0x86, 0x24, 0x93, 0x45, 0x4e, 0x4b, 0x55, 0xdf, 0x32, 0x41, 0xaa, 0xfc, 0x86, 0xfb,
-	0xc6, 0xa3, 0xab, 0x8e, 0x2b, 0x1e, 0x7a, 0x00, 0xed, 0x88, 0xe6, 0xc9, 0x19, 0xd5, 0x73, 0xed,
-	0x8e, 0xdf, 0xa8, 0xed, 0x78, 0xa6, 0x1d, 0xb8, 0x24, 0xa0, 0x4f, 0xc0, 0x5d, 0x12, 0x49, 0xf3,
-	0x84, 0xa4, 0xc9, 0xb7, 0x6a, 0xce, 0x2f, 0xfb, 0xc6, 0xf0, 0x0f, 0x2f, 0xbd, 0xe6, 0x96, 0x3a,
-	0x1f, 0x8d, 0xa0, 0x93, 0x30, 0x49, 0xf3, 0x33, 0x92, 0xea, 0x67, 0xc0, 0x1d, 0xdf, 0xae, 0xed,
-	0xfd, 0xa2, 0x74, 0xe1, 0x35, 0xa9, 0x7b, 0x04, 0xb7, 0x9e, 0xf1, 0x50, 0x3c, 0x65, 0xd1, 0x64,
-	0x25, 0xa9, 0x40, 0x6f, 0x03, 0x44, 0x3c, 0xac, 0x0a, 0x64, 0xe9, 0xdc, 0x1d, 0x85, 0x98, 0xca,
-	0xec, 0x82, 0x3b, 0x53, 0xbc, 0xd2, 0xaf, 0x54, 0x6a, 0x61, 0xd0, 0x90, 0x26, 0x74, 0x7f, 0xb0,
-	0x60, 0x73, 0x92, 0x30, 0xd5, 0xc3, 0xe8, 0x11, 0xb4, 0x52, 0x3a, 0x97, 0xa5, 0xd8, 0xf5, 0x32,
-	0xd5, 0xaf, 0xc4, 0x9a, 0x84, 0x3e, 0x00, 0x3b, 0x4f, 0xe2, 0x53, 0x59, 0x2a, 0xff, 0x4a, 0xb6,
-	0x61, 0xa1, 0x07, 0xd0, 0xe4, 0x85, 0x2c, 0xd5, 0x7f, 0x25, 0x59, 0x71, 0x54, 0x8a, 0x75, 0x59,
-	0x6e, 0x18, 0x86, 0x41, 0x7d, 0x18, 0xdc, 0x31, 0xaa, 0x1d, 0x57, 0xe6, 0x52, 0x1b, 0x90, 0xee,
-	0x6f, 0x0d, 0x68, 0x1b, 0xd5, 0xd0, 0x53, 0x00, 0x99, 0x13, 0x26, 0xe6, 0x3c, 0x5f, 0x0a, 0xdf,
-	0xd2, 0x62, 0xdd, 0xbf, 0x26, 0xee, 0x70, 0xba, 0xe6, 0x18, 0xc9, 0x6a, 0x9b, 0xd0, 0x47, 0xe0,
-	0x64, 0xc5, 0x2c, 0x4d, 0xc4, 0x29, 0x8d, 0x5e, 0x97, 0xfb, 0x25, 0xf3, 0xdf, 0xe4, 0x8f, 0xc1,
-	0x59, 0x07, 0x80, 0xde, 0x84, 0x76, 0xf9, 0x15, 0x32, 0xf9, 0x97, 0x2b, 0x55, 0xfe, 0x84, 0x65,
-	0xc5, 0xeb, 0xcb, 0xaf, 0x59, 0xdd, 0x13, 0xd8, 0xb9, 0x92, 0xd4, 0x0d, 0x65, 0x7d, 0xfc, 0x72,
-	0x59, 0xef, 0xfe, 0x43, 0x61, 0xea, 0xf5, 0xc5, 0xe0, 0x5d, 0x6d, 0xf2, 0xff, 0xac, 0xd9, 0x57,
-	0xd0, 0xa9, 0x9a, 0x1f, 0xbd, 0x07, 0xdb, 0x45, 0xa6, 0x1e, 0x90, 0xea, 0x35, 0x28, 0xdb, 0x7c,
-	0xcb, 0xa0, 0xe5, 0x4b, 0xa0, 0x26, 0xa1, 0x50, 0x4f, 0x6f, 0x90, 0x13, 0x69, 0x6e, 0x69, 0x60,
-	0x47, 0x23, 0x98, 0x48, 0xfa, 0xf0, 0x08, 0x3a, 0xd5, 0x77, 0x12, 0x79, 0x70, 0x2b, 0x61, 0x67,
-	0x24, 0x4d, 0x22, 0xfd, 0x01, 0xf6, 0x36, 0x90, 0xbb, 0x7e, 0x24, 0x3c, 0x0b, 0x6d, 0x03, 0xe8,
-	0xe9, 0x26, 0x32, 0xe1, 0xea, 0xb9, 0xbc, 0x0d, 0x3b, 0x97, 0x33, 0x6b, 0xc0, 0xe6, 0xe4, 0xc9,
-	0xc5, 0x9f, 0xbd, 0x8d, 0x8b, 0x17, 0x3d, 0xeb, 0x97, 0x17, 0x3d, 0xeb, 0xc7, 0xbf, 0x7a, 0xd6,
-	0xc9, 0x20, 0x4e, 0xe4, 0x69, 0x31, 0x1b, 0x86, 0x7c, 0x39, 0xa2, 0x42, 0x16, 0x24, 0x5f, 0x99,
-	0xdf, 0x4b, 0x57, 0x7f, 0x62, 0xcd, 0xda, 0x7a, 0xf9, 0xe1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x20, 0x7a, 0xa8, 0x9a, 0x7d, 0x09, 0x00, 0x00,
+	// 1228 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xda, 0xbb, 0x8e, 0xf7, 0x75, 0x9c, 0x6c, 0xa7, 0xd5, 0xef, 0xb7, 0x72, 0x4b, 0x92,
+	0x1a, 0x90, 0xd2, 0x16, 0x6c, 0xd5, 0x80, 0x84, 0x2a, 0x90, 0x68, 0x5a, 0x90, 0x40, 0x49, 0x81,
+	0x4d, 0xb8, 0xf4, 0xb2, 0x1a, 0xef, 0x8e, 0x37, 0x83, 0xd7, 0x33, 0xab, 0x99, 0xd9, 0xb4, 0xe6,
+	0xc8, 0x91, 0x13, 0x1f, 0x81, 0x33, 0x5f, 0x83, 0x4b, 0x4f, 0x80, 0xc4, 0x85, 0x13, 0x88, 0xf2,
+	0x45, 0xd0, 0xcc, 0xec, 0x3a, 0xdb, 0xa6, 0x4a, 0x85, 0x7a, 0xe0, 0x90, 0x68, 0xe6, 0x7d, 0x9e,
+	0x79, 0xe7, 0x9d, 0xe7, 0xfd, 0xe3, 0x85, 0x41, 0xc6, 0xc7, 0x85, 0xe0, 0x8a, 0x27, 0x3c, 0x97,
+	0x63, 0x5e, 0x98, 0xbf, 0x91, 0xb1, 0xa0, 0x36, 0x2f, 0xe4, 0xe0, 0xda, 0x33, 0x84, 0x59, 0xce,
+	0x1f, 0x99, 0x7f, 0x96, 0x32, 0xb8, 0x92, 0xf1, 0x8c, 0x9b, 0xe5, 0x58, 0xaf, 0x2a, 0xeb, 0x4e,
+	0xc6, 0x79, 0x96, 0x13, 0x7b, 0x6e, 0x5a, 0xce, 0xc6, 0x8a, 0x2e, 0x88, 0x54, 0x78, 0x51, 0x58,
+	0xc2, 0xf0, 0xc7, 0x16, 0xf4, 0x8f, 0x4e, 0xb0, 0x48, 0x0f, 0xf0, 0x94, 0xe4, 0x94, 0x65, 0xe8,
+	0x0a, 0x78, 0xd3, 0x92, 0xe6, 0x69, 0xe8, 0xec, 0x3a, 0x7b, 0x7e, 0x64, 0x37, 0x68, 0x00, 0xdd,
+	0x13, 0x2e, 0x15, 0xc3, 0x0b, 0x12, 0xb6, 0x0c, 0xb0, 0xda, 0xa3, 0x5b, 0xe0, 0xe7, 0x3c, 0x8b,
+	0x73, 0x72, 0x4a, 0xf2, 0xb0, 0xbd, 0xeb, 0xec, 0x6d, 0x4e, 0x36, 0x47, 0x3a, 0xf8, 0x03, 0x9e,
+	0x8d, 0x0e, 0xb4, 0x35, 0xea, 0xe6, 0x3c, 0x33, 0x2b, 0x74, 0x0b, 0x3c, 0x81, 0x59, 0x46, 0x42,
+	0x6f, 0xd7, 0xd9, 0xeb, 0x4d, 0xb6, 0x46, 0xe6, 0x0d, 0x91, 0x36, 0x1d, 0x15, 0x24, 0xd9, 0x77,
+	0x9f, 0xfc, 0xb1, 0xb3, 0x16, 0x59, 0x0e, 0xba, 0x0e, 0x1b, 0xb2, 0xc8, 0xa9, 0x8a, 0x25, 0x2f,
+	0x45, 0x42, 0xc2, 0x8e, 0xb9, 0xb9, 0x67, 0x6c, 0x47, 0xc6, 0x74, 0x46, 0x51, 0x58, 0x64, 0x44,
+	0x85, 0xeb, 0x0d, 0xca, 0xb1, 0x31, 0xa1, 0xab, 0xe0, 0x2b, 0x2c, 0xe7, 0xb1, 0x09, 0xbe, 0x6b,
+	0x83, 0xd7, 0x86, 0x07, 0x3a, 0xf8, 0x9b, 0x15, 0xa8, 0x96, 0x05, 0x09, 0x7d, 0x13, 0x7c, 0xdf,
+	0x04, 0x7f, 0x8c, 0xe5, 0xfc, 0x78, 0x59, 0x10, 0xcb, 0xd5, 0xab, 0xe1, 0xb7, 0x0e, 0x74, 0x8d,
+	0x58, 0x11, 0x99, 0xa1, 0xeb, 0xe0, 0xce, 0x29, 0xb3, 0x32, 0x9d, 0x3b, 0x63, 0x20, 0x84, 0xc0,
+	0x6d, 0x08, 0x66, 0xd6, 0x3a, 0x98, 0x39, 0x59, 0xc6, 0x53, 0x92, 0x51, 0x66, 0xc4, 0xf2, 0xa3,
+	0xee, 0x9c, 0x2c, 0xf7, 0xf5, 0x1e, 0x0d, 0xa1, 0x2f, 0xe2, 0x24, 0xe7, 0xc9, 0xbc, 0x22, 0xb8,
+	0xf6, 0x35, 0xe2, 0x9e, 0xb6, 0x19, 0xce, 0x70, 0x00, 0xee, 0x21, 0x51, 0x58, 0x3b, 0x2f, 0x4b,
+	0x5a, 0xa7, 0xc9, 0xac, 0x87, 0x3f, 0xb7, 0xa1, 0x7d, 0xc0, 0x33, 0xb4, 0x0d, 0xee, 0x82, 0x28,
+	0x6c, 0xb0, 0xde, 0xc4, 0x37, 0xb1, 0xe9, 0x43, 0x91, 0x17, 0x6b, 0x3b, 0x7a, 0x1d, 0x3c, 0xa9,
+	0xdf, 0x61, 0x22, 0xeb, 0x55, 0xc1, 0xd7, 0x2f, 0x8b, 0x2c, 0x86, 0x6e, 0x83, 0xbf, 0xaa, 0x16,
+	0x13, 0x69, 0x6f, 0x32, 0x18, 0xd9, 0x7a, 0x1a, 0xd5, 0xf5, 0x34, 0x3a, 0xae, 0x19, 0x51, 0x4b,
+	0x49, 0xf4, 0x06, 0x78, 0xb6, 0x0a, 0xdc, 0x17, 0x56, 0x81, 0x05, 0x51, 0x08, 0xeb, 0x0b, 0x22,
+	0x25, 0xae, 0x8a, 0xc0, 0x8f, 0xea, 0x2d, 0x7a, 0x08, 0x5b, 0x33, 0x4a, 0xf2, 0x54, 0xc6, 0x5f,
+	0x4b, 0xce, 0xe2, 0x05, 0x2e, 0xc2, 0xce, 0x6e, 0x7b, 0xaf, 0x37, 0xb9, 0xba, 0xf2, 0xf4, 0x89,
+	0xc1, 0x3f, 0x93, 0x9c, 0x1d, 0xe2, 0xe2, 0x63, 0xa6, 0xc4, 0x72, 0xff, 0xda, 0x77, 0x7f, 0xee,
+	0x84, 0x84, 0x25, 0x3c, 0xa5, 0x2c, 0x1b, 0xeb, 0x93, 0xa3, 0x08, 0x3f, 0x3a, 0xb4, 0x3e, 0xa3,
+	0x8e, 0xf5, 0x88, 0xb6, 0xc1, 0x93, 0x05, 0x66, 0x32, 0x5c, 0x37, 0x1e, 0xbb, 0xb5, 0xc7, 0xc8,
+	0x9a, 0x07, 0x1f, 0x01, 0x3a, 0xef, 0x1b, 0x05, 0xd0, 0x9e, 0x93, 0x65, 0x25, 0xb2, 0x5e, 0xea,
+	0xfe, 0x38, 0xc5, 0x79, 0x59, 0x67, 0xd5, 0x6e, 0xee, 0xb4, 0xde, 0x77, 0x86, 0x5f, 0x82, 0x67,
+	0x6b, 0xfc, 0x32, 0x6c, 0x95, 0x2c, 0x25, 0x33, 0xca, 0x48, 0x6a, 0xdb, 0x22, 0x58, 0x43, 0x3e,
+	0x78, 0x44, 0x08, 0x2e, 0x02, 0x07, 0x75, 0xc1, 0x7d, 0x84, 0x05, 0x0b, 0x5a, 0x7a, 0x45, 0xd9,
+	0x8c, 0x07, 0x6d, 0x0d, 0xa7, 0x64, 0x5a, 0x66, 0x81, 0xab, 0x97, 0x4a, 0xe0, 0x84, 0x04, 0xde,
+	0xf0, 0xb7, 0x0d, 0xf0, 0x8e, 0x14, 0x56, 0xf2, 0x3f, 0x4b, 0xe9, 0x5b, 0x80, 0x78, 0x41, 0x58,
+	0x2c, 0x49, 0xc2, 0x59, 0x2a, 0x63, 0xc5, 0x15, 0xb6, 0xf9, 0x75, 0xa2, 0x40, 0x23, 0x47, 0x16,
+	0x38, 0xd6, 0x76, 0xd3, 0x6a, 0x8f, 0x59, 0x9c, 0xf0, 0x92, 0x29, 0x93, 0xdc, 0x7e, 0xd4, 0x55,
+	0x8f, 0xd9, 0x3d, 0xbd, 0x47, 0xb7, 0x61, 0x3d, 0xc1, 0x85, 0x2a, 0x05, 0xa9, 0xb2, 0xfa, 0x7f,
+	0x1b, 0xa4, 0x7e, 0xdf, 0xe8, 0x9e, 0x45, 0x8c, 0xea, 0x51, 0xcd, 0x43, 0x37, 0xa0, 0x93, 0x12,
+	0x41, 0x4f, 0x89, 0xe9, 0xeb, 0xde, 0xe4, 0x52, 0xe3, 0xc4, 0x7d, 0x03, 0x44, 0x15, 0x01, 0x7d,
+	0x08, 0xbd, 0x05, 0x56, 0x44, 0x50, 0x9c, 0xd3, 0x6f, 0x74, 0x9f, 0x9f, 0xd5, 0x8d, 0xe5, 0x1f,
+	0x9e, 0xa1, 0xf6, 0x96, 0x26, 0x1f, 0x8d, 0xa1, 0x4b, 0x99, 0x22, 0xe2, 0x14, 0xe7, 0x66, 0x0c,
+	0xf4, 0x26, 0x97, 0x1b, 0x67, 0x3f, 0xad, 0xa0, 0x68, 0x45, 0x42, 0xef, 0x42, 0x77, 0x4a, 0x99,
+	0x2e, 0x39, 0x19, 0x82, 0xb9, 0x2c, 0x6c, 0x1c, 0xd8, 0xaf, 0x20, 0x7b, 0xd3, 0x8a, 0x39, 0x78,
+	0x00, 0x1b, 0xf7, 0x79, 0x22, 0xef, 0xb2, 0x74, 0x7f, 0xa9, 0x88, 0x44, 0xaf, 0x01, 0xa4, 0x3c,
+	0xa9, 0x65, 0x75, 0x8c, 0x62, 0xbe, 0xb6, 0x58, 0x3d, 0x77, 0xa0, 0x37, 0xd5, 0xbc, 0x0a, 0xd7,
+	0xb9, 0x75, 0x23, 0x30, 0x26, 0x43, 0x18, 0x7c, 0xef, 0xc0, 0x7a, 0x75, 0x17, 0xba, 0x05, 0x6e,
+	0x4e, 0x66, 0xaa, 0x2a, 0x91, 0xa6, 0xb8, 0xcd, 0x2b, 0x23, 0x43, 0x42, 0x6f, 0x83, 0x27, 0x68,
+	0x76, 0xa2, 0xc2, 0xd6, 0xc5, 0x6c, 0xcb, 0x42, 0x37, 0xa0, 0xcd, 0x4b, 0x15, 0xb6, 0x2f, 0x26,
+	0x6b, 0x8e, 0x7e, 0x62, 0x33, 0x99, 0x2f, 0x68, 0xa1, 0xbd, 0x66, 0x0b, 0xf5, 0x26, 0xe8, 0xbc,
+	0x6e, 0x8d, 0xb6, 0x1a, 0xfc, 0xd2, 0x86, 0x8e, 0xcd, 0x35, 0xba, 0x0b, 0xa0, 0x04, 0x66, 0x72,
+	0xc6, 0xc5, 0x42, 0x86, 0x8e, 0x51, 0xfd, 0xfa, 0xb9, 0x92, 0x18, 0x1d, 0xaf, 0x38, 0x56, 0xfe,
+	0xc6, 0x21, 0xf4, 0x1e, 0xf8, 0x45, 0x39, 0xcd, 0xa9, 0x3c, 0x21, 0xe9, 0xcb, 0xde, 0x7e, 0xc6,
+	0xfc, 0x37, 0xef, 0xff, 0xc9, 0x01, 0x7f, 0x15, 0x01, 0xfa, 0x1f, 0x74, 0xaa, 0x1f, 0x2f, 0x2b,
+	0x40, 0xb5, 0xd3, 0xfa, 0x53, 0x56, 0x94, 0x2f, 0xd7, 0xdf, 0xb0, 0x74, 0xef, 0xc8, 0x39, 0x2d,
+	0x0a, 0x92, 0xbe, 0x2c, 0x86, 0x9a, 0x87, 0x3e, 0x80, 0x7e, 0x4a, 0xb0, 0x1e, 0x40, 0x4a, 0x11,
+	0x41, 0xd2, 0xd0, 0xbd, 0xf8, 0xe0, 0x86, 0x66, 0x1f, 0x54, 0xe4, 0xc1, 0x43, 0xd8, 0x7a, 0x4e,
+	0xc6, 0x17, 0x24, 0xf2, 0xf6, 0xb3, 0x89, 0xbc, 0x7a, 0x41, 0x2a, 0x9a, 0x19, 0x8d, 0x20, 0x78,
+	0xbe, 0x19, 0x5f, 0xb9, 0x4a, 0x7e, 0x77, 0xa0, 0x5b, 0x77, 0x29, 0x7a, 0x13, 0x36, 0xcb, 0x42,
+	0x4f, 0xba, 0x7a, 0x6c, 0x55, 0x9d, 0xd5, 0xb7, 0xd6, 0x6a, 0x64, 0xe9, 0xe6, 0x2b, 0xf5, 0x6f,
+	0x44, 0x2c, 0xb0, 0xb2, 0xd7, 0xb4, 0x22, 0xdf, 0x58, 0x22, 0xac, 0x88, 0x1e, 0x7d, 0x0b, 0xb2,
+	0xe0, 0x62, 0x19, 0x5b, 0x96, 0x69, 0x3b, 0x23, 0xbf, 0x1b, 0x05, 0x16, 0xf9, 0x4a, 0x03, 0xb6,
+	0x93, 0x6f, 0xc2, 0xa5, 0x8a, 0x5d, 0x10, 0x3c, 0xaf, 0xc8, 0xae, 0x21, 0x6f, 0x59, 0xe0, 0x0b,
+	0x82, 0xe7, 0x96, 0x7b, 0xe6, 0x39, 0xa7, 0x0b, 0xaa, 0x2a, 0xb2, 0xd7, 0xf4, 0x7c, 0xa0, 0x01,
+	0xc3, 0x1e, 0x7c, 0x0e, 0xfd, 0x67, 0xc6, 0xc9, 0xab, 0x6a, 0x75, 0xf3, 0x01, 0x74, 0xeb, 0x2f,
+	0x15, 0x14, 0xc0, 0x06, 0x65, 0xa7, 0x38, 0xa7, 0xa9, 0xf9, 0x04, 0x0a, 0xd6, 0x50, 0x6f, 0x35,
+	0xa6, 0x03, 0x07, 0x6d, 0x02, 0x98, 0xf9, 0x8a, 0x15, 0xe5, 0xfa, 0x07, 0xeb, 0x32, 0x6c, 0x9d,
+	0x4d, 0x4d, 0x6b, 0x6c, 0xef, 0xdf, 0x79, 0xf2, 0xd7, 0xf6, 0xda, 0x93, 0xa7, 0xdb, 0xce, 0xaf,
+	0x4f, 0xb7, 0x9d, 0x1f, 0xfe, 0xde, 0x76, 0x1e, 0xee, 0x65, 0x54, 0x9d, 0x94, 0xd3, 0x51, 0xc2,
+	0x17, 0x63, 0x22, 0x55, 0x89, 0xc5, 0xd2, 0x7e, 0xb1, 0x3e, 0xff, 0x91, 0x3b, 0xed, 0x98, 0xed,
+	0x3b, 0xff, 0x0c, 0x00, 0xfe, 0xc5, 0x22, 0xe4, 0xff, 0x0a, 0x00, 0x00,
 }
 
 func (m *ShardLabeling) Marshal() (dAtA []byte, err error) {
@@ -984,6 +1009,32 @@ func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Bindings) > 0 {
+		for k := range m.Bindings {
+			v := m.Bindings[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintOps(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOps(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOps(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.Interval != nil {
 		{
 			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
@@ -1311,6 +1362,30 @@ func (m *Stats_Derive_Transform) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DeadLettered != nil {
+		{
+			size, err := m.DeadLettered.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Skipped != nil {
+		{
+			size, err := m.Skipped.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Input != nil {
 		{
 			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
@@ -1357,6 +1432,21 @@ func (m *Stats_Interval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MemoryLimitBytes != 0 {
+		i = encodeVarintOps(dAtA, i, uint64(m.MemoryLimitBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MemoryPeakBytes != 0 {
+		i = encodeVarintOps(dAtA, i, uint64(m.MemoryPeakBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MemoryUsageBytes != 0 {
+		i = encodeVarintOps(dAtA, i, uint64(m.MemoryUsageBytes))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.UsageRate != 0 {
 		i -= 4
 		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.UsageRate))))
@@ -1568,6 +1658,19 @@ func (m *Stats) ProtoSize() (n int) {
 		l = m.Interval.ProtoSize()
 		n += 1 + l + sovOps(uint64(l))
 	}
+	if len(m.Bindings) > 0 {
+		for k, v := range m.Bindings {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.ProtoSize()
+				l += 1 + sovOps(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovOps(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovOps(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1663,6 +1766,14 @@ func (m *Stats_Derive_Transform) ProtoSize() (n int) {
 		l = m.Input.ProtoSize()
 		n += 1 + l + sovOps(uint64(l))
 	}
+	if m.Skipped != nil {
+		l = m.Skipped.ProtoSize()
+		n += 1 + l + sovOps(uint64(l))
+	}
+	if m.DeadLettered != nil {
+		l = m.DeadLettered.ProtoSize()
+		n += 1 + l + sovOps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1681,6 +1792,15 @@ func (m *Stats_Interval) ProtoSize() (n int) {
 	if m.UsageRate != 0 {
 		n += 5
 	}
+	if m.MemoryUsageBytes != 0 {
+		n += 1 + sovOps(uint64(m.MemoryUsageBytes))
+	}
+	if m.MemoryPeakBytes != 0 {
+		n += 1 + sovOps(uint64(m.MemoryPeakBytes))
+	}
+	if m.MemoryLimitBytes != 0 {
+		n += 1 + sovOps(uint64(m.MemoryLimitBytes))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3092,6 +3212,135 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bindings == nil {
+				m.Bindings = make(map[string]*Stats_Binding)
+			}
+			var mapkey string
+			var mapvalue *Stats_Binding
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthOps
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthOps
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Stats_Binding{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Bindings[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOps(dAtA[iNdEx:])
@@ -3711,6 +3960,78 @@ func (m *Stats_Derive_Transform) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Skipped == nil {
+				m.Skipped = &Stats_DocsAndBytes{}
+			}
+			if err := m.Skipped.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLettered", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadLettered == nil {
+				m.DeadLettered = &Stats_DocsAndBytes{}
+			}
+			if err := m.DeadLettered.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOps(dAtA[iNdEx:])
@@ -3792,6 +4113,63 @@ func (m *Stats_Interval) Unmarshal(dAtA []byte) error {
 			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
 			iNdEx += 4
 			m.UsageRate = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryUsageBytes", wireType)
+			}
+			m.MemoryUsageBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryUsageBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryPeakBytes", wireType)
+			}
+			m.MemoryPeakBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryPeakBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimitBytes", wireType)
+			}
+			m.MemoryLimitBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryLimitBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOps(dAtA[iNdEx:])
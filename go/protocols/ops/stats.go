@@ -25,6 +25,26 @@ func (s *Stats_DocsAndBytes) MarshalJSONPB(*jsonpb.Marshaler) ([]byte, error) {
 	})
 }
 
+type jsonInterval struct {
+	UptimeSeconds    uint32  `json:"uptimeSeconds,omitempty"`
+	UsageRate        float32 `json:"usageRate,omitempty"`
+	MemoryUsageBytes uint64  `json:"memoryUsageBytes,omitempty"`
+	MemoryPeakBytes  uint64  `json:"memoryPeakBytes,omitempty"`
+	MemoryLimitBytes uint64  `json:"memoryLimitBytes,omitempty"`
+}
+
+// MarshalJSONPB is implemented for the same reason as Stats_DocsAndBytes:
+// memory fields are uint64, and aren't quoted.
+func (s *Stats_Interval) MarshalJSONPB(*jsonpb.Marshaler) ([]byte, error) {
+	return json.Marshal(jsonInterval{
+		UptimeSeconds:    s.UptimeSeconds,
+		UsageRate:        s.UsageRate,
+		MemoryUsageBytes: s.MemoryUsageBytes,
+		MemoryPeakBytes:  s.MemoryPeakBytes,
+		MemoryLimitBytes: s.MemoryLimitBytes,
+	})
+}
+
 // StatsCollection returns the collection to which stats for the given task name are written.
 func StatsCollection(taskName string) pf.Collection {
 	return pf.Collection(fmt.Sprintf("ops/%s/stats", strings.Split(taskName, "/")[0]))
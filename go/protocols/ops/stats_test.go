@@ -34,3 +34,23 @@ func Test_StatsSerde_RoundTrip(t *testing.T) {
 	require.NoError(t, err)
 	cupaloy.SnapshotT(t, string(pb))
 }
+
+func Test_StatsIntervalSerde_RoundTrip(t *testing.T) {
+	want := &Stats_Interval{
+		UptimeSeconds:    300,
+		UsageRate:        1.5,
+		MemoryUsageBytes: 1 << 20,
+		MemoryPeakBytes:  2 << 20,
+		MemoryLimitBytes: 4 << 20,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, (&jsonpb.Marshaler{}).Marshal(&buf, want))
+	require.Equal(t,
+		`{"uptimeSeconds":300,"usageRate":1.5,"memoryUsageBytes":1048576,"memoryPeakBytes":2097152,"memoryLimitBytes":4194304}`,
+		buf.String())
+
+	got := new(Stats_Interval)
+	require.NoError(t, (&jsonpb.Unmarshaler{}).Unmarshal(bytes.NewReader(buf.Bytes()), got))
+	require.Equal(t, want, got)
+}
@@ -454,6 +454,7 @@ type CaptureResponseExt struct {
 	Opened               *CaptureResponseExt_Opened     `protobuf:"bytes,2,opt,name=opened,proto3" json:"opened,omitempty"`
 	Captured             *CaptureResponseExt_Captured   `protobuf:"bytes,3,opt,name=captured,proto3" json:"captured,omitempty"`
 	Checkpoint           *CaptureResponseExt_Checkpoint `protobuf:"bytes,4,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Keepalive            *CaptureResponseExt_Keepalive  `protobuf:"bytes,5,opt,name=keepalive,proto3" json:"keepalive,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
 	XXX_unrecognized     []byte                         `json:"-"`
 	XXX_sizecache        int32                          `json:"-"`
@@ -616,16 +617,58 @@ func (m *CaptureResponseExt_Checkpoint) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CaptureResponseExt_Checkpoint proto.InternalMessageInfo
 
+// Keepalive is sent by connector-init at the interval requested by the
+// runtime, and is not forwarded to the client of the runtime.
+type CaptureResponseExt_Keepalive struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CaptureResponseExt_Keepalive) Reset()         { *m = CaptureResponseExt_Keepalive{} }
+func (m *CaptureResponseExt_Keepalive) String() string { return proto.CompactTextString(m) }
+func (*CaptureResponseExt_Keepalive) ProtoMessage()    {}
+func (*CaptureResponseExt_Keepalive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73af6e0737ce390c, []int{6, 3}
+}
+func (m *CaptureResponseExt_Keepalive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CaptureResponseExt_Keepalive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CaptureResponseExt_Keepalive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CaptureResponseExt_Keepalive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaptureResponseExt_Keepalive.Merge(m, src)
+}
+func (m *CaptureResponseExt_Keepalive) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CaptureResponseExt_Keepalive) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaptureResponseExt_Keepalive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CaptureResponseExt_Keepalive proto.InternalMessageInfo
+
 type DeriveRequestExt struct {
 	// Log.Level of this Request.
 	LogLevel ops.Log_Level `protobuf:"varint,1,opt,name=log_level,json=logLevel,proto3,enum=ops.Log_Level" json:"log_level,omitempty"`
 	// RocksDB descriptor which should be opened. Sent (only) with the first Request.
 	// Ommitted if this is a SQLite derivation.
-	RocksdbDescriptor    *RocksDBDescriptor     `protobuf:"bytes,2,opt,name=rocksdb_descriptor,json=rocksdbDescriptor,proto3" json:"rocksdb_descriptor,omitempty"`
-	Open                 *DeriveRequestExt_Open `protobuf:"bytes,3,opt,name=open,proto3" json:"open,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	RocksdbDescriptor    *RocksDBDescriptor      `protobuf:"bytes,2,opt,name=rocksdb_descriptor,json=rocksdbDescriptor,proto3" json:"rocksdb_descriptor,omitempty"`
+	Open                 *DeriveRequestExt_Open  `protobuf:"bytes,3,opt,name=open,proto3" json:"open,omitempty"`
+	Query                *DeriveRequestExt_Query `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *DeriveRequestExt) Reset()         { *m = DeriveRequestExt{} }
@@ -702,11 +745,62 @@ func (m *DeriveRequestExt_Open) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeriveRequestExt_Open proto.InternalMessageInfo
 
+// Query is a read-only query of the SQLite state of the derivation,
+// which is sent between transactions. The connector runs the query
+// and responds with DeriveResponseExt.Queried.
+type DeriveRequestExt_Query struct {
+	// SQL query to execute.
+	Sql string `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`
+	// JSON-encoded array of parameters bound to the query.
+	ParamsJson string `protobuf:"bytes,2,opt,name=params_json,json=paramsJson,proto3" json:"params_json,omitempty"`
+	// Duration, in milliseconds, after which the query is interrupted.
+	TimeoutMs uint32 `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// Maximum number of rows which the query may return.
+	MaxRows              uint32   `protobuf:"varint,4,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeriveRequestExt_Query) Reset()         { *m = DeriveRequestExt_Query{} }
+func (m *DeriveRequestExt_Query) String() string { return proto.CompactTextString(m) }
+func (*DeriveRequestExt_Query) ProtoMessage()    {}
+func (*DeriveRequestExt_Query) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73af6e0737ce390c, []int{7, 1}
+}
+func (m *DeriveRequestExt_Query) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeriveRequestExt_Query) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeriveRequestExt_Query.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeriveRequestExt_Query) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeriveRequestExt_Query.Merge(m, src)
+}
+func (m *DeriveRequestExt_Query) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeriveRequestExt_Query) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeriveRequestExt_Query.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeriveRequestExt_Query proto.InternalMessageInfo
+
 type DeriveResponseExt struct {
 	Container            *Container                   `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
 	Opened               *DeriveResponseExt_Opened    `protobuf:"bytes,2,opt,name=opened,proto3" json:"opened,omitempty"`
 	Published            *DeriveResponseExt_Published `protobuf:"bytes,3,opt,name=published,proto3" json:"published,omitempty"`
 	Flushed              *DeriveResponseExt_Flushed   `protobuf:"bytes,4,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	Queried              *DeriveResponseExt_Queried   `protobuf:"bytes,5,opt,name=queried,proto3" json:"queried,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -791,7 +885,11 @@ type DeriveResponseExt_Published struct {
 	// Packed key extracted from the published document.
 	KeyPacked []byte `protobuf:"bytes,2,opt,name=key_packed,json=keyPacked,proto3" json:"key_packed,omitempty"`
 	// Packed partition values extracted from the published document.
-	PartitionsPacked     []byte   `protobuf:"bytes,3,opt,name=partitions_packed,json=partitionsPacked,proto3" json:"partitions_packed,omitempty"`
+	PartitionsPacked []byte `protobuf:"bytes,3,opt,name=partitions_packed,json=partitionsPacked,proto3" json:"partitions_packed,omitempty"`
+	// Dead-letter collection of a source document which a transform failed
+	// to process. When set, this document is published to the dead-letter
+	// collection rather than the derived collection.
+	DeadLetter           string   `protobuf:"bytes,4,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -870,6 +968,52 @@ func (m *DeriveResponseExt_Flushed) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeriveResponseExt_Flushed proto.InternalMessageInfo
 
+type DeriveResponseExt_Queried struct {
+	// Columns of the query result.
+	Columns []string `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	// JSON-encoded array of result rows, each an array of column values.
+	RowsJson string `protobuf:"bytes,2,opt,name=rows_json,json=rowsJson,proto3" json:"rows_json,omitempty"`
+	// Error of the query, if it failed.
+	// A failed query doesn't fail the derivation.
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeriveResponseExt_Queried) Reset()         { *m = DeriveResponseExt_Queried{} }
+func (m *DeriveResponseExt_Queried) String() string { return proto.CompactTextString(m) }
+func (*DeriveResponseExt_Queried) ProtoMessage()    {}
+func (*DeriveResponseExt_Queried) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73af6e0737ce390c, []int{8, 3}
+}
+func (m *DeriveResponseExt_Queried) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeriveResponseExt_Queried) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeriveResponseExt_Queried.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeriveResponseExt_Queried) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeriveResponseExt_Queried.Merge(m, src)
+}
+func (m *DeriveResponseExt_Queried) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeriveResponseExt_Queried) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeriveResponseExt_Queried.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeriveResponseExt_Queried proto.InternalMessageInfo
+
 type MaterializeRequestExt struct {
 	// Log.Level of this Request.
 	LogLevel ops.Log_Level `protobuf:"varint,1,opt,name=log_level,json=logLevel,proto3,enum=ops.Log_Level" json:"log_level,omitempty"`
@@ -914,11 +1058,12 @@ func (m *MaterializeRequestExt) XXX_DiscardUnknown() {
 var xxx_messageInfo_MaterializeRequestExt proto.InternalMessageInfo
 
 type MaterializeResponseExt struct {
-	Container            *Container                      `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
-	Flushed              *MaterializeResponseExt_Flushed `protobuf:"bytes,2,opt,name=flushed,proto3" json:"flushed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+	Container            *Container                        `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Flushed              *MaterializeResponseExt_Flushed   `protobuf:"bytes,2,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	Keepalive            *MaterializeResponseExt_Keepalive `protobuf:"bytes,3,opt,name=keepalive,proto3" json:"keepalive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
 func (m *MaterializeResponseExt) Reset()         { *m = MaterializeResponseExt{} }
@@ -994,6 +1139,47 @@ func (m *MaterializeResponseExt_Flushed) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MaterializeResponseExt_Flushed proto.InternalMessageInfo
 
+// Keepalive is sent by connector-init at the interval requested by the
+// runtime, and is not forwarded to the client of the runtime.
+type MaterializeResponseExt_Keepalive struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaterializeResponseExt_Keepalive) Reset()         { *m = MaterializeResponseExt_Keepalive{} }
+func (m *MaterializeResponseExt_Keepalive) String() string { return proto.CompactTextString(m) }
+func (*MaterializeResponseExt_Keepalive) ProtoMessage()    {}
+func (*MaterializeResponseExt_Keepalive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73af6e0737ce390c, []int{10, 1}
+}
+func (m *MaterializeResponseExt_Keepalive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaterializeResponseExt_Keepalive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaterializeResponseExt_Keepalive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaterializeResponseExt_Keepalive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaterializeResponseExt_Keepalive.Merge(m, src)
+}
+func (m *MaterializeResponseExt_Keepalive) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *MaterializeResponseExt_Keepalive) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaterializeResponseExt_Keepalive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaterializeResponseExt_Keepalive proto.InternalMessageInfo
+
 type CombineRequest struct {
 	Open                 *CombineRequest_Open `protobuf:"bytes,1,opt,name=open,proto3" json:"open,omitempty"`
 	Add                  *CombineRequest_Add  `protobuf:"bytes,2,opt,name=add,proto3" json:"add,omitempty"`
@@ -1239,15 +1425,19 @@ func init() {
 	proto.RegisterType((*CaptureResponseExt_Opened)(nil), "runtime.CaptureResponseExt.Opened")
 	proto.RegisterType((*CaptureResponseExt_Captured)(nil), "runtime.CaptureResponseExt.Captured")
 	proto.RegisterType((*CaptureResponseExt_Checkpoint)(nil), "runtime.CaptureResponseExt.Checkpoint")
+	proto.RegisterType((*CaptureResponseExt_Keepalive)(nil), "runtime.CaptureResponseExt.Keepalive")
 	proto.RegisterType((*DeriveRequestExt)(nil), "runtime.DeriveRequestExt")
 	proto.RegisterType((*DeriveRequestExt_Open)(nil), "runtime.DeriveRequestExt.Open")
+	proto.RegisterType((*DeriveRequestExt_Query)(nil), "runtime.DeriveRequestExt.Query")
 	proto.RegisterType((*DeriveResponseExt)(nil), "runtime.DeriveResponseExt")
 	proto.RegisterType((*DeriveResponseExt_Opened)(nil), "runtime.DeriveResponseExt.Opened")
 	proto.RegisterType((*DeriveResponseExt_Published)(nil), "runtime.DeriveResponseExt.Published")
 	proto.RegisterType((*DeriveResponseExt_Flushed)(nil), "runtime.DeriveResponseExt.Flushed")
+	proto.RegisterType((*DeriveResponseExt_Queried)(nil), "runtime.DeriveResponseExt.Queried")
 	proto.RegisterType((*MaterializeRequestExt)(nil), "runtime.MaterializeRequestExt")
 	proto.RegisterType((*MaterializeResponseExt)(nil), "runtime.MaterializeResponseExt")
 	proto.RegisterType((*MaterializeResponseExt_Flushed)(nil), "runtime.MaterializeResponseExt.Flushed")
+	proto.RegisterType((*MaterializeResponseExt_Keepalive)(nil), "runtime.MaterializeResponseExt.Keepalive")
 	proto.RegisterType((*CombineRequest)(nil), "runtime.CombineRequest")
 	proto.RegisterType((*CombineRequest_Open)(nil), "runtime.CombineRequest.Open")
 	proto.RegisterType((*CombineRequest_Open_Binding)(nil), "runtime.CombineRequest.Open.Binding")
@@ -1260,127 +1450,139 @@ func init() {
 }
 
 var fileDescriptor_73af6e0737ce390c = []byte{
-	// 1909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x72, 0x1b, 0xc7,
-	0x11, 0xd6, 0xe2, 0x87, 0xc0, 0x36, 0x40, 0x0a, 0x9c, 0x52, 0x6c, 0x18, 0x56, 0x48, 0x1a, 0xb6,
-	0x13, 0x56, 0x24, 0x2f, 0x15, 0x3a, 0x95, 0x38, 0xae, 0xc4, 0x25, 0x02, 0x24, 0x23, 0x28, 0xa4,
-	0xc8, 0x0c, 0x24, 0x55, 0x25, 0x97, 0xad, 0xe5, 0xce, 0x00, 0x58, 0x61, 0xb1, 0xb3, 0x9e, 0xd9,
-	0x25, 0x45, 0xbf, 0x42, 0x0e, 0x79, 0x85, 0x9c, 0xf3, 0x06, 0xf1, 0x13, 0xe8, 0x98, 0xca, 0x21,
-	0x95, 0x13, 0xab, 0xe2, 0x5c, 0x73, 0x4c, 0x0e, 0x51, 0xe5, 0x90, 0x9a, 0x9f, 0x5d, 0x80, 0x80,
-	0xa8, 0x28, 0xb4, 0x0f, 0x3e, 0x48, 0x9c, 0xe9, 0xee, 0xaf, 0xb7, 0xbb, 0xa7, 0xbb, 0xa7, 0x07,
-	0xd0, 0x1e, 0xb2, 0xad, 0x98, 0xb3, 0x84, 0xf9, 0x2c, 0x14, 0x5b, 0x3c, 0x8d, 0x92, 0x60, 0x42,
-	0xb3, 0xbf, 0x8e, 0xe2, 0xa0, 0x8a, 0xd9, 0xb6, 0xd6, 0x4e, 0x38, 0x1b, 0x53, 0x9e, 0x03, 0xf2,
-	0x85, 0x16, 0x6c, 0x6d, 0xf8, 0x2c, 0x12, 0xe9, 0xe4, 0x35, 0x12, 0xb7, 0x2f, 0x7d, 0x6e, 0x10,
-	0xb2, 0x33, 0xf5, 0x9f, 0xe1, 0xb6, 0x2e, 0x71, 0x59, 0xac, 0xfe, 0x19, 0xde, 0xad, 0x21, 0x1b,
-	0x32, 0xb5, 0xdc, 0x92, 0x2b, 0x4d, 0x6d, 0xff, 0xd1, 0x82, 0xd5, 0xc7, 0x9e, 0x18, 0xf7, 0x29,
-	0x3f, 0x0d, 0x7c, 0xda, 0x65, 0xd1, 0x20, 0x18, 0xa2, 0x35, 0xa8, 0x85, 0x6c, 0xe8, 0x0e, 0x82,
-	0x90, 0xba, 0x03, 0xd2, 0xb4, 0x36, 0xac, 0xcd, 0x32, 0xb6, 0x43, 0x36, 0xdc, 0x0f, 0x42, 0xba,
-	0x4f, 0xd0, 0xbb, 0x60, 0x27, 0x9e, 0x18, 0xbb, 0x91, 0x37, 0xa1, 0xcd, 0xc2, 0x86, 0xb5, 0x69,
-	0xe3, 0xaa, 0x24, 0x3c, 0xf2, 0x26, 0x14, 0xbd, 0x03, 0xd5, 0x94, 0x08, 0x37, 0xf6, 0x92, 0x51,
-	0xb3, 0xa8, 0x78, 0x95, 0x94, 0x88, 0x63, 0x2f, 0x19, 0xa1, 0x3b, 0xb0, 0xea, 0xb3, 0x28, 0xf1,
-	0x82, 0x88, 0x72, 0x37, 0xa2, 0xc9, 0x19, 0xe3, 0xe3, 0x66, 0x49, 0xc9, 0x34, 0x72, 0xc6, 0x23,
-	0x4d, 0x47, 0xeb, 0x50, 0xf3, 0xc2, 0x90, 0x9d, 0xb9, 0x21, 0xf3, 0xbd, 0xb0, 0x59, 0xde, 0xb0,
-	0x36, 0xab, 0x18, 0x14, 0xe9, 0x40, 0x52, 0xda, 0xff, 0x2e, 0xc1, 0x4a, 0x7f, 0x94, 0x0e, 0x06,
-	0x21, 0xc5, 0xf4, 0xf3, 0x94, 0x8a, 0x04, 0xf5, 0xa0, 0xf2, 0x8c, 0xa5, 0x3c, 0xf2, 0x42, 0x65,
-	0xb4, 0xdd, 0xd9, 0x7a, 0x79, 0xb1, 0x7e, 0x67, 0xc8, 0x9c, 0xa1, 0xf7, 0x05, 0x4d, 0x12, 0xea,
-	0x10, 0x7a, 0xba, 0xe5, 0x33, 0x4e, 0xb7, 0xe6, 0x4e, 0xc2, 0x79, 0xa8, 0x61, 0x38, 0xc3, 0xa3,
-	0xb7, 0x60, 0x89, 0xd3, 0x38, 0xf4, 0xce, 0x95, 0x83, 0x55, 0x6c, 0x76, 0xd2, 0xbd, 0x93, 0x34,
-	0x08, 0x89, 0x1b, 0x90, 0xcc, 0x3d, 0xb5, 0xef, 0x11, 0xb4, 0x0f, 0x4b, 0x6c, 0x30, 0x10, 0x34,
-	0x51, 0x3e, 0x15, 0x3b, 0xce, 0xcb, 0x8b, 0xf5, 0x1f, 0xbc, 0xc9, 0xc7, 0x8f, 0x14, 0x0a, 0x1b,
-	0x34, 0x3a, 0x04, 0xa0, 0x11, 0x71, 0x8d, 0xae, 0xf2, 0xb5, 0x74, 0xd9, 0x34, 0x22, 0x7a, 0x89,
-	0xee, 0x40, 0x99, 0x7b, 0xd1, 0x90, 0x36, 0x97, 0x36, 0xac, 0xcd, 0xda, 0xf6, 0x4d, 0x47, 0x65,
-	0x0c, 0x96, 0xa4, 0x7e, 0x4c, 0xfd, 0x4e, 0xe9, 0xc5, 0xc5, 0xfa, 0x0d, 0xac, 0x65, 0x50, 0x1f,
-	0x6a, 0x3e, 0x63, 0x9c, 0x04, 0x91, 0x97, 0x30, 0xde, 0xac, 0xa8, 0x28, 0xfe, 0xf0, 0xe5, 0xc5,
-	0xfa, 0x47, 0xaf, 0xfa, 0xf8, 0x42, 0xbe, 0x3a, 0xfd, 0x91, 0xc7, 0x49, 0x6f, 0x17, 0xcf, 0x6a,
-	0x41, 0xf7, 0x00, 0x38, 0x15, 0x2c, 0x4c, 0x93, 0x80, 0x45, 0xcd, 0xaa, 0x32, 0xa3, 0xe1, 0xe4,
-	0x98, 0x07, 0xd4, 0x23, 0x94, 0xe3, 0x19, 0x19, 0xf4, 0x3e, 0x2c, 0x0b, 0x7d, 0xb4, 0x6e, 0x10,
-	0x11, 0xfa, 0xbc, 0x69, 0x6f, 0x58, 0x9b, 0xcb, 0xb8, 0x6e, 0x88, 0x3d, 0x49, 0x43, 0x3f, 0x02,
-	0x20, 0x94, 0x07, 0xa7, 0x9e, 0x52, 0x0b, 0x4a, 0xed, 0x2d, 0xed, 0x5d, 0x97, 0x85, 0x21, 0xf5,
-	0x25, 0x5d, 0xba, 0x88, 0x67, 0xe4, 0x50, 0x17, 0x6e, 0x4e, 0xbc, 0x84, 0xf2, 0xc0, 0x0b, 0x83,
-	0x2f, 0x34, 0xb4, 0xa6, 0xa0, 0xef, 0x68, 0xe8, 0xe1, 0x65, 0xa6, 0xc2, 0xcf, 0x23, 0xda, 0x7f,
-	0x2e, 0xc1, 0xcd, 0x3c, 0xf7, 0x44, 0xcc, 0x22, 0x41, 0xd1, 0x26, 0x2c, 0x89, 0xc4, 0x4b, 0x52,
-	0xa1, 0x72, 0x6f, 0x65, 0xbb, 0xe1, 0x64, 0xe1, 0x71, 0xfa, 0x8a, 0x8e, 0x0d, 0x5f, 0x4a, 0x8e,
-	0x94, 0xcf, 0x2a, 0xb7, 0x5e, 0x15, 0x0b, 0xc3, 0x47, 0x1f, 0xc2, 0x4a, 0x42, 0xf9, 0x24, 0x88,
-	0xbc, 0xd0, 0xa5, 0x9c, 0x33, 0x6e, 0x72, 0x6e, 0x39, 0xa3, 0xee, 0x49, 0x22, 0xfa, 0x15, 0xd4,
-	0x39, 0xf5, 0x88, 0x9b, 0x8c, 0x38, 0x4b, 0x87, 0xa3, 0x6b, 0xe6, 0x5f, 0x4d, 0xea, 0x78, 0xac,
-	0x55, 0xc8, 0x24, 0x3c, 0xe3, 0x41, 0x42, 0x5d, 0x69, 0xc9, 0x75, 0x93, 0x50, 0x69, 0x90, 0x2e,
-	0xa1, 0x1e, 0x94, 0x3d, 0x4e, 0x23, 0x4f, 0x25, 0x61, 0xbd, 0xf3, 0xf1, 0xcb, 0x8b, 0xf5, 0xad,
-	0x61, 0x90, 0x8c, 0xd2, 0x13, 0xc7, 0x67, 0x93, 0x2d, 0x2a, 0x92, 0xd4, 0xe3, 0xe7, 0xba, 0xa3,
-	0x2d, 0xf4, 0x38, 0x67, 0x47, 0x42, 0xb1, 0xd6, 0x80, 0x3e, 0x84, 0x12, 0x61, 0xbe, 0x68, 0x56,
-	0x36, 0x8a, 0x9b, 0xb5, 0xed, 0x9a, 0x3e, 0xb5, 0x7e, 0x18, 0xf8, 0xd4, 0xa4, 0xb2, 0x62, 0xa3,
-	0x07, 0x50, 0xd1, 0x15, 0x24, 0x9a, 0xd5, 0x8d, 0xe2, 0x35, 0xac, 0xcf, 0xe0, 0x32, 0xcf, 0xd2,
-	0x34, 0x20, 0x6e, 0xec, 0xf1, 0x44, 0x34, 0x6d, 0xf5, 0x59, 0x53, 0x45, 0x4f, 0x9e, 0xf4, 0x76,
-	0x8f, 0x25, 0xd9, 0x7c, 0xda, 0x96, 0x82, 0x8a, 0x20, 0x93, 0x3e, 0xf6, 0xfc, 0x31, 0x25, 0xee,
-	0x98, 0x9e, 0x37, 0xe1, 0x2a, 0x63, 0x6d, 0x2d, 0xf4, 0x4b, 0x7a, 0xde, 0x26, 0xb0, 0x8a, 0x99,
-	0x3f, 0x16, 0xbb, 0x9d, 0x5d, 0x2a, 0x7c, 0x1e, 0xc4, 0xb2, 0x76, 0xee, 0x02, 0xe2, 0x92, 0x48,
-	0x4e, 0x5c, 0x1a, 0x9d, 0xba, 0x13, 0x3a, 0x89, 0x13, 0xae, 0x32, 0x6c, 0x09, 0x37, 0x0c, 0x67,
-	0x2f, 0x3a, 0x3d, 0x54, 0x74, 0xf4, 0x1e, 0xd4, 0x33, 0x69, 0xd5, 0x80, 0x75, 0x73, 0xae, 0x19,
-	0x9a, 0x6c, 0xc2, 0xed, 0x7f, 0x59, 0x60, 0x77, 0xb3, 0x66, 0x8b, 0xde, 0x86, 0x4a, 0x10, 0xbb,
-	0x1e, 0x21, 0x5a, 0xa7, 0x8d, 0x97, 0x82, 0x78, 0x87, 0x10, 0x8e, 0x7e, 0x0c, 0xcb, 0xa6, 0x43,
-	0xbb, 0x31, 0x93, 0x7e, 0x17, 0x94, 0x07, 0xab, 0xda, 0x03, 0xd3, 0xa4, 0x8f, 0x19, 0x4f, 0x70,
-	0x3d, 0x9a, 0x6e, 0x04, 0xea, 0xc3, 0xea, 0xc4, 0x8b, 0x63, 0x4a, 0xdc, 0x11, 0x13, 0x89, 0xc1,
-	0x16, 0x15, 0xf6, 0xfb, 0x4e, 0x76, 0x2f, 0xe6, 0xdf, 0x77, 0x0e, 0x95, 0xec, 0x03, 0x26, 0x12,
-	0x05, 0xdf, 0x8b, 0x12, 0x7e, 0x2e, 0xcb, 0xed, 0x12, 0xb5, 0xd5, 0x81, 0x5b, 0xaf, 0x12, 0x44,
-	0x0d, 0x28, 0xca, 0xe0, 0x5a, 0xaa, 0x39, 0xc8, 0x25, 0xba, 0x05, 0xe5, 0x53, 0x2f, 0x4c, 0xb3,
-	0x6b, 0x49, 0x6f, 0x3e, 0x2d, 0x7c, 0x62, 0xb5, 0xff, 0x50, 0x80, 0xd5, 0xae, 0x17, 0x27, 0x29,
-	0xcf, 0xae, 0x8b, 0xbd, 0xe7, 0xb2, 0x39, 0xca, 0x7b, 0xcd, 0x0d, 0xe9, 0x29, 0x0d, 0x4d, 0xdd,
-	0xae, 0x38, 0xf2, 0xd6, 0x3c, 0x60, 0x43, 0xe7, 0x40, 0x52, 0x71, 0x35, 0x64, 0x43, 0xb5, 0x42,
-	0xbd, 0xe9, 0x59, 0x90, 0xfc, 0x84, 0x4c, 0x0d, 0xb7, 0x72, 0xe7, 0x16, 0xce, 0x10, 0xaf, 0x1a,
-	0xd4, 0xcc, 0xb1, 0xf6, 0xa0, 0x2e, 0x12, 0x8f, 0x27, 0xae, 0xcf, 0x26, 0x93, 0x20, 0x51, 0x65,
-	0x5d, 0xdb, 0xfe, 0xde, 0x34, 0x42, 0xf3, 0x96, 0xca, 0x1e, 0xc2, 0x93, 0xae, 0x92, 0xc6, 0x35,
-	0x31, 0xdd, 0xb4, 0x30, 0xd4, 0x66, 0x78, 0xa8, 0x0b, 0xc8, 0x28, 0x71, 0xfd, 0x11, 0xf5, 0xc7,
-	0x31, 0x0b, 0xa2, 0x44, 0xb9, 0x26, 0xbb, 0x63, 0xde, 0x92, 0xba, 0x39, 0x0f, 0xaf, 0x1a, 0xf9,
-	0x29, 0xa9, 0xfd, 0x9f, 0x12, 0xa0, 0xdc, 0x04, 0xdd, 0xdf, 0x64, 0xb4, 0xee, 0x81, 0x9d, 0xdf,
-	0xd3, 0x46, 0x25, 0x5a, 0x3c, 0x54, 0x3c, 0x15, 0x42, 0x9f, 0xc2, 0x12, 0x8b, 0x69, 0x44, 0x89,
-	0x09, 0x53, 0x7b, 0xd1, 0xc3, 0x5c, 0xbd, 0x73, 0xa4, 0x24, 0xb1, 0x41, 0xa0, 0xfb, 0x50, 0xf5,
-	0xb5, 0x10, 0x31, 0xf1, 0xf9, 0xe0, 0x75, 0x68, 0x43, 0x22, 0x38, 0x47, 0xa1, 0x7d, 0x80, 0x99,
-	0x18, 0x94, 0xae, 0x8a, 0xf1, 0x8c, 0x8e, 0x69, 0x54, 0x66, 0x90, 0xad, 0x43, 0x58, 0xd2, 0xb6,
-	0x7d, 0x23, 0xd1, 0x6d, 0x3d, 0x85, 0x6a, 0x66, 0x2c, 0xfa, 0x2e, 0xc0, 0x98, 0x9e, 0xbb, 0xba,
-	0x0b, 0x28, 0x45, 0x75, 0x6c, 0x8f, 0xe9, 0xf9, 0xb1, 0x22, 0xc8, 0x91, 0x49, 0xb6, 0x9d, 0x40,
-	0xde, 0x3a, 0x22, 0x93, 0x2a, 0x28, 0xa9, 0xc6, 0x94, 0xa1, 0x85, 0x5b, 0x67, 0x00, 0xd3, 0xaf,
-	0xa0, 0x0d, 0x28, 0xcb, 0xfb, 0x46, 0x18, 0xeb, 0x40, 0xa5, 0xb5, 0xbc, 0x89, 0x04, 0xd6, 0x0c,
-	0xf4, 0x0b, 0xa8, 0xc5, 0x2c, 0x0c, 0x5d, 0x4e, 0x45, 0x1a, 0x26, 0x4a, 0xed, 0xca, 0xeb, 0xe3,
-	0x73, 0xcc, 0xc2, 0x10, 0x2b, 0x69, 0x0c, 0x71, 0xbe, 0x6e, 0x3f, 0x02, 0x98, 0x72, 0x50, 0x0d,
-	0x2a, 0xbd, 0x47, 0x4f, 0x77, 0x0e, 0x7a, 0xbb, 0x8d, 0x1b, 0xc8, 0x86, 0x32, 0xde, 0xdb, 0xd9,
-	0xfd, 0x75, 0xc3, 0x42, 0xcb, 0x60, 0x3f, 0x3a, 0x7a, 0xec, 0xea, 0x6d, 0x01, 0xd5, 0xa1, 0xda,
-	0x3d, 0x3a, 0x3a, 0x70, 0x8f, 0xf6, 0xf7, 0x1b, 0x45, 0x09, 0xc2, 0x7b, 0xfd, 0xc7, 0x3b, 0xf8,
-	0x71, 0xa3, 0xd4, 0xfe, 0x87, 0x05, 0x8d, 0x5d, 0x79, 0x65, 0x7f, 0x1b, 0x4a, 0x75, 0x1b, 0x4a,
-	0x32, 0x21, 0x4d, 0x0a, 0xae, 0xe5, 0xe0, 0x79, 0x03, 0x55, 0xfa, 0x62, 0x25, 0xdb, 0xba, 0x0b,
-	0x25, 0xb9, 0x43, 0x1f, 0xc0, 0x8a, 0xf8, 0x3c, 0x94, 0xd7, 0xe8, 0xe9, 0x40, 0xb8, 0x29, 0x0f,
-	0x4c, 0x97, 0xad, 0x6b, 0xea, 0xd3, 0x81, 0x78, 0xc2, 0x83, 0xf6, 0x3f, 0x8b, 0xb0, 0x9a, 0x69,
-	0xfb, 0x3a, 0xc5, 0xf6, 0xd3, 0xb9, 0x62, 0x7b, 0x6f, 0xc1, 0xd6, 0x2b, 0x6b, 0xad, 0x03, 0x76,
-	0x9c, 0x9e, 0x84, 0x81, 0x18, 0xbd, 0xa2, 0xd8, 0x16, 0xd1, 0xc7, 0x99, 0x2c, 0x9e, 0xc2, 0xd0,
-	0xcf, 0xa0, 0x32, 0x08, 0x53, 0xa5, 0xa1, 0x34, 0x57, 0xec, 0x8b, 0x1a, 0xf6, 0xb5, 0x24, 0xce,
-	0x20, 0xdf, 0x74, 0x8d, 0x25, 0x60, 0xe7, 0x46, 0xca, 0x07, 0xcb, 0xc4, 0x7b, 0xee, 0xfa, 0x21,
-	0xf3, 0xc7, 0xe6, 0xee, 0xac, 0x4e, 0xbc, 0xe7, 0x5d, 0xb9, 0x9f, 0xab, 0xc0, 0xc2, 0x1b, 0x55,
-	0x60, 0xf1, 0x8a, 0x0a, 0xbc, 0x03, 0x15, 0xe3, 0xd8, 0xff, 0x2e, 0xbf, 0xf6, 0xef, 0x2c, 0xf8,
-	0xce, 0x74, 0xda, 0xfc, 0x16, 0xa4, 0x7a, 0xfb, 0x4b, 0x0b, 0xde, 0xba, 0x64, 0xd1, 0xd7, 0xc9,
-	0xc6, 0x9d, 0x69, 0x3a, 0x68, 0x63, 0xa6, 0xf7, 0xff, 0xab, 0xbf, 0xb1, 0x98, 0x13, 0xff, 0x57,
-	0x38, 0xbf, 0x2c, 0xc1, 0x4a, 0x97, 0x4d, 0x4e, 0x82, 0x28, 0x7f, 0x0f, 0xde, 0x33, 0xa5, 0xab,
-	0x31, 0xb7, 0x67, 0xec, 0x9d, 0x15, 0x9b, 0x29, 0x5c, 0xf4, 0x11, 0x14, 0x3d, 0x92, 0x19, 0xfc,
-	0xee, 0x55, 0x80, 0x1d, 0x42, 0xb0, 0x94, 0x6b, 0xfd, 0xa5, 0x60, 0x0a, 0xfd, 0x3e, 0x54, 0x4f,
-	0x82, 0x88, 0x04, 0xd1, 0x50, 0x5a, 0x58, 0xbc, 0x7c, 0x57, 0x2d, 0x7e, 0xcd, 0xe9, 0x68, 0x61,
-	0x9c, 0xa3, 0x5a, 0xbf, 0x2d, 0x40, 0xc5, 0x50, 0x11, 0x82, 0xd2, 0x20, 0x0d, 0xf5, 0xd1, 0x57,
-	0xb1, 0x5a, 0x67, 0xb3, 0x8e, 0x1c, 0xc3, 0x6c, 0x3d, 0xeb, 0x7c, 0x02, 0xb5, 0x98, 0xb3, 0x67,
-	0xfa, 0x9d, 0x93, 0x0d, 0x59, 0x0d, 0x3d, 0xa0, 0x1d, 0xe7, 0x0c, 0x33, 0x67, 0xce, 0x8a, 0xa2,
-	0x9f, 0x43, 0x4d, 0xf8, 0x23, 0x3a, 0xf1, 0xdc, 0x67, 0x82, 0x45, 0xfa, 0x09, 0xde, 0xb9, 0xfd,
-	0xf2, 0x62, 0xbd, 0x49, 0x23, 0x9f, 0x49, 0x13, 0xb6, 0x24, 0xc3, 0xc1, 0xde, 0xd9, 0x21, 0x15,
-	0xc2, 0x1b, 0x52, 0x0c, 0x1a, 0xf0, 0x50, 0xb0, 0x08, 0x39, 0x00, 0x82, 0x72, 0x37, 0x66, 0x61,
-	0xe0, 0x9f, 0xab, 0xb7, 0x41, 0x3e, 0x10, 0xf7, 0x29, 0x3f, 0x56, 0x64, 0x6c, 0x8b, 0x6c, 0xa9,
-	0x7e, 0x12, 0x50, 0x03, 0x74, 0xc2, 0xd5, 0xfc, 0x6f, 0xe3, 0x8a, 0x9a, 0x93, 0x13, 0x2e, 0x9f,
-	0xd9, 0x6a, 0x44, 0xd3, 0xe3, 0xbc, 0x8d, 0xcd, 0xae, 0x15, 0x41, 0x71, 0x87, 0x10, 0xd4, 0x84,
-	0x8a, 0x09, 0x90, 0x19, 0xf2, 0xb2, 0x2d, 0xfa, 0x09, 0x54, 0x09, 0xf3, 0xb5, 0xfd, 0x85, 0x37,
-	0xb0, 0xbf, 0x42, 0x98, 0xaf, 0x8c, 0xbf, 0x05, 0xe5, 0x01, 0x67, 0x91, 0x1e, 0xb9, 0xaa, 0x58,
-	0x6f, 0xda, 0x7f, 0xb5, 0xe0, 0x66, 0x7e, 0x4e, 0xe6, 0x41, 0x77, 0xf5, 0xc7, 0x9b, 0x50, 0x21,
-	0x34, 0xa4, 0x89, 0x49, 0xed, 0x2a, 0xce, 0xb6, 0x97, 0xcc, 0x2a, 0x5e, 0xcb, 0xac, 0xd2, 0x8c,
-	0x59, 0x73, 0xbd, 0xa9, 0x3c, 0xdf, 0x9b, 0xde, 0x87, 0x65, 0x1d, 0xaf, 0x4c, 0x42, 0xbd, 0xae,
-	0x70, 0x5d, 0x13, 0xb5, 0xd0, 0xf6, 0x43, 0xa8, 0x9a, 0xa7, 0x2a, 0x47, 0x9f, 0x41, 0xc5, 0xac,
-	0xd1, 0xdb, 0x79, 0x7e, 0x5e, 0xfe, 0x11, 0xa5, 0xd5, 0x5c, 0x64, 0xe8, 0x80, 0xdc, 0xb3, 0xb6,
-	0x0f, 0xa0, 0x6a, 0xa2, 0xc4, 0xd1, 0x7d, 0xa8, 0x98, 0xf5, 0x8c, 0xae, 0xcb, 0xb9, 0x3e, 0xa3,
-	0x6b, 0x2e, 0xb8, 0x9b, 0xd6, 0x3d, 0xab, 0xf3, 0xd9, 0x8b, 0xbf, 0xad, 0xdd, 0x78, 0xf1, 0xd5,
-	0x9a, 0xf5, 0xa7, 0xaf, 0xd6, 0xac, 0xdf, 0xff, 0x7d, 0xcd, 0xfa, 0xcd, 0xdd, 0x37, 0x7a, 0x13,
-	0x1a, 0x9d, 0x27, 0x4b, 0x8a, 0xf4, 0xf1, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xd6, 0x10,
-	0xa2, 0x85, 0x13, 0x00, 0x00,
+	// 2098 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x73, 0x1b, 0xb7,
+	0x15, 0xf7, 0xf2, 0x8f, 0xc8, 0x7d, 0x94, 0x64, 0x0a, 0xe3, 0x26, 0x1b, 0xc6, 0x95, 0x14, 0x26,
+	0x6e, 0xd5, 0xda, 0xa1, 0x5c, 0xa5, 0x7f, 0xd2, 0x4c, 0x9b, 0xb1, 0x44, 0x49, 0xb1, 0x1c, 0xc9,
+	0x92, 0x21, 0xdb, 0x33, 0xed, 0x65, 0x07, 0xda, 0x05, 0xc9, 0xb5, 0x96, 0x8b, 0x35, 0x80, 0x95,
+	0xac, 0x7c, 0x85, 0x1e, 0xda, 0x4b, 0x0f, 0xbd, 0xf5, 0xdc, 0xe9, 0x17, 0x68, 0x3f, 0x81, 0x4f,
+	0x9d, 0x4e, 0x0f, 0x9d, 0x9e, 0x34, 0xd3, 0xf4, 0x33, 0xf4, 0x50, 0x9f, 0x3a, 0xf8, 0xb3, 0x4b,
+	0x4a, 0x94, 0x6c, 0xc7, 0xc9, 0x21, 0x07, 0x89, 0xc0, 0xc3, 0xef, 0x3d, 0xe0, 0x3d, 0xbc, 0xf7,
+	0x03, 0xb0, 0xd0, 0xee, 0xb3, 0xe5, 0x94, 0x33, 0xc9, 0x02, 0x16, 0x8b, 0x65, 0x9e, 0x25, 0x32,
+	0x1a, 0xd2, 0xfc, 0xb7, 0xa3, 0x47, 0x50, 0xcd, 0x76, 0x5b, 0xf3, 0x07, 0x9c, 0x1d, 0x52, 0x5e,
+	0x28, 0x14, 0x0d, 0x03, 0x6c, 0x2d, 0x06, 0x2c, 0x11, 0xd9, 0xf0, 0x25, 0x88, 0xeb, 0x67, 0xa6,
+	0xeb, 0xc5, 0xec, 0x58, 0xff, 0xb3, 0xa3, 0xad, 0x33, 0xa3, 0x2c, 0xd5, 0x7f, 0x76, 0xec, 0x5a,
+	0x9f, 0xf5, 0x99, 0x6e, 0x2e, 0xab, 0x96, 0x91, 0xb6, 0xff, 0xe2, 0xc0, 0xdc, 0x43, 0x22, 0x0e,
+	0xf7, 0x29, 0x3f, 0x8a, 0x02, 0xda, 0x65, 0x49, 0x2f, 0xea, 0xa3, 0x79, 0x68, 0xc4, 0xac, 0xef,
+	0xf7, 0xa2, 0x98, 0xfa, 0xbd, 0xd0, 0x73, 0x16, 0x9d, 0xa5, 0x2a, 0x76, 0x63, 0xd6, 0xdf, 0x8c,
+	0x62, 0xba, 0x19, 0xa2, 0x77, 0xc1, 0x95, 0x44, 0x1c, 0xfa, 0x09, 0x19, 0x52, 0xaf, 0xb4, 0xe8,
+	0x2c, 0xb9, 0xb8, 0xae, 0x04, 0xf7, 0xc9, 0x90, 0xa2, 0x77, 0xa0, 0x9e, 0x85, 0xc2, 0x4f, 0x89,
+	0x1c, 0x78, 0x65, 0x3d, 0x56, 0xcb, 0x42, 0xb1, 0x47, 0xe4, 0x00, 0xdd, 0x84, 0xb9, 0x80, 0x25,
+	0x92, 0x44, 0x09, 0xe5, 0x7e, 0x42, 0xe5, 0x31, 0xe3, 0x87, 0x5e, 0x45, 0x63, 0x9a, 0xc5, 0xc0,
+	0x7d, 0x23, 0x47, 0x0b, 0xd0, 0x20, 0x71, 0xcc, 0x8e, 0xfd, 0x98, 0x05, 0x24, 0xf6, 0xaa, 0x8b,
+	0xce, 0x52, 0x1d, 0x83, 0x16, 0x6d, 0x2b, 0x49, 0xfb, 0x7f, 0x15, 0x98, 0xdd, 0x1f, 0x64, 0xbd,
+	0x5e, 0x4c, 0x31, 0x7d, 0x9a, 0x51, 0x21, 0xd1, 0x16, 0xd4, 0x9e, 0xb0, 0x8c, 0x27, 0x24, 0xd6,
+	0x8b, 0x76, 0xd7, 0x96, 0x5f, 0x9c, 0x2e, 0xdc, 0xec, 0xb3, 0x4e, 0x9f, 0x7c, 0x41, 0xa5, 0xa4,
+	0x9d, 0x90, 0x1e, 0x2d, 0x07, 0x8c, 0xd3, 0xe5, 0x73, 0x3b, 0xd1, 0xb9, 0x67, 0xd4, 0x70, 0xae,
+	0x8f, 0xde, 0x82, 0x29, 0x4e, 0xd3, 0x98, 0x9c, 0x68, 0x07, 0xeb, 0xd8, 0xf6, 0x94, 0x7b, 0x07,
+	0x59, 0x14, 0x87, 0x7e, 0x14, 0xe6, 0xee, 0xe9, 0xfe, 0x56, 0x88, 0x36, 0x61, 0x8a, 0xf5, 0x7a,
+	0x82, 0x4a, 0xed, 0x53, 0x79, 0xad, 0xf3, 0xe2, 0x74, 0xe1, 0x87, 0xaf, 0x33, 0xf9, 0xae, 0xd6,
+	0xc2, 0x56, 0x1b, 0xed, 0x00, 0xd0, 0x24, 0xf4, 0xad, 0xad, 0xea, 0x1b, 0xd9, 0x72, 0x69, 0x12,
+	0x9a, 0x26, 0xba, 0x09, 0x55, 0x4e, 0x92, 0x3e, 0xf5, 0xa6, 0x16, 0x9d, 0xa5, 0xc6, 0xca, 0xd5,
+	0x8e, 0xce, 0x18, 0xac, 0x44, 0xfb, 0x29, 0x0d, 0xd6, 0x2a, 0xcf, 0x4f, 0x17, 0xae, 0x60, 0x83,
+	0x41, 0xfb, 0xd0, 0x08, 0x18, 0xe3, 0x61, 0x94, 0x10, 0xc9, 0xb8, 0x57, 0xd3, 0x51, 0xfc, 0xd1,
+	0x8b, 0xd3, 0x85, 0x0f, 0x2f, 0x9a, 0x7c, 0x22, 0x5f, 0x3b, 0xfb, 0x03, 0xc2, 0xc3, 0xad, 0x75,
+	0x3c, 0x6e, 0x05, 0xdd, 0x06, 0xe0, 0x54, 0xb0, 0x38, 0x93, 0x11, 0x4b, 0xbc, 0xba, 0x5e, 0x46,
+	0xb3, 0x53, 0xe8, 0xdc, 0xa5, 0x24, 0xa4, 0x1c, 0x8f, 0x61, 0xd0, 0xfb, 0x30, 0x23, 0xcc, 0xd6,
+	0xfa, 0x51, 0x12, 0xd2, 0x67, 0x9e, 0xbb, 0xe8, 0x2c, 0xcd, 0xe0, 0x69, 0x2b, 0xdc, 0x52, 0x32,
+	0xf4, 0x63, 0x80, 0x90, 0xf2, 0xe8, 0x88, 0x68, 0xb3, 0xa0, 0xcd, 0x5e, 0x33, 0xde, 0x75, 0x59,
+	0x1c, 0xd3, 0x40, 0xc9, 0x95, 0x8b, 0x78, 0x0c, 0x87, 0xba, 0x70, 0x75, 0x48, 0x24, 0xe5, 0x11,
+	0x89, 0xa3, 0x2f, 0x8c, 0x6a, 0x43, 0xab, 0xbe, 0x63, 0x54, 0x77, 0xce, 0x0e, 0x6a, 0xfd, 0xf3,
+	0x1a, 0xed, 0x7f, 0x54, 0xe0, 0x6a, 0x91, 0x7b, 0x22, 0x65, 0x89, 0xa0, 0x68, 0x09, 0xa6, 0x84,
+	0x24, 0x32, 0x13, 0x3a, 0xf7, 0x66, 0x57, 0x9a, 0x9d, 0x3c, 0x3c, 0x9d, 0x7d, 0x2d, 0xc7, 0x76,
+	0x5c, 0x21, 0x07, 0xda, 0x67, 0xaf, 0x74, 0x49, 0x2c, 0xec, 0x38, 0xba, 0x01, 0xb3, 0x92, 0xf2,
+	0x61, 0x94, 0x90, 0xd8, 0xa7, 0x9c, 0x33, 0x6e, 0x73, 0x6e, 0x26, 0x97, 0x6e, 0x28, 0x21, 0x7a,
+	0x00, 0xd3, 0x9c, 0x92, 0xd0, 0x97, 0x03, 0xce, 0xb2, 0xfe, 0xe0, 0x0d, 0xf3, 0xaf, 0xa1, 0x6c,
+	0x3c, 0x34, 0x26, 0x54, 0x12, 0x1e, 0xf3, 0x48, 0x52, 0x5f, 0xad, 0xe4, 0x4d, 0x93, 0x50, 0x5b,
+	0x50, 0x2e, 0xa1, 0x2d, 0xa8, 0x12, 0x4e, 0x13, 0xa2, 0x93, 0x70, 0x7a, 0xed, 0xa3, 0x17, 0xa7,
+	0x0b, 0xcb, 0xfd, 0x48, 0x0e, 0xb2, 0x83, 0x4e, 0xc0, 0x86, 0xcb, 0x54, 0xc8, 0x8c, 0xf0, 0x13,
+	0xc3, 0x68, 0x13, 0x1c, 0xd7, 0x59, 0x55, 0xaa, 0xd8, 0x58, 0x40, 0x37, 0xa0, 0x12, 0xb2, 0x40,
+	0x78, 0xb5, 0xc5, 0xf2, 0x52, 0x63, 0xa5, 0x61, 0x76, 0x6d, 0x3f, 0x8e, 0x02, 0x6a, 0x53, 0x59,
+	0x0f, 0xa3, 0xbb, 0x50, 0x33, 0x15, 0x24, 0xbc, 0xfa, 0x62, 0xf9, 0x0d, 0x56, 0x9f, 0xab, 0xab,
+	0x3c, 0xcb, 0xb2, 0x28, 0xf4, 0x53, 0xc2, 0xa5, 0xf0, 0xdc, 0xc5, 0xf2, 0xa8, 0x8a, 0x1e, 0x3d,
+	0xda, 0x5a, 0xdf, 0x53, 0x62, 0x3b, 0xb5, 0xab, 0x80, 0x5a, 0xa0, 0x92, 0x3e, 0x25, 0xc1, 0x21,
+	0x0d, 0xfd, 0x43, 0x7a, 0xe2, 0xc1, 0x65, 0x8b, 0x75, 0x0d, 0xe8, 0x73, 0x7a, 0xd2, 0x0e, 0x61,
+	0x0e, 0xb3, 0xe0, 0x50, 0xac, 0xaf, 0xad, 0x53, 0x11, 0xf0, 0x28, 0x55, 0xb5, 0x73, 0x0b, 0x10,
+	0x57, 0xc2, 0xf0, 0xc0, 0xa7, 0xc9, 0x91, 0x3f, 0xa4, 0xc3, 0x54, 0x72, 0x9d, 0x61, 0x53, 0xb8,
+	0x69, 0x47, 0x36, 0x92, 0xa3, 0x1d, 0x2d, 0x47, 0xef, 0xc1, 0x74, 0x8e, 0xd6, 0x04, 0x6c, 0xc8,
+	0xb9, 0x61, 0x65, 0x8a, 0x84, 0xdb, 0xff, 0x75, 0xc0, 0xed, 0xe6, 0x64, 0x8b, 0xde, 0x86, 0x5a,
+	0x94, 0xfa, 0x24, 0x0c, 0x8d, 0x4d, 0x17, 0x4f, 0x45, 0xe9, 0x6a, 0x18, 0x72, 0xf4, 0x53, 0x98,
+	0xb1, 0x0c, 0xed, 0xa7, 0x4c, 0xf9, 0x5d, 0xd2, 0x1e, 0xcc, 0x19, 0x0f, 0x2c, 0x49, 0xef, 0x31,
+	0x2e, 0xf1, 0x74, 0x32, 0xea, 0x08, 0xb4, 0x0f, 0x73, 0x43, 0x92, 0xa6, 0x34, 0xf4, 0x07, 0x4c,
+	0x48, 0xab, 0x5b, 0xd6, 0xba, 0xdf, 0xef, 0xe4, 0xe7, 0x62, 0x31, 0x7f, 0x67, 0x47, 0x63, 0xef,
+	0x32, 0x21, 0xb5, 0xfa, 0x46, 0x22, 0xf9, 0x89, 0x2a, 0xb7, 0x33, 0xd2, 0xd6, 0x1a, 0x5c, 0xbb,
+	0x08, 0x88, 0x9a, 0x50, 0x56, 0xc1, 0x75, 0x34, 0x39, 0xa8, 0x26, 0xba, 0x06, 0xd5, 0x23, 0x12,
+	0x67, 0xf9, 0xb1, 0x64, 0x3a, 0x9f, 0x94, 0x3e, 0x76, 0xda, 0x7f, 0x2a, 0xc1, 0x5c, 0x97, 0xa4,
+	0x32, 0xe3, 0xf9, 0x71, 0xb1, 0xf1, 0x4c, 0x91, 0xa3, 0x3a, 0xd7, 0xfc, 0x98, 0x1e, 0xd1, 0xd8,
+	0xd6, 0xed, 0x6c, 0x47, 0x9d, 0x9a, 0xdb, 0xac, 0xdf, 0xd9, 0x56, 0x52, 0x5c, 0x8f, 0x59, 0x5f,
+	0xb7, 0xd0, 0xd6, 0x68, 0x2f, 0xc2, 0x62, 0x87, 0x6c, 0x0d, 0xb7, 0x0a, 0xe7, 0x26, 0xf6, 0x10,
+	0xcf, 0x59, 0xad, 0xb1, 0x6d, 0xdd, 0x82, 0x69, 0x21, 0x09, 0x97, 0x7e, 0xc0, 0x86, 0xc3, 0x48,
+	0xea, 0xb2, 0x6e, 0xac, 0x7c, 0x6f, 0x14, 0xa1, 0xf3, 0x2b, 0x55, 0x1c, 0xc2, 0x65, 0x57, 0xa3,
+	0x71, 0x43, 0x8c, 0x3a, 0x2d, 0x0c, 0x8d, 0xb1, 0x31, 0xd4, 0x05, 0x64, 0x8d, 0xf8, 0xc1, 0x80,
+	0x06, 0x87, 0x29, 0x8b, 0x12, 0xe9, 0x39, 0x96, 0x1d, 0x0b, 0x4a, 0xea, 0x16, 0x63, 0x78, 0xce,
+	0xe2, 0x47, 0xa2, 0xf6, 0xdf, 0xaa, 0x80, 0x8a, 0x25, 0x18, 0x7e, 0x53, 0xd1, 0xba, 0x0d, 0x6e,
+	0x71, 0x4e, 0x5b, 0x93, 0x68, 0x72, 0x53, 0xf1, 0x08, 0x84, 0x3e, 0x81, 0x29, 0x96, 0xd2, 0x84,
+	0x86, 0x36, 0x4c, 0xed, 0x49, 0x0f, 0x0b, 0xf3, 0x9d, 0x5d, 0x8d, 0xc4, 0x56, 0x03, 0xdd, 0x81,
+	0x7a, 0x60, 0x40, 0xa1, 0x8d, 0xcf, 0x07, 0x2f, 0xd3, 0xb6, 0xa2, 0x10, 0x17, 0x5a, 0x68, 0x13,
+	0x60, 0x2c, 0x06, 0x95, 0xcb, 0x62, 0x3c, 0x66, 0x63, 0x14, 0x95, 0x31, 0x4d, 0xd4, 0x05, 0xf7,
+	0x90, 0xd2, 0x94, 0xc4, 0xd1, 0x11, 0xd5, 0x5c, 0xd8, 0x58, 0xb9, 0xf1, 0x32, 0x33, 0x9f, 0xe7,
+	0x60, 0x3c, 0xd2, 0x6b, 0xed, 0xc0, 0x94, 0x71, 0xf0, 0x1b, 0xd9, 0xa2, 0xd6, 0x63, 0xa8, 0xe7,
+	0x1e, 0xa3, 0xef, 0x02, 0x1c, 0xd2, 0x13, 0xdf, 0x50, 0x89, 0x36, 0x34, 0xad, 0x66, 0x3e, 0xd9,
+	0xd3, 0x02, 0x75, 0xef, 0x52, 0xdc, 0x15, 0xa9, 0xa3, 0x4b, 0xe4, 0xa8, 0x92, 0x46, 0x35, 0x47,
+	0x03, 0x06, 0xdc, 0x3a, 0x06, 0x18, 0xcd, 0x82, 0x16, 0xa1, 0xaa, 0x0e, 0x2d, 0x61, 0x57, 0x07,
+	0xba, 0x36, 0xd4, 0x71, 0x26, 0xb0, 0x19, 0x40, 0x9f, 0x41, 0x23, 0x65, 0x71, 0xec, 0x73, 0x2a,
+	0xb2, 0x58, 0x6a, 0xb3, 0xb3, 0x2f, 0x0f, 0xf2, 0x1e, 0x8b, 0x63, 0xac, 0xd1, 0x18, 0xd2, 0xa2,
+	0xdd, 0x6a, 0x80, 0x5b, 0xc4, 0xad, 0x7d, 0x1f, 0x60, 0x04, 0x43, 0x0d, 0xa8, 0x6d, 0xdd, 0x7f,
+	0xbc, 0xba, 0xbd, 0xb5, 0xde, 0xbc, 0x82, 0x5c, 0xa8, 0xe2, 0x8d, 0xd5, 0xf5, 0x5f, 0x35, 0x1d,
+	0x34, 0x03, 0xee, 0xfd, 0xdd, 0x87, 0xbe, 0xe9, 0x96, 0xd0, 0x34, 0xd4, 0xbb, 0xbb, 0xbb, 0xdb,
+	0xfe, 0xee, 0xe6, 0x66, 0xb3, 0xac, 0x94, 0xf0, 0xc6, 0xfe, 0xc3, 0x55, 0xfc, 0xb0, 0x59, 0x69,
+	0xff, 0xa1, 0x0c, 0xcd, 0x75, 0x75, 0x09, 0xf8, 0x36, 0x14, 0xff, 0x0a, 0x54, 0x54, 0x8a, 0xdb,
+	0xa4, 0x9e, 0x2f, 0x94, 0xcf, 0x2f, 0x50, 0x17, 0x04, 0xd6, 0x58, 0xf4, 0x13, 0xa8, 0x3e, 0xcd,
+	0x28, 0x3f, 0xb1, 0x59, 0xbc, 0x70, 0xb9, 0xd2, 0x03, 0x05, 0xc3, 0x06, 0xdd, 0xba, 0x05, 0x15,
+	0x65, 0x04, 0x7d, 0x00, 0xb3, 0xe2, 0x69, 0xac, 0xce, 0xf3, 0xa3, 0x9e, 0xf0, 0x33, 0x1e, 0x59,
+	0xba, 0x9f, 0x36, 0xd2, 0xc7, 0x3d, 0xf1, 0x88, 0x47, 0x2d, 0x09, 0x55, 0xad, 0xad, 0x88, 0x55,
+	0x3c, 0xb5, 0x97, 0x68, 0xac, 0x9a, 0xea, 0x3a, 0x9e, 0x12, 0x4e, 0x86, 0xc2, 0x7f, 0x22, 0x58,
+	0x62, 0xe9, 0x15, 0x8c, 0xe8, 0x9e, 0x60, 0x89, 0xca, 0x41, 0xb5, 0x1e, 0x96, 0x49, 0x7f, 0x28,
+	0xb4, 0x6b, 0x33, 0xd8, 0xb5, 0x92, 0x1d, 0xa1, 0xee, 0xcd, 0x43, 0xf2, 0xcc, 0xe7, 0xec, 0x58,
+	0x68, 0x17, 0x66, 0x70, 0x6d, 0x48, 0x9e, 0x61, 0x76, 0x2c, 0xda, 0x7f, 0xae, 0xc2, 0x5c, 0xee,
+	0xc5, 0xd7, 0xe1, 0x9a, 0x9f, 0x9f, 0xe3, 0x9a, 0xf7, 0x26, 0x62, 0x74, 0x29, 0xd5, 0xac, 0x81,
+	0x9b, 0x66, 0x07, 0x71, 0x24, 0x06, 0x17, 0x70, 0xcd, 0xa4, 0xf6, 0x5e, 0x8e, 0xc5, 0x23, 0x35,
+	0xf4, 0x0b, 0xa8, 0xf5, 0xe2, 0x4c, 0x5b, 0xa8, 0x9c, 0xe3, 0xba, 0x49, 0x0b, 0x9b, 0x06, 0x89,
+	0x73, 0x15, 0xa5, 0xad, 0x76, 0x2c, 0xa2, 0xa1, 0x57, 0x7d, 0xa5, 0xf6, 0x03, 0x83, 0xc4, 0xb9,
+	0xca, 0x37, 0xcd, 0x2d, 0xbf, 0x77, 0xc0, 0x2d, 0x7c, 0x54, 0xcf, 0x3d, 0xb5, 0x75, 0x41, 0xcc,
+	0x82, 0x43, 0x7b, 0xf3, 0x50, 0x7b, 0xd9, 0x55, 0xfd, 0x73, 0xd4, 0x53, 0x7a, 0x2d, 0xea, 0x29,
+	0x5f, 0x4c, 0x3d, 0x2a, 0xc7, 0x42, 0x75, 0x8d, 0x8d, 0xa9, 0x94, 0x94, 0xdb, 0x97, 0x21, 0x28,
+	0xd1, 0xb6, 0x96, 0xb4, 0x6e, 0x42, 0xcd, 0x06, 0xee, 0xd5, 0xc4, 0xd4, 0x7a, 0x0c, 0x35, 0x1b,
+	0x27, 0xe4, 0x41, 0x2d, 0x60, 0x71, 0x36, 0x4c, 0x14, 0xbc, 0xac, 0xde, 0x6c, 0xb6, 0xab, 0x7c,
+	0x53, 0x29, 0x39, 0x9e, 0xd4, 0x75, 0x25, 0xd0, 0x29, 0x7d, 0x0d, 0xaa, 0xe3, 0x97, 0x6e, 0xd3,
+	0x69, 0xff, 0xd6, 0x81, 0xef, 0x8c, 0x1e, 0x09, 0xdf, 0x02, 0x3e, 0x69, 0xff, 0xae, 0x04, 0x6f,
+	0x9d, 0x59, 0xd1, 0xd7, 0xa9, 0xa2, 0xd5, 0x51, 0x1a, 0x9b, 0xc5, 0x8c, 0xae, 0x6d, 0x17, 0xcf,
+	0x31, 0x99, 0xcb, 0x9f, 0x8d, 0x1f, 0x97, 0xa6, 0x9a, 0x7e, 0xf0, 0x2a, 0x23, 0x17, 0x1e, 0x99,
+	0x5f, 0x69, 0xbf, 0xcf, 0x9c, 0x1f, 0x7f, 0xad, 0xc0, 0x6c, 0x97, 0x0d, 0x0f, 0xa2, 0xa4, 0xf8,
+	0x38, 0x70, 0xdb, 0xb2, 0xae, 0x31, 0x70, 0x7d, 0x2c, 0x0a, 0xe3, 0xb0, 0x71, 0xce, 0xfd, 0x10,
+	0xca, 0x24, 0xcc, 0xc3, 0xf0, 0xee, 0x65, 0x0a, 0xab, 0x61, 0x88, 0x15, 0xae, 0xf5, 0xcf, 0x92,
+	0x25, 0xdb, 0x3b, 0x50, 0x3f, 0x88, 0x92, 0x30, 0x4a, 0xfa, 0x26, 0xdf, 0xce, 0x5c, 0x5c, 0x26,
+	0x67, 0xeb, 0xac, 0x19, 0x30, 0x2e, 0xb4, 0x5a, 0xbf, 0x29, 0x41, 0xcd, 0x4a, 0x11, 0x82, 0x4a,
+	0x2f, 0x8b, 0x4d, 0x42, 0xd5, 0xb1, 0x6e, 0xe7, 0x17, 0xdf, 0x92, 0x4e, 0x66, 0xd5, 0x44, 0x1f,
+	0x43, 0x23, 0xe5, 0xec, 0x89, 0x79, 0xf4, 0xe6, 0x37, 0xee, 0xa6, 0xb9, 0xad, 0xef, 0x15, 0x03,
+	0xf6, 0xd1, 0x31, 0x0e, 0x45, 0xbf, 0x84, 0x86, 0x08, 0x06, 0x74, 0x48, 0x4c, 0x11, 0xe8, 0xaa,
+	0x5b, 0xbb, 0xfe, 0xe2, 0x74, 0xc1, 0xa3, 0x49, 0xc0, 0xd4, 0x12, 0x96, 0xd5, 0x40, 0x07, 0x93,
+	0xe3, 0x1d, 0x2a, 0x04, 0xe9, 0x53, 0x0c, 0x46, 0x41, 0x17, 0x49, 0x07, 0x40, 0x50, 0xee, 0xa7,
+	0x2c, 0x8e, 0x82, 0x13, 0xcb, 0x5d, 0xf6, 0x75, 0xb4, 0x4f, 0xf9, 0x9e, 0x16, 0x63, 0x57, 0xe4,
+	0x4d, 0xfd, 0x7d, 0x48, 0xbf, 0xa6, 0x24, 0xf7, 0xa6, 0xec, 0xf7, 0x21, 0xf5, 0x68, 0x92, 0x5c,
+	0x7d, 0x73, 0xd1, 0xf7, 0x75, 0xf3, 0xb6, 0x73, 0xb1, 0xed, 0xb5, 0x12, 0x28, 0xaf, 0x86, 0xba,
+	0x8a, 0x6d, 0x80, 0xec, 0x8d, 0x3f, 0xef, 0xa2, 0x9f, 0x41, 0x3d, 0x64, 0xc1, 0x58, 0x11, 0xbf,
+	0x62, 0xfd, 0xb5, 0x90, 0x05, 0x79, 0x85, 0xf7, 0x38, 0x4b, 0xcc, 0xfd, 0xbb, 0x8e, 0x4d, 0xa7,
+	0xfd, 0x2f, 0x07, 0xae, 0x16, 0xfb, 0x64, 0x5f, 0xf7, 0x97, 0x4f, 0xee, 0x41, 0x2d, 0xa4, 0x31,
+	0x95, 0xb6, 0x60, 0xea, 0x38, 0xef, 0x9e, 0x59, 0x56, 0xf9, 0x8d, 0x96, 0x55, 0x19, 0x5b, 0xd6,
+	0x39, 0xaa, 0xad, 0x9e, 0xa7, 0xda, 0xf7, 0x61, 0xc6, 0xc4, 0x2b, 0x47, 0xe8, 0xa7, 0x36, 0x9e,
+	0x36, 0x42, 0x03, 0x5a, 0xb9, 0x07, 0x75, 0xfb, 0xdd, 0x82, 0xa3, 0x4f, 0xa1, 0x66, 0xdb, 0xe8,
+	0xed, 0x22, 0x3f, 0xcf, 0x7e, 0x51, 0x6b, 0x79, 0x93, 0x03, 0x26, 0x20, 0xb7, 0x9d, 0x95, 0x6d,
+	0xa8, 0xdb, 0x28, 0x71, 0x74, 0x07, 0x6a, 0xb6, 0x3d, 0x66, 0xeb, 0x6c, 0xae, 0xb7, 0xbc, 0xc9,
+	0x01, 0x63, 0x6b, 0xc9, 0xb9, 0xed, 0xac, 0x7d, 0xfa, 0xfc, 0xdf, 0xf3, 0x57, 0x9e, 0x7f, 0x39,
+	0xef, 0xfc, 0xfd, 0xcb, 0x79, 0xe7, 0x8f, 0xff, 0x99, 0x77, 0x7e, 0x7d, 0xeb, 0xb5, 0x3e, 0x10,
+	0x58, 0x9b, 0x07, 0x53, 0x5a, 0xf4, 0xd1, 0xff, 0x07, 0x00, 0x4a, 0xe3, 0xd9, 0x1e, 0x92, 0x15,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2129,6 +2331,18 @@ func (m *CaptureResponseExt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Keepalive != nil {
+		{
+			size, err := m.Keepalive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRuntime(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Checkpoint != nil {
 		{
 			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
@@ -2304,6 +2518,33 @@ func (m *CaptureResponseExt_Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *CaptureResponseExt_Keepalive) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CaptureResponseExt_Keepalive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CaptureResponseExt_Keepalive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DeriveRequestExt) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2328,6 +2569,18 @@ func (m *DeriveRequestExt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRuntime(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Open != nil {
 		{
 			size, err := m.Open.MarshalToSizedBuffer(dAtA[:i])
@@ -2394,6 +2647,57 @@ func (m *DeriveRequestExt_Open) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeriveRequestExt_Query) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeriveRequestExt_Query) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeriveRequestExt_Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxRows != 0 {
+		i = encodeVarintRuntime(dAtA, i, uint64(m.MaxRows))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeoutMs != 0 {
+		i = encodeVarintRuntime(dAtA, i, uint64(m.TimeoutMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ParamsJson) > 0 {
+		i -= len(m.ParamsJson)
+		copy(dAtA[i:], m.ParamsJson)
+		i = encodeVarintRuntime(dAtA, i, uint64(len(m.ParamsJson)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sql) > 0 {
+		i -= len(m.Sql)
+		copy(dAtA[i:], m.Sql)
+		i = encodeVarintRuntime(dAtA, i, uint64(len(m.Sql)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DeriveResponseExt) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2418,6 +2722,18 @@ func (m *DeriveResponseExt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Queried != nil {
+		{
+			size, err := m.Queried.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRuntime(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Flushed != nil {
 		{
 			size, err := m.Flushed.MarshalToSizedBuffer(dAtA[:i])
@@ -2532,6 +2848,13 @@ func (m *DeriveResponseExt_Published) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DeadLetter) > 0 {
+		i -= len(m.DeadLetter)
+		copy(dAtA[i:], m.DeadLetter)
+		i = encodeVarintRuntime(dAtA, i, uint64(len(m.DeadLetter)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.PartitionsPacked) > 0 {
 		i -= len(m.PartitionsPacked)
 		copy(dAtA[i:], m.PartitionsPacked)
@@ -2594,6 +2917,56 @@ func (m *DeriveResponseExt_Flushed) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *DeriveResponseExt_Queried) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeriveResponseExt_Queried) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeriveResponseExt_Queried) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRuntime(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RowsJson) > 0 {
+		i -= len(m.RowsJson)
+		copy(dAtA[i:], m.RowsJson)
+		i = encodeVarintRuntime(dAtA, i, uint64(len(m.RowsJson)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Columns) > 0 {
+		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Columns[iNdEx])
+			copy(dAtA[i:], m.Columns[iNdEx])
+			i = encodeVarintRuntime(dAtA, i, uint64(len(m.Columns[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MaterializeRequestExt) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2662,6 +3035,18 @@ func (m *MaterializeResponseExt) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Keepalive != nil {
+		{
+			size, err := m.Keepalive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRuntime(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Flushed != nil {
 		{
 			size, err := m.Flushed.MarshalToSizedBuffer(dAtA[:i])
@@ -2728,7 +3113,7 @@ func (m *MaterializeResponseExt_Flushed) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *CombineRequest) Marshal() (dAtA []byte, err error) {
+func (m *MaterializeResponseExt_Keepalive) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2738,12 +3123,12 @@ func (m *CombineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CombineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MaterializeResponseExt_Keepalive) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CombineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MaterializeResponseExt_Keepalive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2752,17 +3137,44 @@ func (m *CombineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Add != nil {
-		{
-			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRuntime(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *CombineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CombineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CombineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Add != nil {
+		{
+			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRuntime(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.Open != nil {
 		{
@@ -3296,6 +3708,10 @@ func (m *CaptureResponseExt) ProtoSize() (n int) {
 		l = m.Checkpoint.ProtoSize()
 		n += 1 + l + sovRuntime(uint64(l))
 	}
+	if m.Keepalive != nil {
+		l = m.Keepalive.ProtoSize()
+		n += 1 + l + sovRuntime(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3357,6 +3773,18 @@ func (m *CaptureResponseExt_Checkpoint) ProtoSize() (n int) {
 	return n
 }
 
+func (m *CaptureResponseExt_Keepalive) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DeriveRequestExt) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3374,6 +3802,10 @@ func (m *DeriveRequestExt) ProtoSize() (n int) {
 		l = m.Open.ProtoSize()
 		n += 1 + l + sovRuntime(uint64(l))
 	}
+	if m.Query != nil {
+		l = m.Query.ProtoSize()
+		n += 1 + l + sovRuntime(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3396,6 +3828,32 @@ func (m *DeriveRequestExt_Open) ProtoSize() (n int) {
 	return n
 }
 
+func (m *DeriveRequestExt_Query) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sql)
+	if l > 0 {
+		n += 1 + l + sovRuntime(uint64(l))
+	}
+	l = len(m.ParamsJson)
+	if l > 0 {
+		n += 1 + l + sovRuntime(uint64(l))
+	}
+	if m.TimeoutMs != 0 {
+		n += 1 + sovRuntime(uint64(m.TimeoutMs))
+	}
+	if m.MaxRows != 0 {
+		n += 1 + sovRuntime(uint64(m.MaxRows))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DeriveResponseExt) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3418,6 +3876,10 @@ func (m *DeriveResponseExt) ProtoSize() (n int) {
 		l = m.Flushed.ProtoSize()
 		n += 1 + l + sovRuntime(uint64(l))
 	}
+	if m.Queried != nil {
+		l = m.Queried.ProtoSize()
+		n += 1 + l + sovRuntime(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3457,6 +3919,10 @@ func (m *DeriveResponseExt_Published) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRuntime(uint64(l))
 	}
+	l = len(m.DeadLetter)
+	if l > 0 {
+		n += 1 + l + sovRuntime(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3479,6 +3945,32 @@ func (m *DeriveResponseExt_Flushed) ProtoSize() (n int) {
 	return n
 }
 
+func (m *DeriveResponseExt_Queried) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovRuntime(uint64(l))
+		}
+	}
+	l = len(m.RowsJson)
+	if l > 0 {
+		n += 1 + l + sovRuntime(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRuntime(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *MaterializeRequestExt) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3512,6 +4004,10 @@ func (m *MaterializeResponseExt) ProtoSize() (n int) {
 		l = m.Flushed.ProtoSize()
 		n += 1 + l + sovRuntime(uint64(l))
 	}
+	if m.Keepalive != nil {
+		l = m.Keepalive.ProtoSize()
+		n += 1 + l + sovRuntime(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3534,6 +4030,18 @@ func (m *MaterializeResponseExt_Flushed) ProtoSize() (n int) {
 	return n
 }
 
+func (m *MaterializeResponseExt_Keepalive) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *CombineRequest) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -5342,6 +5850,42 @@ func (m *CaptureResponseExt) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keepalive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRuntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Keepalive == nil {
+				m.Keepalive = &CaptureResponseExt_Keepalive{}
+			}
+			if err := m.Keepalive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRuntime(dAtA[iNdEx:])
@@ -5676,6 +6220,57 @@ func (m *CaptureResponseExt_Checkpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CaptureResponseExt_Keepalive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRuntime
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Keepalive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Keepalive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRuntime(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DeriveRequestExt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5796,6 +6391,42 @@ func (m *DeriveRequestExt) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRuntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = &DeriveRequestExt_Query{}
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRuntime(dAtA[iNdEx:])
@@ -5901,7 +6532,7 @@ func (m *DeriveRequestExt_Open) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeriveResponseExt) Unmarshal(dAtA []byte) error {
+func (m *DeriveRequestExt_Query) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5924,17 +6555,17 @@ func (m *DeriveResponseExt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeriveResponseExt: wiretype end group for non-group")
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeriveResponseExt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sql", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRuntime
@@ -5944,33 +6575,29 @@ func (m *DeriveResponseExt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRuntime
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRuntime
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Container == nil {
-				m.Container = &Container{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sql = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Opened", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsJson", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRuntime
@@ -5980,33 +6607,29 @@ func (m *DeriveResponseExt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRuntime
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRuntime
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Opened == nil {
-				m.Opened = &DeriveResponseExt_Opened{}
-			}
-			if err := m.Opened.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ParamsJson = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Published", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutMs", wireType)
 			}
-			var msglen int
+			m.TimeoutMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRuntime
@@ -6016,13 +6639,174 @@ func (m *DeriveResponseExt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TimeoutMs |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRuntime
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRows", wireType)
+			}
+			m.MaxRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRuntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRows |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRuntime(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeriveResponseExt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRuntime
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeriveResponseExt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeriveResponseExt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRuntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opened", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRuntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Opened == nil {
+				m.Opened = &DeriveResponseExt_Opened{}
+			}
+			if err := m.Opened.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Published", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRuntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRuntime
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
@@ -6074,6 +6858,42 @@ func (m *DeriveResponseExt) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queried", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRuntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Queried == nil {
+				m.Queried = &DeriveResponseExt_Queried{}
+			}
+			if err := m.Queried.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRuntime(dAtA[iNdEx:])
@@ -6290,6 +7110,38 @@ func (m *DeriveResponseExt_Published) Unmarshal(dAtA []byte) error {
 				m.PartitionsPacked = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRuntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadLetter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRuntime(dAtA[iNdEx:])
@@ -6399,6 +7251,153 @@ func (m *DeriveResponseExt_Flushed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeriveResponseExt_Queried) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRuntime
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Queried: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Queried: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRuntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRuntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RowsJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRuntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRuntime(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MaterializeRequestExt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6606,6 +7605,42 @@ func (m *MaterializeResponseExt) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keepalive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRuntime
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Keepalive == nil {
+				m.Keepalive = &MaterializeResponseExt_Keepalive{}
+			}
+			if err := m.Keepalive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRuntime(dAtA[iNdEx:])
@@ -6715,6 +7750,57 @@ func (m *MaterializeResponseExt_Flushed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MaterializeResponseExt_Keepalive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRuntime
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Keepalive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Keepalive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRuntime(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRuntime
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CombineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
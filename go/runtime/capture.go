@@ -108,6 +108,10 @@ func (c *Capture) RestoreCheckpoint(shard consumer.Shard) (pf.Checkpoint, error)
 		checkpoint = c.legacyCheckpoint
 	}
 
+	if err := c.startRediscovers(); err != nil {
+		return pf.Checkpoint{}, fmt.Errorf("starting rediscovers: %w", err)
+	}
+
 	return checkpoint, nil
 }
 
@@ -278,6 +282,10 @@ func (c *Capture) ConsumeMessage(shard consumer.Shard, env message.Envelope, pub
 			var captured = response.Captured
 			var capturedExt = responseExt.Captured
 
+			// Captured documents are drained from the runtime's combiner,
+			// which holds them until the transaction's final checkpoint.
+			c.memory.Acquire(flow.MemoryCombine, int64(len(captured.DocJson)+len(capturedExt.KeyPacked)))
+
 			partitions, err := tuple.Unpack(capturedExt.PartitionsPacked)
 			if err != nil {
 				return fmt.Errorf("unpacking partitions: %w", err)
@@ -298,6 +306,7 @@ func (c *Capture) ConsumeMessage(shard consumer.Shard, env message.Envelope, pub
 			return fmt.Errorf("expected Captured or Checkpoint, but got %#v", response)
 		}
 	}
+	c.memory.ReleaseAll(flow.MemoryCombine)
 
 	if len(stats.Capture) == 0 {
 		// The connector may have only emitted an empty checkpoint.
@@ -25,6 +25,11 @@ type Derive struct {
 	*taskReader[*pf.CollectionSpec]
 	client pd.Connector_DeriveClient
 	sqlite *store_sqlite.Store
+	// queryLock is held by a transaction or query of SQLite state,
+	// and serializes queries with transactions.
+	queryLock chan struct{}
+	// deadLetters are lazily-loaded dead-letter collections of the current term.
+	deadLetters map[string]*pf.CollectionSpec
 }
 
 var _ Application = (*Derive)(nil)
@@ -68,6 +73,7 @@ func NewDeriveApp(host *FlowConsumer, shard consumer.Shard, recorder *recoverylo
 		taskReader: newTaskReader[*pf.CollectionSpec](base, shard),
 		client:     client,
 		sqlite:     sqlite,
+		queryLock:  make(chan struct{}, 1),
 	}, nil
 }
 
@@ -75,6 +81,7 @@ func (d *Derive) RestoreCheckpoint(shard consumer.Shard) (pf.Checkpoint, error)
 	if err := d.initTerm(shard); err != nil {
 		return pf.Checkpoint{}, err
 	}
+	d.deadLetters = make(map[string]*pf.CollectionSpec)
 
 	var requestExt = &pr.DeriveRequestExt{
 		LogLevel: d.term.labels.LogLevel,
@@ -113,6 +120,9 @@ func (d *Derive) ConsumeMessage(_ consumer.Shard, env message.Envelope, _ *messa
 	var keyPacked = isr.Arena.Bytes(isr.PackedKey[isr.Index])
 	var docJson = isr.Arena.Bytes(isr.Docs[isr.Index])
 
+	// Read documents are combined by the runtime until the transaction is flushed.
+	d.memory.Acquire(flow.MemoryCombine, int64(len(docJson)+len(keyPacked)))
+
 	return doSend[pd.Response](d.client, &pd.Request{
 		Read: &pd.Request_Read{
 			Transform: uint32(isr.ShuffleIndex),
@@ -137,7 +147,23 @@ func (d *Derive) FinalizeTxn(shard consumer.Shard, pub *message.Publisher) error
 		}
 		var responseExt = pr.FromInternal[pr.DeriveResponseExt](response.Internal)
 
-		if response.Published != nil {
+		if response.Published != nil && responseExt.Published.DeadLetter != "" {
+			// The document was validated by the connector against the write
+			// schema of its dead-letter collection. Dead-letter collections have
+			// no logical partitions, which is enforced by validation, so the
+			// document is mapped without them.
+			var spec, err = d.deadLetterSpec(responseExt.Published.DeadLetter)
+			if err != nil {
+				return err
+			}
+			if _, err = pub.PublishUncommitted(mapper.Map, flow.Mappable{
+				Spec: spec,
+				Doc:  response.Published.DocJson,
+			}); err != nil {
+				return fmt.Errorf("publishing dead-lettered document: %w", err)
+			}
+
+		} else if response.Published != nil {
 			var partitions, err = tuple.Unpack(responseExt.Published.PartitionsPacked)
 			if err != nil {
 				return fmt.Errorf("unpacking partitions: %w", err)
@@ -152,6 +178,8 @@ func (d *Derive) FinalizeTxn(shard consumer.Shard, pub *message.Publisher) error
 			}
 
 		} else if response.Flushed != nil {
+			d.memory.ReleaseAll(flow.MemoryCombine)
+
 			if err := d.publisher.PublishStats(*responseExt.Flushed.Stats, pub.PublishUncommitted); err != nil {
 				return fmt.Errorf("publishing stats: %w", err)
 			}
@@ -160,6 +188,28 @@ func (d *Derive) FinalizeTxn(shard consumer.Shard, pub *message.Publisher) error
 	}
 }
 
+// deadLetterSpec returns the CollectionSpec of dead-letter collection |name|,
+// loading it from the build of the current term if it's not yet known.
+func (d *Derive) deadLetterSpec(name string) (*pf.CollectionSpec, error) {
+	if spec, ok := d.deadLetters[name]; ok {
+		return spec, nil
+	}
+
+	var build = d.host.Builds.Open(d.term.labels.Build)
+	defer build.Close()
+
+	var spec *pf.CollectionSpec
+	if err := build.Extract(func(db *sql.DB) (err error) {
+		spec, err = catalog.LoadCollection(db, name)
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("loading dead-letter collection %q: %w", name, err)
+	}
+	d.deadLetters[name] = spec
+
+	return spec, nil
+}
+
 func (d *Derive) StartCommit(_ consumer.Shard, cp pf.Checkpoint, waitFor client.OpFutures) client.OpFuture {
 	ops.PublishLog(d.publisher, ops.Log_debug,
 		"StartCommit",
@@ -207,8 +257,30 @@ func (d *Derive) ClearRegistersForTest() error {
 	return nil
 }
 
-func (d *Derive) BeginTxn(shard consumer.Shard) error                    { return nil } // No-op.
-func (d *Derive) FinishedTxn(shard consumer.Shard, op consumer.OpFuture) {}             // No-op.
+// BeginTxn excludes queries of SQLite state until the transaction has committed.
+// It waits for a running query, which is bounded by maxQueryExecution.
+func (d *Derive) BeginTxn(shard consumer.Shard) error {
+	if d.sqlite == nil {
+		return nil
+	}
+	select {
+	case d.queryLock <- struct{}{}:
+		return nil
+	case <-shard.Context().Done():
+		return shard.Context().Err()
+	}
+}
+
+// FinishedTxn allows queries of SQLite state to proceed once the transaction
+// has committed, so that queries observe only durable state.
+func (d *Derive) FinishedTxn(shard consumer.Shard, op consumer.OpFuture) {
+	if d.sqlite != nil {
+		go func() {
+			<-op.Done()
+			<-d.queryLock
+		}()
+	}
+}
 
 func extractCollectionSpec(db *sql.DB, taskName string) (*pf.CollectionSpec, error) {
 	return catalog.LoadCollection(db, taskName)
@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"runtime/pprof"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,6 +15,8 @@ import (
 	"github.com/estuary/flow/go/protocols/ops"
 	pr "github.com/estuary/flow/go/protocols/runtime"
 	"github.com/estuary/flow/go/shuffle"
+	log "github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.gazette.dev/core/broker/client"
 	pb "go.gazette.dev/core/broker/protocol"
 	"go.gazette.dev/core/consumer"
@@ -27,12 +30,31 @@ import (
 type FlowConsumerConfig struct {
 	runconsumer.BaseConfig
 	Flow struct {
-		AllowLocal          bool   `long:"allow-local" description:"Allow local connectors. True for local stacks, and false otherwise."`
-		BuildsRoot          string `long:"builds-root" required:"true" env:"BUILDS_ROOT" description:"Base URL for fetching Flow catalog builds"`
-		BrokerRoot          string `long:"broker-root" required:"true" env:"BROKER_ROOT" default:"/gazette/cluster" description:"Broker Etcd base prefix"`
-		Network             string `long:"network" description:"The Docker network that connector containers are given access to, defaults to the bridge network"`
-		TestAPIs            bool   `long:"test-apis" description:"Enable APIs exclusively used while running catalog tests"`
-		DeprecatedInference bool   `long:"enable-schema-inference" description:"This flag is deprecated and will be removed." `
+		Airgapped                 bool              `long:"airgapped" env:"AIRGAPPED" description:"Run without access to external resources. Connector images are never pulled, and must be pre-loaded"`
+		AllowLocal                bool              `long:"allow-local" description:"Allow local connectors. True for local stacks, and false otherwise."`
+		AppendRetryBuffer         int               `long:"append-retry-buffer" env:"APPEND_RETRY_BUFFER" default:"4194304" description:"Maximum content, in bytes, of a journal append which is buffered and retried with a jittered backoff while brokers are unavailable. Zero disables these retries"`
+		BuildsRoot                string            `long:"builds-root" required:"true" env:"BUILDS_ROOT" description:"Base URL for fetching Flow catalog builds"`
+		BrokerRoot                string            `long:"broker-root" required:"true" env:"BROKER_ROOT" default:"/gazette/cluster" description:"Broker Etcd base prefix"`
+		BrokerEndpoints           map[string]string `long:"broker-endpoint" env:"BROKER_ENDPOINTS" env-delim:"," description:"Endpoint of a broker cluster which serves the journals of a collection, as 'collection:endpoint'. The collection may be a prefix ending in '/'. Collections which aren't listed are served by --broker.address. May be repeated"`
+		BrokerEtcdEndpoints       map[string]string `long:"broker-etcd-endpoint" env:"BROKER_ETCD_ENDPOINTS" env-delim:"," description:"Etcd endpoint of the broker cluster of a collection of --flow.broker-endpoint, as 'collection:endpoint'. Each such collection must have one, and its cluster must use the same --flow.broker-root. May be repeated"`
+		ConnectorAddressFamily    string            `long:"connector-address-family" env:"CONNECTOR_ADDRESS_FAMILY" default:"any" choice:"any" choice:"ipv4" choice:"ipv6" description:"Address family used to reach connector containers and the SSH endpoints of their network tunnels. 'any' prefers IPv4 and falls back to IPv6"`
+		ConnectorAllowEnv         []string          `long:"connector-allow-env" env:"CONNECTOR_ALLOW_ENV" env-delim:"," description:"Name of an environment variable which tasks may set in their connector containers. A name ending in '*' permits all variables having its prefix. May be repeated"`
+		ConnectorAllowMounts      []string          `long:"connector-allow-mount" env:"CONNECTOR_ALLOW_MOUNTS" env-delim:"," description:"Host directory within which tasks may mount read-only files into their connector containers. May be repeated"`
+		ConnectorMaxTmpfs         int64             `long:"connector-max-tmpfs" env:"CONNECTOR_MAX_TMPFS" default:"0" description:"Maximum total size, in megabytes, of the tmpfs filesystems of a connector container. Zero disallows tmpfs filesystems"`
+		ConnectorKeepalive        time.Duration     `long:"connector-keepalive" env:"CONNECTOR_KEEPALIVE" default:"10s" description:"Interval of keepalives exchanged with connector containers. Zero disables keepalives"`
+		ConnectorKeepaliveTimeout time.Duration     `long:"connector-keepalive-timeout" env:"CONNECTOR_KEEPALIVE_TIMEOUT" default:"20s" description:"Timeout after which a connector container which hasn't acknowledged a keepalive ping, or sent an expected keepalive frame, is considered dead and its streams are failed"`
+		ForgetAPI                 bool              `long:"forget-api" env:"FORGET_API" description:"Serve an HTTP API at /api/v1/forget which forgets the documents of a collection key, writing tombstones and rewriting persisted fragments. Requires --flow.ingest-api"`
+		ForgetAPIToken            string            `long:"forget-api-token" env:"FORGET_API_TOKEN" description:"Bearer token which is required of requests to the forget API"`
+		IngestAPI                 bool              `long:"ingest-api" env:"INGEST_API" description:"Serve an HTTP API at /api/v1/ingest which appends a batch of documents spanning multiple collections as one transaction. Transactions are atomic across the journals of their collections"`
+		IngestAPIToken            string            `long:"ingest-api-token" env:"INGEST_API_TOKEN" description:"Bearer token which is required of requests to the ingest API"`
+		MaxMessageSize            int               `long:"max-message-size" env:"MAX_MESSAGE_SIZE" default:"67108864" description:"Maximum size, in bytes, of a message received from a connector. A larger message fails the task"`
+		Network                   string            `long:"network" description:"The Docker network that connector containers are given access to, defaults to the bridge network"`
+		QueryAPI                  bool              `long:"query-api" env:"QUERY_API" description:"Serve an HTTP API at /api/v1/query of read-only queries over the SQLite state of derivation shards"`
+		QueryAPIToken             string            `long:"query-api-token" env:"QUERY_API_TOKEN" description:"Bearer token which is required of requests to the query API"`
+		RediscoverMinInterval     time.Duration     `long:"rediscover-min-interval" env:"REDISCOVER_MIN_INTERVAL" default:"1h" description:"Minimum interval of background re-discovers of captures which set the estuary.dev/rediscover-interval shard label. Shorter intervals are raised to this minimum"`
+		TaskMemoryLimit           int64             `long:"task-memory-limit" env:"TASK_MEMORY_LIMIT" default:"0" description:"Default limit, in bytes, of memory held by the combine buffers, read-ahead queues, and connector proxies of each task. Zero is unlimited"`
+		TestAPIs                  bool              `long:"test-apis" description:"Enable APIs exclusively used while running catalog tests"`
+		DeprecatedInference       bool              `long:"enable-schema-inference" description:"This flag is deprecated and will be removed." `
 	} `group:"flow" namespace:"flow" env-namespace:"FLOW"`
 }
 
@@ -60,6 +82,14 @@ type FlowConsumer struct {
 	// that we use an AppendService with a context that's scoped to the life of the process, rather
 	// than the lives of individual shards.
 	LogPublisher *message.Publisher
+	// Rediscovers is a semaphore which limits background re-discovers
+	// of captures to one at a time.
+	Rediscovers chan struct{}
+	// OpsPublishers of running task shards, keyed on shard ID.
+	shardPublishers struct {
+		m  map[pc.ShardID]*OpsPublisher
+		mu sync.Mutex
+	}
 }
 
 // Application is the interface implemented by Flow shard task stores.
@@ -76,9 +106,9 @@ type Application interface {
 	ReplayRange(_ consumer.Shard, _ pb.Journal, begin, end pb.Offset) message.Iterator
 	ReadThrough(pb.Offsets) (pb.Offsets, error)
 
-	// proxyHook exposes a current Container and ops.Publisher
+	// proxyHook exposes a current Container, ops.Publisher, and MemoryBudget
 	// for use by the network proxy server.
-	proxyHook() (*pr.Container, ops.Publisher)
+	proxyHook() (*pr.Container, ops.Publisher, *flow.MemoryBudget)
 }
 
 var _ consumer.Application = (*FlowConsumer)(nil)
@@ -187,13 +217,31 @@ func (f *FlowConsumer) InitApplication(args runconsumer.InitArgs) error {
 	bindings.RegisterPrometheusCollector()
 	var config = *args.Config.(*FlowConsumerConfig)
 
+	if config.Flow.ConnectorKeepalive != 0 && config.Flow.ConnectorKeepaliveTimeout <= 0 {
+		return fmt.Errorf("--flow.connector-keepalive-timeout must be positive")
+	}
+	if config.Flow.MaxMessageSize <= 0 {
+		return fmt.Errorf("--flow.max-message-size must be positive")
+	} else if config.Flow.AppendRetryBuffer < 0 {
+		return fmt.Errorf("--flow.append-retry-buffer cannot be negative")
+	}
+	bindings.SetConnectorKeepalive(config.Flow.ConnectorKeepalive, config.Flow.ConnectorKeepaliveTimeout)
+	bindings.SetMaxMessageSize(config.Flow.MaxMessageSize)
+	bindings.SetAirgapped(config.Flow.Airgapped)
+	bindings.SetConnectorAddressFamily(config.Flow.ConnectorAddressFamily)
+	bindings.SetConnectorAllowlist(config.Flow.ConnectorAllowEnv, config.Flow.ConnectorAllowMounts, config.Flow.ConnectorMaxTmpfs)
+
 	var builds, err = flow.NewBuildService(config.Flow.BuildsRoot)
 	if err != nil {
 		return fmt.Errorf("catalog builds service: %w", err)
 	}
 
-	// Load journal keyspace, and queue task that watches for updates.
-	journals, err := flow.NewJournalsKeySpace(args.Tasks.Context(), args.Service.Etcd, config.Flow.BrokerRoot)
+	// Load journal keyspaces, and queue task that watches for updates.
+	remoteEtcds, err := dialFederatedEtcds(config)
+	if err != nil {
+		return err
+	}
+	journals, err := flow.NewFederatedJournalsKeySpace(args.Tasks.Context(), args.Service.Etcd, remoteEtcds, config.Flow.BrokerRoot)
 	if err != nil {
 		return fmt.Errorf("loading journals keyspace: %w", err)
 	}
@@ -213,12 +261,25 @@ func (f *FlowConsumer) InitApplication(args runconsumer.InitArgs) error {
 		return flow.ShardStat(ctx, svc, req, journals)
 	}
 
+	if len(config.Flow.BrokerEndpoints) != 0 {
+		if args.Service.Journals, err = newFederatedJournalClient(args.Context, config, args.Service.Journals); err != nil {
+			return err
+		}
+	}
+
+	if config.Flow.AppendRetryBuffer != 0 {
+		args.Service.Journals = flow.NewAppendRetryClient(
+			args.Service.Journals, config.Flow.AppendRetryBuffer, appendRetryEvents{f})
+	}
+
 	f.LogPublisher = message.NewPublisher(client.NewAppendService(args.Context, args.Service.Journals), nil)
 	f.Config = &config
 	f.Service = args.Service
 	f.Builds = builds
 	f.Journals = journals
 	f.Timepoint.Now = flow.NewTimepoint(time.Now())
+	f.Rediscovers = make(chan struct{}, 1)
+	f.shardPublishers.m = make(map[pc.ShardID]*OpsPublisher)
 
 	// Start a ticker of the shared *Timepoint.
 	go func() {
@@ -236,9 +297,143 @@ func (f *FlowConsumer) InitApplication(args runconsumer.InitArgs) error {
 		}
 	}
 
+	if config.Flow.IngestAPI {
+		var ajc = client.NewAppendService(args.Context, args.Service.Journals)
+		if ingest, err := NewIngestAPI(f, ajc, config.Flow.IngestAPIToken); err != nil {
+			return fmt.Errorf("creating ingest API: %w", err)
+		} else {
+			args.Server.HTTPMux.Handle("/api/v1/ingest", ingest)
+
+			if config.Flow.ForgetAPI {
+				if forget, err := NewForgetAPI(ingest, config.Flow.ForgetAPIToken); err != nil {
+					return fmt.Errorf("creating forget API: %w", err)
+				} else {
+					args.Server.HTTPMux.Handle(forgetAPIPath, forget)
+				}
+			}
+		}
+	} else if config.Flow.ForgetAPI {
+		return fmt.Errorf("--flow.forget-api requires --flow.ingest-api")
+	}
+
+	if config.Flow.QueryAPI {
+		if query, err := NewQueryAPI(f, config.Flow.QueryAPIToken); err != nil {
+			return fmt.Errorf("creating query API: %w", err)
+		} else {
+			args.Server.HTTPMux.Handle(queryAPIPath, query)
+		}
+	}
+
 	pr.RegisterShufflerServer(args.Server.GRPCServer, shuffle.NewAPI(args.Service.Resolver))
 
 	pf.RegisterNetworkProxyServer(args.Server.GRPCServer, &proxyServer{resolver: args.Service.Resolver})
 
 	return nil
 }
+
+// dialFederatedEtcds dials the Etcd of the broker cluster of each collection
+// of the configured BrokerEndpoints.
+func dialFederatedEtcds(config FlowConsumerConfig) (map[string]*clientv3.Client, error) {
+	var out = make(map[string]*clientv3.Client)
+
+	for collection := range config.Flow.BrokerEtcdEndpoints {
+		if _, ok := config.Flow.BrokerEndpoints[collection]; !ok {
+			return nil, fmt.Errorf("broker Etcd endpoint of %q requires a --flow.broker-endpoint", collection)
+		}
+	}
+	for collection := range config.Flow.BrokerEndpoints {
+		var endpoint, ok = config.Flow.BrokerEtcdEndpoints[collection]
+		if !ok {
+			return nil, fmt.Errorf("federated collection %q requires a --flow.broker-etcd-endpoint", collection)
+		}
+		var remote = config.Etcd.EtcdConfig
+		remote.Address = pb.Endpoint(endpoint)
+
+		if err := remote.Address.Validate(); err != nil {
+			return nil, fmt.Errorf("broker Etcd endpoint of %q: %w", collection, err)
+		}
+		out[collection] = remote.MustDial()
+	}
+	return out, nil
+}
+
+// newFederatedJournalClient dials the broker clusters of the configured
+// per-collection BrokerEndpoints, and returns a client which dispatches
+// to them or to the |local| client of --broker.address.
+func newFederatedJournalClient(ctx context.Context, config FlowConsumerConfig, local pb.RoutedJournalClient) (*flow.FederatedJournalClient, error) {
+	var remotes = make(map[string]pb.RoutedJournalClient)
+
+	for collection, endpoint := range config.Flow.BrokerEndpoints {
+		var remote = config.Broker.ClientConfig // Inherit route cache configuration.
+		remote.Address = pb.Endpoint(endpoint)
+
+		if err := remote.Address.Validate(); err != nil {
+			return nil, fmt.Errorf("broker endpoint of %q: %w", collection, err)
+		}
+		remotes[collection] = remote.MustRoutedJournalClient(ctx)
+
+		log.WithFields(log.Fields{
+			"collection": collection,
+			"endpoint":   endpoint,
+		}).Info("federating collection journals to broker endpoint")
+	}
+	return flow.NewFederatedJournalClient(local, remotes)
+}
+
+// appendRetryEvents publishes ops events of appends retried by a
+// flow.AppendRetryClient to the task of the shard which is appending.
+// Appends not made by a shard, such as of ops logs, have no events.
+type appendRetryEvents struct{ f *FlowConsumer }
+
+func (e appendRetryEvents) AppendDegraded(ctx context.Context, journal pb.Journal, err error) {
+	if publisher := e.f.shardPublisher(ctx); publisher != nil {
+		ops.PublishLog(publisher, ops.Log_warn,
+			"journal appends are degraded because brokers are unavailable, and are being retried",
+			"journal", journal,
+			"error", err,
+		)
+	}
+}
+
+func (e appendRetryEvents) AppendRecovered(ctx context.Context, journal pb.Journal, attempts int, elapsed time.Duration) {
+	if publisher := e.f.shardPublisher(ctx); publisher != nil {
+		ops.PublishLog(publisher, ops.Log_info,
+			"journal appends have recovered",
+			"journal", journal,
+			"attempts", attempts,
+			"elapsed", elapsed.Seconds(),
+		)
+	}
+}
+
+// shardPublisher returns the OpsPublisher of the shard named by the pprof
+// labels of |ctx|, which the consumer sets on the Contexts of its shards,
+// or nil if |ctx| isn't of a running task shard.
+func (f *FlowConsumer) shardPublisher(ctx context.Context) *OpsPublisher {
+	var id, ok = pprof.Label(ctx, "shard")
+	if !ok {
+		return nil
+	}
+	f.shardPublishers.mu.Lock()
+	defer f.shardPublishers.mu.Unlock()
+
+	return f.shardPublishers.m[pc.ShardID(id)]
+}
+
+func (f *FlowConsumer) registerShardPublisher(id pc.ShardID, publisher *OpsPublisher) {
+	f.shardPublishers.mu.Lock()
+	defer f.shardPublishers.mu.Unlock()
+
+	f.shardPublishers.m[id] = publisher
+}
+
+// unregisterShardPublisher removes |publisher| of shard |id|, unless it's
+// since been replaced by the publisher of a new instance of the shard.
+func (f *FlowConsumer) unregisterShardPublisher(id pc.ShardID, publisher *OpsPublisher) {
+	f.shardPublishers.mu.Lock()
+	defer f.shardPublishers.mu.Unlock()
+
+	if f.shardPublishers.m[id] == publisher {
+		delete(f.shardPublishers.m, id)
+	}
+}
@@ -0,0 +1,682 @@
+package runtime
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/estuary/flow/go/labels"
+	"github.com/estuary/flow/go/protocols/catalog"
+	pf "github.com/estuary/flow/go/protocols/flow"
+	"github.com/estuary/flow/go/protocols/ops"
+	log "github.com/sirupsen/logrus"
+	"go.gazette.dev/core/broker/client"
+	"go.gazette.dev/core/broker/fragment"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+// ForgetRequest requests that all documents of a collection key be forgotten.
+type ForgetRequest struct {
+	// Build of the catalog having the Collection.
+	BuildID string `json:"buildId"`
+	// Collection having documents to forget.
+	Collection pf.Collection `json:"collection"`
+	// Key of the documents to forget, having one component for each
+	// JSON pointer of the collection key.
+	Key []json.RawMessage `json:"key"`
+	// DryRun reports the documents which would be forgotten,
+	// without appending tombstones or rewriting fragments.
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// ForgetReport is the auditable result of a ForgetRequest.
+type ForgetReport struct {
+	Collection pf.Collection     `json:"collection"`
+	Key        []json.RawMessage `json:"key"`
+	DryRun     bool              `json:"dryRun,omitempty"`
+	StartedAt  time.Time         `json:"startedAt"`
+	FinishedAt time.Time         `json:"finishedAt"`
+	// Tombstones are the write heads of journals into which
+	// deletion tombstones of the key were appended.
+	Tombstones pb.Offsets `json:"tombstones,omitempty"`
+	// Fragments are persisted fragments which were rewritten
+	// to purge historical documents of the key.
+	Fragments []ForgottenFragment `json:"fragments,omitempty"`
+	// Pending are documents of the key which are not yet persisted to a
+	// fragment store, and could not be purged. The request should be
+	// repeated once they've been persisted.
+	Pending []ForgottenDocument `json:"pending,omitempty"`
+	// Unredacted are persisted documents of the key which are too short to be
+	// replaced by a tombstone, and were left as-is.
+	Unredacted []ForgottenDocument `json:"unredacted,omitempty"`
+	// Readers are derivations and materializations of the build which read
+	// the collection, and to which its tombstones are propagated.
+	Readers []ForgetReader `json:"readers,omitempty"`
+	// Complete is true if every document of the key was purged.
+	Complete bool `json:"complete"`
+}
+
+// ForgottenFragment is a persisted fragment which was rewritten by a ForgetRequest.
+type ForgottenFragment struct {
+	Journal pb.Journal `json:"journal"`
+	Begin   int64      `json:"begin"`
+	End     int64      `json:"end"`
+	// Fragment is the path of the original fragment.
+	Fragment string `json:"fragment"`
+	// Replacement is the path of the rewritten fragment,
+	// which replaced the original fragment in its store.
+	Replacement string `json:"replacement,omitempty"`
+	// Documents is the number of documents of the key which were redacted.
+	Documents int `json:"documents"`
+}
+
+// ForgottenDocument is a document of the key at a journal offset.
+type ForgottenDocument struct {
+	Journal pb.Journal `json:"journal"`
+	Offset  int64      `json:"offset"`
+}
+
+// ForgetReader is a task which reads a forgotten collection.
+type ForgetReader struct {
+	Task string `json:"task"`
+	Type string `json:"type"`
+}
+
+// ForgetAPI forgets the documents of a collection key, as is required by
+// data-deletion requests. A forgotten key is handled in three parts:
+//
+//   - A deletion tombstone having the key, its partitions, and `_meta/op` of
+//     "d" is appended to each logical partition which has documents of the key.
+//     The collection schema must permit such tombstones.
+//   - Persisted fragments having documents of the key are rewritten, and each
+//     such document is replaced by a tombstone which is padded to its original
+//     length. Journal offsets are thus unchanged, and the rewritten fragment
+//     replaces the original in its fragment store.
+//   - Tombstones propagate to derivations and materializations which read the
+//     collection. Tasks opt into deletions by handling `_meta/op`: a derivation
+//     lambda may test for "d", and a materialization replaces the stored
+//     document of the key with its tombstone under a last-write-wins reduction.
+//
+// Requests must present the configured bearer token.
+type ForgetAPI struct {
+	ingest *IngestAPI
+	token  string
+}
+
+// forgetAPIPath is the HTTP path at which the ForgetAPI is served.
+const forgetAPIPath = "/api/v1/forget"
+
+// NewForgetAPI builds a *ForgetAPI which appends tombstones using |ingest|,
+// and authenticates requests with |token|.
+func NewForgetAPI(ingest *IngestAPI, token string) (*ForgetAPI, error) {
+	if token == "" {
+		return nil, fmt.Errorf("a forget API token is required")
+	}
+	return &ForgetAPI{ingest: ingest, token: token}, nil
+}
+
+// ServeHTTP executes a JSON-encoded ForgetRequest POSTed as the request body,
+// and responds with a JSON-encoded ForgetReport.
+func (api *ForgetAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "expected POST", http.StatusMethodNotAllowed)
+		return
+	}
+	var bearer = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(bearer), []byte(api.token)) != 1 {
+		http.Error(w, "invalid or missing bearer token", http.StatusUnauthorized)
+		return
+	}
+
+	var req ForgetRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("decoding request: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var report, err = api.Forget(r.Context(), req)
+
+	var invalid *invalidIngestError
+	if errors.As(err, &invalid) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		log.WithFields(log.Fields{
+			"err":        err,
+			"buildId":    req.BuildID,
+			"collection": req.Collection,
+		}).Warn("failed to forget collection key")
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(report)
+}
+
+// Forget the documents of the key of ForgetRequest |req|.
+func (api *ForgetAPI) Forget(ctx context.Context, req ForgetRequest) (*ForgetReport, error) {
+	var report = &ForgetReport{
+		Collection: req.Collection,
+		Key:        req.Key,
+		DryRun:     req.DryRun,
+		StartedAt:  time.Now().UTC(),
+	}
+
+	collections, err := api.ingest.loadCollections(IngestTransaction{
+		BuildID:   req.BuildID,
+		Documents: []IngestDocument{{Collection: req.Collection}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var spec = collections[0]
+
+	if len(req.Key) != len(spec.Key) {
+		return nil, &invalidIngestError{fmt.Errorf(
+			"key has %d components, but collection %s has a key of %d components",
+			len(req.Key), spec.Name, len(spec.Key))}
+	}
+	var f = &forgetter{spec: spec}
+	for _, raw := range req.Key {
+		var component, err = canonicalJSON(raw)
+		if err != nil {
+			return nil, &invalidIngestError{fmt.Errorf("decoding key component: %w", err)}
+		}
+		f.key = append(f.key, component)
+	}
+	for _, field := range spec.PartitionFields {
+		f.partitions = append(f.partitions, spec.GetProjection(field).Ptr)
+	}
+
+	if report.Readers, err = api.loadReaders(req.BuildID, spec.Name); err != nil {
+		return nil, err
+	}
+
+	listing, err := client.ListAllJournals(ctx, api.ingest.consumer.Service.Journals, pb.ListRequest{
+		Selector: pb.LabelSelector{Include: pb.MustLabelSet(labels.Collection, spec.Name.String())},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing journals of %s: %w", spec.Name, err)
+	}
+
+	// Scan all persisted fragments and unpersisted content of each journal.
+	var scans []journalScan
+	var tombstones = make(map[string]json.RawMessage)
+
+	for _, journal := range listing.Journals {
+		var scan, err = f.scanJournal(ctx, api.ingest.consumer.Service.Journals, &journal.Spec)
+		if err != nil {
+			return nil, fmt.Errorf("scanning journal %s: %w", journal.Spec.Name, err)
+		}
+		for _, doc := range scan.pending {
+			report.Pending = append(report.Pending, ForgottenDocument{Journal: journal.Spec.Name, Offset: doc.offset})
+		}
+		for _, doc := range scan.docs() {
+			// Tombstones are keyed on their logical partition.
+			if tombstone, err := f.tombstone(doc.doc, nil); err != nil {
+				return nil, err
+			} else {
+				tombstones[string(tombstone)] = tombstone
+			}
+		}
+		scans = append(scans, scan)
+	}
+
+	if req.DryRun {
+		for _, scan := range scans {
+			for _, frag := range scan.fragments {
+				report.Fragments = append(report.Fragments, ForgottenFragment{
+					Journal:   frag.Journal,
+					Begin:     frag.Begin,
+					End:       frag.End,
+					Fragment:  frag.ContentPath(),
+					Documents: len(frag.matched),
+				})
+			}
+		}
+		report.FinishedAt = time.Now().UTC()
+		return report, nil
+	}
+
+	// Append tombstones before purging historical documents, so that the
+	// collection's readers observe a deletion of the key before (or even if)
+	// its history is rewritten.
+	if len(tombstones) != 0 {
+		var txn = IngestTransaction{BuildID: req.BuildID}
+		for _, tombstone := range tombstones {
+			txn.Documents = append(txn.Documents, IngestDocument{Collection: spec.Name, Doc: tombstone})
+		}
+		var resp, err = api.ingest.Append(ctx, txn)
+		if err != nil {
+			return nil, fmt.Errorf("appending tombstones (does the collection schema permit them?): %w", err)
+		}
+		report.Tombstones = resp.JournalWriteHeads
+	}
+
+	for _, scan := range scans {
+		for _, frag := range scan.fragments {
+			var forgotten, unredacted, err = f.rewriteFragment(ctx, frag)
+			if err != nil {
+				return nil, fmt.Errorf("rewriting fragment %s: %w", frag.ContentPath(), err)
+			}
+			report.Fragments = append(report.Fragments, forgotten)
+			report.Unredacted = append(report.Unredacted, unredacted...)
+		}
+	}
+	report.Complete = len(report.Pending) == 0 && len(report.Unredacted) == 0
+	report.FinishedAt = time.Now().UTC()
+
+	log.WithFields(log.Fields{
+		"collection": report.Collection,
+		"tombstones": len(report.Tombstones),
+		"fragments":  len(report.Fragments),
+		"pending":    len(report.Pending),
+		"unredacted": len(report.Unredacted),
+		"complete":   report.Complete,
+	}).Info("forgot collection key")
+
+	return report, nil
+}
+
+// loadReaders loads the derivations and materializations of |buildID|
+// which read from |collection|.
+func (api *ForgetAPI) loadReaders(buildID string, collection pf.Collection) ([]ForgetReader, error) {
+	var build = api.ingest.consumer.Builds.Open(buildID)
+	defer build.Close()
+
+	var out []ForgetReader
+	var err = build.Extract(func(db *sql.DB) error {
+		var collections, err = catalog.LoadAllCollections(db)
+		if err != nil {
+			return fmt.Errorf("loading collections: %w", err)
+		}
+		for _, spec := range collections {
+			if spec.Derivation == nil {
+				continue
+			}
+			for _, transform := range spec.Derivation.Transforms {
+				if transform.Collection.Name == collection {
+					out = append(out, ForgetReader{Task: spec.Name.String(), Type: ops.TaskType_derivation.String()})
+					break
+				}
+			}
+		}
+
+		materializations, err := catalog.LoadAllMaterializations(db)
+		if err != nil {
+			return fmt.Errorf("loading materializations: %w", err)
+		}
+		for _, spec := range materializations {
+			for _, binding := range spec.Bindings {
+				if binding.Collection.Name == collection {
+					out = append(out, ForgetReader{Task: spec.Name.String(), Type: ops.TaskType_materialization.String()})
+					break
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, build.Close()
+}
+
+// forgetter matches and redacts documents of a key of a collection.
+type forgetter struct {
+	spec *pf.CollectionSpec
+	// Canonical JSON encodings of the components of the key.
+	key []string
+	// JSON pointers of the logical partitions of the collection.
+	partitions []string
+}
+
+// journalScan is the result of scanning a journal for documents of the key.
+type journalScan struct {
+	// Persisted fragments having documents of the key.
+	fragments []scannedFragment
+	// Documents of the key in content which isn't yet persisted.
+	pending []scannedDoc
+}
+
+// scannedFragment is a persisted fragment having documents of the key.
+type scannedFragment struct {
+	pb.Fragment
+	matched []scannedDoc
+}
+
+// scannedDoc is a document of the key.
+type scannedDoc struct {
+	offset int64
+	doc    interface{}
+}
+
+func (s *journalScan) docs() []scannedDoc {
+	var out = append([]scannedDoc(nil), s.pending...)
+	for _, frag := range s.fragments {
+		out = append(out, frag.matched...)
+	}
+	return out
+}
+
+// scanJournal scans all fragments of the journal |spec| in each of its
+// fragment stores, and its content which is not yet persisted.
+func (f *forgetter) scanJournal(ctx context.Context, rjc pb.RoutedJournalClient, spec *pb.JournalSpec) (journalScan, error) {
+	var out journalScan
+	var persisted int64
+
+	for _, store := range spec.Fragment.Stores {
+		var listed []pb.Fragment
+		if err := fragment.List(ctx, store, spec.Name, func(frag pb.Fragment) {
+			listed = append(listed, frag)
+		}); err != nil {
+			return out, fmt.Errorf("listing fragments of %s: %w", store, err)
+		}
+
+		for _, frag := range listed {
+			if frag.End > persisted {
+				persisted = frag.End
+			}
+			var matched []scannedDoc
+
+			if err := readFragment(ctx, frag, func(offset int64, line []byte) error {
+				if doc, ok := f.match(line); ok {
+					matched = append(matched, scannedDoc{offset: offset, doc: doc})
+				}
+				return nil
+			}); err != nil {
+				return out, fmt.Errorf("reading fragment %s: %w", frag.ContentPath(), err)
+			}
+			if len(matched) != 0 {
+				out.fragments = append(out.fragments, scannedFragment{Fragment: frag, matched: matched})
+			}
+		}
+	}
+
+	// Read content beyond the last persisted fragment, through the journal write head.
+	var rr = client.NewReader(ctx, rjc, pb.ReadRequest{
+		Journal: spec.Name,
+		Offset:  persisted,
+		Block:   false,
+	})
+	var br = bufio.NewReader(rr)
+
+	for {
+		var offset = rr.AdjustedOffset(br)
+		var line, err = br.ReadBytes('\n')
+
+		if err == nil {
+			if doc, ok := f.match(line); ok {
+				out.pending = append(out.pending, scannedDoc{offset: offset, doc: doc})
+			}
+		} else if err == client.ErrOffsetJump {
+			continue // Content was removed, such as by retention.
+		} else if err == client.ErrOffsetNotYetAvailable || err == io.EOF {
+			return out, nil // Read through the write head.
+		} else {
+			return out, fmt.Errorf("reading journal content: %w", err)
+		}
+	}
+}
+
+// readFragment invokes |onLine| with each line of persisted |frag| and its offset.
+func readFragment(ctx context.Context, frag pb.Fragment, onLine func(offset int64, line []byte) error) error {
+	var rc, err = fragment.Open(ctx, frag)
+	if err != nil {
+		return err
+	}
+	fr, err := client.NewFragmentReader(rc, frag, frag.Begin)
+	if err != nil {
+		return err
+	}
+	defer fr.Close()
+
+	var br = bufio.NewReader(fr)
+	var offset = frag.Begin
+
+	for {
+		var line, err = br.ReadBytes('\n')
+		if len(line) != 0 {
+			if err := onLine(offset, line); err != nil {
+				return err
+			}
+			offset += int64(len(line))
+		}
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// rewriteFragment rewrites |frag| with each document of the key replaced by a
+// padded tombstone. The rewritten fragment is persisted to the store of |frag|,
+// under its same path postfix, and |frag| is then removed.
+func (f *forgetter) rewriteFragment(ctx context.Context, frag scannedFragment) (ForgottenFragment, []ForgottenDocument, error) {
+	var out = ForgottenFragment{
+		Journal:  frag.Journal,
+		Begin:    frag.Begin,
+		End:      frag.End,
+		Fragment: frag.ContentPath(),
+	}
+	var unredacted []ForgottenDocument
+
+	// Roll an empty spool forward to the beginning of the fragment.
+	var spool = fragment.NewSpool(frag.Journal, discardSpoolObserver{})
+	spool.MustApply(&pb.ReplicateRequest{
+		Proposal: &pb.Fragment{
+			Journal:          frag.Journal,
+			Begin:            frag.Begin,
+			End:              frag.Begin,
+			CompressionCodec: frag.CompressionCodec,
+		},
+		Registers: &pb.LabelSet{},
+	})
+	var buf bytes.Buffer
+	var delta int64
+
+	var flush = func() error {
+		if _, err := spool.Apply(&pb.ReplicateRequest{Content: buf.Bytes(), ContentDelta: delta}, true); err != nil {
+			return err
+		}
+		delta += int64(buf.Len())
+		buf.Reset()
+		return nil
+	}
+
+	var err = readFragment(ctx, frag.Fragment, func(offset int64, line []byte) error {
+		if doc, ok := f.match(line); ok {
+			if redacted, err := f.redact(line, doc); err != nil {
+				return err
+			} else if redacted == nil {
+				unredacted = append(unredacted, ForgottenDocument{Journal: frag.Journal, Offset: offset})
+			} else {
+				line = redacted
+				out.Documents++
+			}
+		}
+		if buf.Write(line); buf.Len() >= 1<<20 {
+			return flush()
+		}
+		return nil
+	})
+	if err == nil && buf.Len() != 0 {
+		err = flush()
+	}
+	if err != nil {
+		return out, nil, err
+	} else if out.Documents == 0 {
+		return out, unredacted, nil // Nothing to rewrite.
+	}
+
+	var proposal = spool.Next()
+	if proposal.End != frag.End {
+		return out, nil, fmt.Errorf("rewritten fragment ends at %d, not %d", proposal.End, frag.End)
+	} else if resp, err := spool.Apply(&pb.ReplicateRequest{Proposal: &proposal, Registers: &pb.LabelSet{}}, true); err != nil {
+		return out, nil, err
+	} else if resp.Status != pb.Status_OK {
+		return out, nil, fmt.Errorf("committing rewritten fragment: %s", resp.Status)
+	}
+
+	// Persist into the store of the original fragment, under its postfix.
+	// The rewritten fragment has a distinct content sum, and thus a distinct name.
+	if err = fragment.Persist(ctx, spool, &pb.JournalSpec{
+		Name: frag.Journal,
+		Fragment: pb.JournalSpec_Fragment{
+			Stores:              []pb.FragmentStore{frag.BackingStore},
+			PathPostfixTemplate: frag.PathPostfix,
+		},
+	}); err != nil {
+		return out, nil, fmt.Errorf("persisting rewritten fragment: %w", err)
+	}
+	var replacement = spool.Fragment.Fragment
+	replacement.PathPostfix = frag.PathPostfix
+	out.Replacement = replacement.ContentPath()
+
+	if err = fragment.Remove(ctx, frag.Fragment); err != nil {
+		return out, nil, fmt.Errorf("removing original fragment: %w", err)
+	}
+	return out, unredacted, nil
+}
+
+// match parses a document |line|, and returns it if it has the key.
+// Documents which are already tombstones are not matched.
+func (f *forgetter) match(line []byte) (interface{}, bool) {
+	var d = json.NewDecoder(bytes.NewReader(line))
+	d.UseNumber()
+
+	var doc interface{}
+	if err := d.Decode(&doc); err != nil {
+		return nil, false
+	}
+	if op, _ := lookupPtr(doc, "/_meta/op"); op == "d" {
+		return nil, false
+	}
+	for i, ptr := range f.spec.Key {
+		var v, ok = lookupPtr(doc, ptr)
+		if !ok {
+			return nil, false
+		} else if b, err := json.Marshal(v); err != nil || string(b) != f.key[i] {
+			return nil, false
+		}
+	}
+	return doc, true
+}
+
+// tombstone returns a deletion tombstone of |doc|, having its key and
+// partitions, a `_meta/op` of "d", and `_meta/uuid` if |uuid| is non-nil.
+func (f *forgetter) tombstone(doc interface{}, uuid interface{}) (json.RawMessage, error) {
+	var out = make(map[string]interface{})
+
+	for _, ptr := range append(append([]string(nil), f.spec.Key...), f.partitions...) {
+		if v, ok := lookupPtr(doc, ptr); ok {
+			setPtr(out, ptr, v)
+		}
+	}
+	setPtr(out, "/_meta/op", "d")
+	if uuid != nil {
+		setPtr(out, f.spec.UuidPtr, uuid)
+	}
+	return json.Marshal(out)
+}
+
+// redact returns a tombstone of |doc| which replaces its original |line|,
+// padded with whitespace to the same length. If the tombstone is longer than
+// |line| then nil is returned, and |line| must be left as-is.
+func (f *forgetter) redact(line []byte, doc interface{}) ([]byte, error) {
+	var uuid, _ = lookupPtr(doc, f.spec.UuidPtr)
+
+	var tombstone, err = f.tombstone(doc, uuid)
+	if err != nil {
+		return nil, err
+	}
+	var body = bytes.TrimSuffix(line, []byte("\n"))
+
+	if len(tombstone) > len(body) {
+		return nil, nil
+	}
+	var out = append(tombstone, bytes.Repeat([]byte(" "), len(body)-len(tombstone))...)
+	return append(out, line[len(body):]...), nil
+}
+
+// canonicalJSON re-encodes JSON |raw| into its canonical form.
+func canonicalJSON(raw json.RawMessage) (string, error) {
+	var d = json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return "", err
+	}
+	var b, err = json.Marshal(v)
+	return string(b), err
+}
+
+// lookupPtr returns the value of |doc| at JSON pointer |ptr|.
+func lookupPtr(doc interface{}, ptr string) (interface{}, bool) {
+	for _, token := range ptrTokens(ptr) {
+		switch vv := doc.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if doc, ok = vv[token]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			var index, err = strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(vv) {
+				return nil, false
+			}
+			doc = vv[index]
+		default:
+			return nil, false
+		}
+	}
+	return doc, true
+}
+
+// setPtr sets |value| at JSON pointer |ptr| of |doc|,
+// creating intermediate objects as required.
+func setPtr(doc map[string]interface{}, ptr string, value interface{}) {
+	var tokens = ptrTokens(ptr)
+	for _, token := range tokens[:len(tokens)-1] {
+		var next, ok = doc[token].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			doc[token] = next
+		}
+		doc = next
+	}
+	doc[tokens[len(tokens)-1]] = value
+}
+
+// ptrTokens returns the unescaped tokens of JSON pointer |ptr|.
+func ptrTokens(ptr string) []string {
+	if ptr == "" {
+		return nil
+	}
+	var tokens = strings.Split(strings.TrimPrefix(ptr, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens
+}
+
+// discardSpoolObserver is a fragment.SpoolObserver which ignores all events,
+// used with Spools of rewritten fragments which are persisted directly.
+type discardSpoolObserver struct{}
+
+func (discardSpoolObserver) SpoolCommit(fragment.Fragment)      {}
+func (discardSpoolObserver) SpoolComplete(fragment.Spool, bool) {}
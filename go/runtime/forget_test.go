@@ -0,0 +1,276 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	pf "github.com/estuary/flow/go/protocols/flow"
+	"github.com/stretchr/testify/require"
+	"go.gazette.dev/core/broker/fragment"
+	pb "go.gazette.dev/core/broker/protocol"
+)
+
+func TestForgetPtrs(t *testing.T) {
+	for _, tc := range []struct {
+		ptr    string
+		expect []string
+	}{
+		{"", nil},
+		{"/", []string{""}},
+		{"/a/b", []string{"a", "b"}},
+		{"/a~1b/c~0d/~01", []string{"a/b", "c~d", "~1"}},
+	} {
+		require.Equal(t, tc.expect, ptrTokens(tc.ptr), tc.ptr)
+	}
+
+	var doc interface{} = map[string]interface{}{
+		"a":   map[string]interface{}{"b": "c"},
+		"d":   []interface{}{"e", map[string]interface{}{"f": true}},
+		"g/h": 1,
+	}
+	for _, tc := range []struct {
+		ptr    string
+		expect interface{}
+		ok     bool
+	}{
+		{"", doc, true},
+		{"/a/b", "c", true},
+		{"/d/0", "e", true},
+		{"/d/1/f", true, true},
+		{"/g~1h", 1, true},
+		{"/a/missing", nil, false},
+		{"/d/2", nil, false},
+		{"/d/-1", nil, false},
+		{"/d/x", nil, false},
+		{"/a/b/c", nil, false},
+	} {
+		var v, ok = lookupPtr(doc, tc.ptr)
+		require.Equal(t, tc.ok, ok, tc.ptr)
+		require.Equal(t, tc.expect, v, tc.ptr)
+	}
+
+	var out = map[string]interface{}{"a": "scalar"}
+	setPtr(out, "/b/c/d", 1)
+	setPtr(out, "/b/e", 2)
+	setPtr(out, "/a/f", 3) // Replaces a non-object.
+	setPtr(out, "/g~1h", 4)
+
+	require.Equal(t, map[string]interface{}{
+		"a":   map[string]interface{}{"f": 3},
+		"b":   map[string]interface{}{"c": map[string]interface{}{"d": 1}, "e": 2},
+		"g/h": 4,
+	}, out)
+}
+
+func TestForgetCanonicalJSON(t *testing.T) {
+	for _, tc := range []struct {
+		raw    string
+		expect string
+	}{
+		{` 42 `, `42`},
+		{`1.50`, `1.50`},
+		{`12345678901234567890`, `12345678901234567890`},
+		{`"abc"`, `"abc"`},
+		{`{"b": 1, "a": [true, null]}`, `{"a":[true,null],"b":1}`},
+	} {
+		var out, err = canonicalJSON(json.RawMessage(tc.raw))
+		require.NoError(t, err)
+		require.Equal(t, tc.expect, out, tc.raw)
+	}
+
+	var _, err = canonicalJSON(json.RawMessage(`{"a":`))
+	require.Error(t, err)
+}
+
+func TestForgetMatchAndTombstone(t *testing.T) {
+	var f = forgetTestFixture()
+
+	for _, tc := range []struct {
+		line  string
+		match bool
+	}{
+		{`{"id": 42, "region": "us", "v": 1}`, true},
+		{`{"id": 42.0, "region": "us"}`, false}, // Not canonically equal.
+		{`{"id": "42", "region": "us"}`, false},
+		{`{"id": 32, "region": "us"}`, false},
+		{`{"region": "us"}`, false},
+		{`{"id": 42, "_meta": {"op": "d"}}`, false}, // Already a tombstone.
+		{`{"id": 42, "_meta": {"op": "u"}}`, true},
+		{`not JSON`, false},
+	} {
+		var _, ok = f.match([]byte(tc.line))
+		require.Equal(t, tc.match, ok, tc.line)
+	}
+
+	var doc, ok = f.match([]byte(`{"_meta": {"uuid": "an-uuid"}, "id": 42, "region": "us", "v": {"secret": true}}`))
+	require.True(t, ok)
+
+	// Tombstones have only the key, partitions, and operation of the document.
+	var tombstone, err = f.tombstone(doc, nil)
+	require.NoError(t, err)
+	require.Equal(t, `{"_meta":{"op":"d"},"id":42,"region":"us"}`, string(tombstone))
+
+	tombstone, err = f.tombstone(doc, "an-uuid")
+	require.NoError(t, err)
+	require.Equal(t, `{"_meta":{"op":"d","uuid":"an-uuid"},"id":42,"region":"us"}`, string(tombstone))
+
+	// A document missing a partition has a tombstone without it.
+	doc, _ = f.match([]byte(`{"id": 42}`))
+	tombstone, err = f.tombstone(doc, nil)
+	require.NoError(t, err)
+	require.Equal(t, `{"_meta":{"op":"d"},"id":42}`, string(tombstone))
+}
+
+func TestForgetRedact(t *testing.T) {
+	var f = forgetTestFixture()
+
+	var line = []byte(`{"_meta":{"uuid":"an-uuid"},"id":42,"region":"us","v":{"secret":"a long secret value"}}` + "\n")
+	var doc, _ = f.match(line)
+
+	var redacted, err = f.redact(line, doc)
+	require.NoError(t, err)
+	require.Len(t, redacted, len(line))
+	require.Equal(t,
+		`{"_meta":{"op":"d","uuid":"an-uuid"},"id":42,"region":"us"}`+strings.Repeat(" ", 28)+"\n",
+		string(redacted))
+
+	// A line without a trailing newline is padded to its same length.
+	redacted, err = f.redact(line[:len(line)-1], doc)
+	require.NoError(t, err)
+	require.NotContains(t, string(redacted), "\n")
+	require.Len(t, redacted, len(line)-1)
+
+	// A line which is shorter than its tombstone cannot be redacted.
+	line = []byte(`{"_meta":{"uuid":"an-uuid"},"id":42,"region":"us"}` + "\n")
+	doc, _ = f.match(line)
+
+	redacted, err = f.redact(line, doc)
+	require.NoError(t, err)
+	require.Nil(t, redacted)
+}
+
+func TestForgetRewriteFragmentPreservesOffsets(t *testing.T) {
+	defer func(root string) { fragment.FileSystemStoreRoot = root }(fragment.FileSystemStoreRoot)
+	fragment.FileSystemStoreRoot = t.TempDir()
+
+	var ctx = context.Background()
+	var f = forgetTestFixture()
+	var store = pb.FragmentStore("file:///")
+
+	var lines = []string{
+		`{"_meta":{"uuid":"uuid-1"},"id":32,"region":"us","v":"keep me"}` + "\n",
+		`{"_meta":{"uuid":"uuid-2"},"id":42,"region":"us","v":"forget me, please"}` + "\n",
+		`{"_meta":{"uuid":"uuid-3"},"id":42}` + "\n", // Too short to redact.
+		`{"_meta":{"uuid":"uuid-4"},"id":52,"region":"eu","v":"keep me too"}` + "\n",
+	}
+	var original = forgetTestPersist(t, ctx, store, 1024, []byte(strings.Join(lines, "")))
+
+	var scanned = scannedFragment{Fragment: original}
+	require.NoError(t, readFragment(ctx, original, func(offset int64, line []byte) error {
+		if doc, ok := f.match(line); ok {
+			scanned.matched = append(scanned.matched, scannedDoc{offset: offset, doc: doc})
+		}
+		return nil
+	}))
+	require.Equal(t, []int64{1024 + int64(len(lines[0])), 1024 + int64(len(lines[0])+len(lines[1]))},
+		[]int64{scanned.matched[0].offset, scanned.matched[1].offset})
+
+	var out, unredacted, err = f.rewriteFragment(ctx, scanned)
+	require.NoError(t, err)
+	require.Equal(t, 1, out.Documents)
+	require.Equal(t, []ForgottenDocument{{Journal: "a/journal", Offset: scanned.matched[1].offset}}, unredacted)
+	require.Equal(t, original.ContentPath(), out.Fragment)
+	require.NotEqual(t, out.Fragment, out.Replacement)
+
+	// The original fragment was replaced by one having the same extent.
+	var listed []pb.Fragment
+	require.NoError(t, fragment.List(ctx, store, "a/journal", func(frag pb.Fragment) {
+		listed = append(listed, frag)
+	}))
+	require.Len(t, listed, 1)
+	require.Equal(t, out.Replacement, listed[0].ContentPath())
+	require.Equal(t, original.Begin, listed[0].Begin)
+	require.Equal(t, original.End, listed[0].End)
+	require.NotEqual(t, original.Sum, listed[0].Sum)
+
+	// Each line has its original offset and length, and only the
+	// redacted document was changed.
+	var offsets []int64
+	var rewritten []string
+
+	require.NoError(t, readFragment(ctx, listed[0], func(offset int64, line []byte) error {
+		offsets = append(offsets, offset)
+		rewritten = append(rewritten, string(line))
+		return nil
+	}))
+	require.Equal(t, []int64{
+		1024,
+		1024 + int64(len(lines[0])),
+		1024 + int64(len(lines[0])+len(lines[1])),
+		1024 + int64(len(lines[0])+len(lines[1])+len(lines[2])),
+	}, offsets)
+	require.Equal(t, lines[0], rewritten[0])
+	require.Len(t, rewritten[1], len(lines[1]))
+	require.True(t, strings.HasPrefix(rewritten[1], `{"_meta":{"op":"d","uuid":"uuid-2"},"id":42,"region":"us"} `))
+	require.Equal(t, lines[2], rewritten[2])
+	require.Equal(t, lines[3], rewritten[3])
+
+	// Rewriting again finds no further documents to redact.
+	scanned = scannedFragment{Fragment: listed[0]}
+	scanned.matched = []scannedDoc{{offset: offsets[2]}}
+
+	out, unredacted, err = f.rewriteFragment(ctx, scanned)
+	require.NoError(t, err)
+	require.Equal(t, 0, out.Documents)
+	require.Len(t, unredacted, 1)
+	require.Empty(t, out.Replacement)
+}
+
+func forgetTestFixture() *forgetter {
+	return &forgetter{
+		spec: &pf.CollectionSpec{
+			Name:    "a/collection",
+			Key:     []string{"/id"},
+			UuidPtr: "/_meta/uuid",
+		},
+		key:        []string{"42"},
+		partitions: []string{"/region"},
+	}
+}
+
+// forgetTestPersist persists |content| as a fragment of "a/journal"
+// beginning at |begin|, and returns the persisted fragment.
+func forgetTestPersist(t *testing.T, ctx context.Context, store pb.FragmentStore, begin int64, content []byte) pb.Fragment {
+	var spool = fragment.NewSpool("a/journal", discardSpoolObserver{})
+	spool.MustApply(&pb.ReplicateRequest{
+		Proposal: &pb.Fragment{
+			Journal:          "a/journal",
+			Begin:            begin,
+			End:              begin,
+			CompressionCodec: pb.CompressionCodec_GZIP,
+		},
+		Registers: &pb.LabelSet{},
+	})
+	var _, err = spool.Apply(&pb.ReplicateRequest{Content: content}, true)
+	require.NoError(t, err)
+
+	var proposal = spool.Next()
+	resp, err := spool.Apply(&pb.ReplicateRequest{Proposal: &proposal, Registers: &pb.LabelSet{}}, true)
+	require.NoError(t, err)
+	require.Equal(t, pb.Status_OK, resp.Status)
+
+	require.NoError(t, fragment.Persist(ctx, spool, &pb.JournalSpec{
+		Name:     "a/journal",
+		Fragment: pb.JournalSpec_Fragment{Stores: []pb.FragmentStore{store}},
+	}))
+
+	var listed []pb.Fragment
+	require.NoError(t, fragment.List(ctx, store, "a/journal", func(frag pb.Fragment) {
+		listed = append(listed, frag)
+	}))
+	require.Len(t, listed, 1)
+
+	return listed[0]
+}
@@ -0,0 +1,423 @@
+package runtime
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/estuary/flow/go/bindings"
+	"github.com/estuary/flow/go/flow"
+	"github.com/estuary/flow/go/protocols/catalog"
+	"github.com/estuary/flow/go/protocols/fdb/tuple"
+	pf "github.com/estuary/flow/go/protocols/flow"
+	"github.com/estuary/flow/go/protocols/ops"
+	pr "github.com/estuary/flow/go/protocols/runtime"
+	log "github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.gazette.dev/core/broker/client"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/message"
+)
+
+// IngestTransaction is a batch of documents, spanning one or more collections,
+// which are appended as a single transaction.
+type IngestTransaction struct {
+	// Build of the catalog having the collections of Documents.
+	BuildID string `json:"buildId"`
+	// Documents of the transaction.
+	Documents []IngestDocument `json:"documents"`
+}
+
+// IngestDocument is a single document of an IngestTransaction.
+type IngestDocument struct {
+	// Collection into which the document is appended.
+	Collection pf.Collection `json:"collection"`
+	// Doc is the JSON document.
+	Doc json.RawMessage `json:"doc"`
+}
+
+// IngestResponse is the response of an IngestTransaction which was committed
+// to all of its journals.
+type IngestResponse struct {
+	// Journals appended to by the transaction, and their write heads
+	// after the transaction's acknowledgements were appended.
+	JournalWriteHeads pb.Offsets `json:"journalWriteHeads"`
+}
+
+// IngestAPI appends IngestTransactions on behalf of producers which require
+// consistency across collections. Documents of a transaction are written as
+// CONTINUE_TXN messages of a single, unique producer. Only once every document
+// has been durably appended are the ACK intents of the transaction recorded in
+// Etcd, which commits the transaction. ACKs are then written to each journal,
+// which makes its documents visible to readers, and the record is removed.
+//
+// A transaction which fails prior to recording its ACK intents is never
+// visible to readers. A transaction which fails after recording them, such as
+// because the process exited while ACKs were being written, has its remaining
+// ACKs written upon recovery by the next IngestAPI of the data plane. Readers
+// eventually observe every committed transaction in all of its journals.
+//
+// Requests must present the configured bearer token.
+type IngestAPI struct {
+	consumer *FlowConsumer
+	token    string
+	// Append service used by Publishers of transactions.
+	ajc *client.AppendService
+	// Task service for combining the documents of transactions.
+	svc *bindings.TaskService
+	// Etcd prefix of recorded ACK intents of committed transactions.
+	intents string
+}
+
+// maxIngestBytes bounds the size of an IngestTransaction request body.
+var maxIngestBytes int64 = 1 << 26 // 64MB.
+
+// NewIngestAPI builds an *IngestAPI which appends using the given AppendService,
+// and authenticates requests with |token|.
+func NewIngestAPI(consumer *FlowConsumer, ajc *client.AppendService, token string) (*IngestAPI, error) {
+	if token == "" {
+		return nil, fmt.Errorf("an ingest API token is required")
+	}
+	// Finish acknowledging transactions which were committed but may not have
+	// written all of their ACKs, such as due to a prior process exit.
+	var intents = consumer.Service.State.KS.Root + ingestIntentsPrefix
+	if err := recoverIngest(context.Background(), consumer.Service.Etcd, intents, ajc); err != nil {
+		return nil, fmt.Errorf("recovering ingest transactions: %w", err)
+	}
+
+	svc, err := bindings.NewTaskService(
+		pr.TaskServiceConfig{TaskName: "flow-ingest"},
+		ops.NewLocalPublisher(ops.ShardLabeling{TaskName: "flow-ingest"}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task service: %w", err)
+	}
+
+	return &IngestAPI{
+		consumer: consumer,
+		token:    token,
+		ajc:      ajc,
+		svc:      svc,
+		intents:  intents,
+	}, nil
+}
+
+// ingestIntentsPrefix is the Etcd key prefix, under the consumer root,
+// of the recorded ACK intents of committed ingest transactions.
+const ingestIntentsPrefix = "/ingest-intents/"
+
+// ServeHTTP appends a JSON-encoded IngestTransaction POSTed as the request body,
+// and responds with a JSON-encoded IngestResponse.
+func (api *IngestAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "expected POST", http.StatusMethodNotAllowed)
+		return
+	}
+	var bearer = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(bearer), []byte(api.token)) != 1 {
+		http.Error(w, "invalid or missing bearer token", http.StatusUnauthorized)
+		return
+	}
+
+	var txn IngestTransaction
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxIngestBytes)).Decode(&txn); err != nil {
+		http.Error(w, fmt.Sprintf("decoding transaction: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var resp, err = api.Append(r.Context(), txn)
+
+	var invalid *invalidIngestError
+	if errors.As(err, &invalid) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		log.WithFields(log.Fields{
+			"err":       err,
+			"buildId":   txn.BuildID,
+			"documents": len(txn.Documents),
+		}).Warn("failed to append ingest transaction")
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// Append the documents of IngestTransaction |txn| as a single transaction.
+// Documents are first validated and combined, so that an invalid document
+// fails the transaction before any document is appended.
+func (api *IngestAPI) Append(ctx context.Context, txn IngestTransaction) (*IngestResponse, error) {
+	if len(txn.Documents) == 0 {
+		return nil, &invalidIngestError{errors.New("transaction has no documents")}
+	}
+
+	var collections, err = api.loadCollections(txn)
+	if err != nil {
+		return nil, err
+	}
+	combined, err := api.combine(ctx, txn, collections)
+	if err != nil {
+		return nil, &invalidIngestError{err}
+	}
+
+	var docs = make([]flow.Mappable, 0, len(combined))
+	for _, doc := range combined {
+		var partitions, err = tuple.Unpack(doc.ValuesPacked)
+		if err != nil {
+			return nil, fmt.Errorf("unpacking partitions: %w", err)
+		}
+		docs = append(docs, flow.Mappable{
+			Spec:       collections[doc.Binding],
+			Doc:        doc.DocJson,
+			PackedKey:  doc.KeyPacked,
+			Partitions: partitions,
+		})
+	}
+	var mapper = flow.NewMapper(ctx, api.consumer.Service.Etcd, api.consumer.Journals, api.consumer.Service.State.LocalKey)
+
+	heads, err := appendIngest(ctx, api.ajc, api.consumer.Service.Etcd, api.intents, mapper.Map, docs)
+	if err != nil {
+		return nil, err
+	}
+	return &IngestResponse{JournalWriteHeads: heads}, nil
+}
+
+// appendIngest appends |docs| mapped by |mapping| as a single transaction,
+// and returns the write heads of its journals after their ACKs were appended.
+// No ACK is written unless every document was first durably appended, and its
+// ACK intents were recorded under the Etcd |prefix|.
+func appendIngest(
+	ctx context.Context,
+	ajc *client.AppendService,
+	kv clientv3.KV,
+	prefix string,
+	mapping message.MappingFunc,
+	docs []flow.Mappable,
+) (pb.Offsets, error) {
+	var producer, intents, err = publishIngest(ajc, mapping, docs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The transaction is committed once its ACK intents are durable.
+	var key = ingestIntentsKey(prefix, producer)
+	if err = persistIngestIntents(ctx, kv, key, intents); err != nil {
+		return nil, fmt.Errorf("recording ACK intents: %w", err)
+	}
+
+	heads, err := ackIngest(ajc, intents)
+	if err != nil {
+		return nil, fmt.Errorf("transaction is committed, but not all ACKs were written (they're written upon recovery): %w", err)
+	}
+
+	// If we fail to remove the record, recovery will harmlessly write
+	// duplicate ACKs which readers ignore.
+	if _, err = kv.Delete(ctx, key); err != nil {
+		log.WithFields(log.Fields{"err": err, "key": key}).
+			Warn("failed to remove ACK intents of an acknowledged ingest transaction")
+	}
+	return heads, nil
+}
+
+// publishIngest publishes |docs| mapped by |mapping| as uncommitted messages
+// of a new and unique producer, awaits their appends, and returns the ACK
+// intents which will commit them.
+func publishIngest(ajc *client.AppendService, mapping message.MappingFunc, docs []flow.Mappable) (message.ProducerID, []message.AckIntent, error) {
+	// Each transaction uses a distinct Publisher and ProducerID, so that
+	// documents of a failed transaction are never acknowledged.
+	var pub = message.NewPublisher(ajc, nil)
+	var appends []*client.AsyncAppend
+
+	for _, doc := range docs {
+		var aa, err = pub.PublishUncommitted(mapping, doc)
+		if err != nil {
+			return message.ProducerID{}, nil, fmt.Errorf("publishing document: %w", err)
+		}
+		appends = append(appends, aa)
+	}
+
+	// Await all document appends before building ACK intents.
+	for _, aa := range appends {
+		if err := aa.Err(); err != nil {
+			return message.ProducerID{}, nil, fmt.Errorf("appending documents: %w", err)
+		}
+	}
+
+	intents, err := pub.BuildAckIntents()
+	if err != nil {
+		panic(err) // Cannot fail.
+	}
+	return pub.ProducerID(), intents, nil
+}
+
+// ackIngest appends each of |intents|, and returns the resulting write heads.
+func ackIngest(ajc *client.AppendService, intents []message.AckIntent) (pb.Offsets, error) {
+	var acks = make(map[pf.Journal]*client.AsyncAppend, len(intents))
+
+	for _, intent := range intents {
+		var aa = ajc.StartAppend(pb.AppendRequest{Journal: intent.Journal}, nil)
+		_, _ = aa.Writer().Write(intent.Intent)
+
+		if err := aa.Release(); err != nil {
+			panic(err) // Cannot fail (we never call Require).
+		}
+		acks[intent.Journal] = aa
+	}
+
+	var heads = make(pb.Offsets, len(acks))
+	for journal, aa := range acks {
+		if err := aa.Err(); err != nil {
+			return nil, fmt.Errorf("appending ACK of %s: %w", journal, err)
+		}
+		heads[journal] = aa.Response().Commit.End
+	}
+	return heads, nil
+}
+
+// persistIngestIntents durably records |intents| under Etcd |key|.
+func persistIngestIntents(ctx context.Context, kv clientv3.KV, key string, intents []message.AckIntent) error {
+	var b, err = json.Marshal(intents)
+	if err != nil {
+		panic(err) // Cannot fail.
+	}
+	_, err = kv.Put(ctx, key, string(b))
+	return err
+}
+
+// recoverIngest writes ACKs of each transaction having ACK intents recorded
+// under Etcd |prefix|, and then removes its record. A transaction may still be
+// in progress, in which case both it and recovery write its ACKs. This is
+// harmless, as its documents were durably appended before it was recorded
+// and readers ignore duplicate ACKs.
+func recoverIngest(ctx context.Context, kv clientv3.KV, prefix string, ajc *client.AppendService) error {
+	var resp, err = kv.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return fmt.Errorf("listing ACK intents: %w", err)
+	}
+
+	for _, record := range resp.Kvs {
+		var intents []message.AckIntent
+		if err := json.Unmarshal(record.Value, &intents); err != nil {
+			return fmt.Errorf("decoding ACK intents of %s: %w", record.Key, err)
+		}
+		heads, err := ackIngest(ajc, intents)
+		if err != nil {
+			return fmt.Errorf("writing ACKs of %s: %w", record.Key, err)
+		}
+		if _, err = kv.Delete(ctx, string(record.Key)); err != nil {
+			return fmt.Errorf("removing ACK intents of %s: %w", record.Key, err)
+		}
+
+		log.WithFields(log.Fields{
+			"key":   string(record.Key),
+			"heads": heads,
+		}).Info("recovered ACKs of committed ingest transaction")
+	}
+	return nil
+}
+
+// ingestIntentsKey returns the Etcd key of ACK intents of |producer|.
+func ingestIntentsKey(prefix string, producer message.ProducerID) string {
+	return prefix + hex.EncodeToString(producer[:])
+}
+
+// loadCollections loads the distinct collections of |txn| from its build,
+// in the order of their first appearance.
+func (api *IngestAPI) loadCollections(txn IngestTransaction) ([]*pf.CollectionSpec, error) {
+	var build = api.consumer.Builds.Open(txn.BuildID)
+	defer build.Close()
+
+	var collections []*pf.CollectionSpec
+	var index = make(map[pf.Collection]int)
+
+	var err = build.Extract(func(db *sql.DB) error {
+		for _, doc := range txn.Documents {
+			if _, ok := index[doc.Collection]; ok {
+				continue
+			}
+			var spec, err = catalog.LoadCollection(db, doc.Collection.String())
+			if err != nil {
+				return &invalidIngestError{fmt.Errorf("loading collection %q: %w", doc.Collection, err)}
+			}
+			index[doc.Collection] = len(collections)
+			collections = append(collections, spec)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return collections, build.Close()
+}
+
+// combine validates and combines the documents of |txn| over the
+// CollectionSpecs of their respective |collections|.
+func (api *IngestAPI) combine(ctx context.Context, txn IngestTransaction, collections []*pf.CollectionSpec) ([]*pr.CombineResponse, error) {
+	var open = &pr.CombineRequest_Open{}
+	var bindingOf = make(map[pf.Collection]uint32, len(collections))
+
+	for index, collection := range collections {
+		open.Bindings = append(open.Bindings, &pr.CombineRequest_Open_Binding{
+			Full:        false,
+			Key:         collection.Key,
+			Projections: collection.Projections,
+			SchemaJson:  collection.WriteSchemaJson,
+			SerPolicy:   nil,
+			UuidPtr:     collection.UuidPtr,
+			Values:      collection.PartitionFields,
+		})
+		bindingOf[collection.Name] = uint32(index)
+	}
+
+	var combineCtx, cancel = context.WithCancel(ctx)
+	defer cancel()
+
+	combiner, err := pr.NewCombinerClient(api.svc.Conn()).Combine(combineCtx)
+	if err != nil {
+		return nil, fmt.Errorf("creating combiner: %w", err)
+	}
+	_ = combiner.Send(&pr.CombineRequest{Open: open})
+
+	for _, doc := range txn.Documents {
+		var err = combiner.Send(&pr.CombineRequest{
+			Add: &pr.CombineRequest_Add{
+				Binding: bindingOf[doc.Collection],
+				DocJson: doc.Doc,
+				Front:   false,
+			},
+		})
+		if err != nil {
+			_, err = combiner.Recv()
+			return nil, err
+		}
+	}
+	_ = combiner.CloseSend()
+
+	var out []*pr.CombineResponse
+	for {
+		var response, err = combiner.Recv()
+		if err == io.EOF {
+			return out, nil
+		} else if err != nil {
+			return nil, err
+		}
+		out = append(out, response)
+	}
+}
+
+// invalidIngestError is an error due to an invalid IngestTransaction,
+// which failed before any of its documents were appended.
+type invalidIngestError struct{ err error }
+
+func (e *invalidIngestError) Error() string { return e.err.Error() }
+func (e *invalidIngestError) Unwrap() error { return e.err }
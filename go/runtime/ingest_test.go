@@ -0,0 +1,268 @@
+package runtime
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/estuary/flow/go/flow"
+	pf "github.com/estuary/flow/go/protocols/flow"
+	"github.com/stretchr/testify/require"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.gazette.dev/core/broker/client"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/brokertest"
+	"go.gazette.dev/core/etcdtest"
+	"go.gazette.dev/core/labels"
+	"go.gazette.dev/core/message"
+)
+
+func TestIngestRejectsRequests(t *testing.T) {
+	var api = &IngestAPI{token: "secret"}
+
+	for _, tc := range []struct {
+		method string
+		auth   string
+		body   string
+		status int
+		expect string
+	}{
+		{"GET", "Bearer secret", `{}`, http.StatusMethodNotAllowed, "expected POST"},
+		{"POST", "", `{}`, http.StatusUnauthorized, "invalid or missing bearer token"},
+		{"POST", "Bearer wrong", `{}`, http.StatusUnauthorized, "invalid or missing bearer token"},
+		{"POST", "Bearer secret", `{"documents": [`, http.StatusBadRequest, "decoding transaction"},
+		{"POST", "Bearer secret", `{"buildId": "a-build", "documents": []}`, http.StatusBadRequest, "transaction has no documents"},
+	} {
+		var req = httptest.NewRequest(tc.method, "/api/v1/ingest", strings.NewReader(tc.body))
+		if tc.auth != "" {
+			req.Header.Set("Authorization", tc.auth)
+		}
+		var rec = httptest.NewRecorder()
+		api.ServeHTTP(rec, req)
+
+		require.Equal(t, tc.status, rec.Code, tc)
+		require.Contains(t, rec.Body.String(), tc.expect, tc)
+	}
+
+	var _, err = NewIngestAPI(nil, nil, "")
+	require.EqualError(t, err, "an ingest API token is required")
+}
+
+func TestIngestAppendAndAck(t *testing.T) {
+	var ctx = pb.WithDispatchDefault(context.Background())
+	var etcd = etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var bk = brokertest.NewBroker(t, etcd, "local", "broker")
+	for _, name := range []pb.Journal{"a/journal", "b/journal"} {
+		brokertest.CreateJournals(t, bk, brokertest.Journal(pb.JournalSpec{
+			Name:     name,
+			LabelSet: pb.MustLabelSet(labels.ContentType, labels.ContentType_JSONLines),
+		}))
+	}
+	var ajc = client.NewAppendService(ctx, bk.Client())
+
+	var specA, specB = ingestTestSpec("a/collection"), ingestTestSpec("b/collection")
+	var mapping = func(m message.Mappable) (pb.Journal, string, error) {
+		switch m.(flow.Mappable).Spec {
+		case specA:
+			return "a/journal", labels.ContentType_JSONLines, nil
+		case specB:
+			return "b/journal", labels.ContentType_JSONLines, nil
+		default:
+			return "", "", errors.New("no such collection")
+		}
+	}
+
+	// Documents of a transaction are acknowledged only after all are appended.
+	var heads, err = appendIngest(ctx, ajc, etcd, ingestTestPrefix, mapping, []flow.Mappable{
+		ingestTestDoc(specA, 1),
+		ingestTestDoc(specB, 2),
+		ingestTestDoc(specA, 3),
+	})
+	require.NoError(t, err)
+
+	var a = readIngestTestJournal(t, ctx, bk, "a/journal", heads["a/journal"])
+	var b = readIngestTestJournal(t, ctx, bk, "b/journal", heads["b/journal"])
+	require.Len(t, heads, 2)
+
+	require.Equal(t, []ingestTestLine{
+		{ID: 1, Flags: message.Flag_CONTINUE_TXN},
+		{ID: 3, Flags: message.Flag_CONTINUE_TXN},
+		{Ack: true, Flags: message.Flag_ACK_TXN},
+	}, a.lines)
+	require.Equal(t, []ingestTestLine{
+		{ID: 2, Flags: message.Flag_CONTINUE_TXN},
+		{Ack: true, Flags: message.Flag_ACK_TXN},
+	}, b.lines)
+	// All documents and ACKs are of a single producer.
+	require.Equal(t, a.producers, b.producers)
+	require.Len(t, a.producers, 1)
+	// The record of its ACK intents was removed.
+	requireIngestTestIntents(t, ctx, etcd, 0)
+
+	// A transaction which fails to publish any document writes no ACKs.
+	_, err = appendIngest(ctx, ajc, etcd, ingestTestPrefix, mapping, []flow.Mappable{
+		ingestTestDoc(specA, 4),
+		ingestTestDoc(ingestTestSpec("c/collection"), 5),
+	})
+	require.EqualError(t, err, "publishing document: no such collection")
+	requireIngestTestIntents(t, ctx, etcd, 0)
+
+	// Document 4 was appended, but is never acknowledged.
+	a = readIngestTestJournal(t, ctx, bk, "a/journal", ingestTestHead(t, ajc, "a/journal"))
+	require.Equal(t, ingestTestLine{ID: 4, Flags: message.Flag_CONTINUE_TXN}, a.lines[len(a.lines)-1])
+
+	bk.Tasks.Cancel()
+	require.NoError(t, bk.Tasks.Wait())
+}
+
+func TestIngestRecoversAfterPartialAcks(t *testing.T) {
+	var ctx = pb.WithDispatchDefault(context.Background())
+	var etcd = etcdtest.TestClient()
+	defer etcdtest.Cleanup()
+
+	var bk = brokertest.NewBroker(t, etcd, "local", "broker")
+	for _, name := range []pb.Journal{"a/journal", "b/journal"} {
+		brokertest.CreateJournals(t, bk, brokertest.Journal(pb.JournalSpec{
+			Name:     name,
+			LabelSet: pb.MustLabelSet(labels.ContentType, labels.ContentType_JSONLines),
+		}))
+	}
+	var ajc = client.NewAppendService(ctx, bk.Client())
+
+	var specA, specB = ingestTestSpec("a/collection"), ingestTestSpec("b/collection")
+	var mapping = func(m message.Mappable) (pb.Journal, string, error) {
+		if m.(flow.Mappable).Spec == specA {
+			return "a/journal", labels.ContentType_JSONLines, nil
+		}
+		return "b/journal", labels.ContentType_JSONLines, nil
+	}
+
+	// Publish and commit a transaction, as appendIngest does.
+	var producer, intents, err = publishIngest(ajc, mapping, []flow.Mappable{
+		ingestTestDoc(specA, 1),
+		ingestTestDoc(specB, 2),
+	})
+	require.NoError(t, err)
+	require.Len(t, intents, 2)
+	require.NoError(t, persistIngestIntents(ctx, etcd, ingestIntentsKey(ingestTestPrefix, producer), intents))
+	requireIngestTestIntents(t, ctx, etcd, 1)
+
+	// Fail after writing only the first ACK.
+	if intents[0].Journal != "a/journal" {
+		intents[0], intents[1] = intents[1], intents[0]
+	}
+	_, err = ackIngest(ajc, intents[:1])
+	require.NoError(t, err)
+
+	// Readers observe the transaction in the first journal, but not the second.
+	var a = readIngestTestJournal(t, ctx, bk, "a/journal", ingestTestHead(t, ajc, "a/journal"))
+	var b = readIngestTestJournal(t, ctx, bk, "b/journal", ingestTestHead(t, ajc, "b/journal"))
+	require.Equal(t, []ingestTestLine{
+		{ID: 1, Flags: message.Flag_CONTINUE_TXN},
+		{Ack: true, Flags: message.Flag_ACK_TXN},
+	}, a.lines)
+	require.Equal(t, []ingestTestLine{
+		{ID: 2, Flags: message.Flag_CONTINUE_TXN},
+	}, b.lines)
+
+	// Recovery writes all ACKs of the committed transaction, and removes its record.
+	require.NoError(t, recoverIngest(ctx, etcd, ingestTestPrefix, ajc))
+	requireIngestTestIntents(t, ctx, etcd, 0)
+
+	a = readIngestTestJournal(t, ctx, bk, "a/journal", ingestTestHead(t, ajc, "a/journal"))
+	b = readIngestTestJournal(t, ctx, bk, "b/journal", ingestTestHead(t, ajc, "b/journal"))
+	require.Equal(t, []ingestTestLine{
+		{ID: 2, Flags: message.Flag_CONTINUE_TXN},
+		{Ack: true, Flags: message.Flag_ACK_TXN},
+	}, b.lines)
+	// The first journal has a duplicate ACK, which readers ignore.
+	require.Len(t, a.lines, 3)
+	require.Equal(t, a.lines[1], a.lines[2])
+	require.Equal(t, a.producers, b.producers)
+
+	// Recovery having no recorded transactions is a no-op.
+	require.NoError(t, recoverIngest(ctx, etcd, ingestTestPrefix, ajc))
+
+	bk.Tasks.Cancel()
+	require.NoError(t, bk.Tasks.Wait())
+}
+
+const ingestTestPrefix = "/ingest-test/ingest-intents/"
+
+// ingestTestHead returns the current write head of |journal|.
+func ingestTestHead(t *testing.T, ajc *client.AppendService, journal pb.Journal) pb.Offset {
+	var op = ajc.StartAppend(pb.AppendRequest{Journal: journal}, nil)
+	require.NoError(t, op.Release())
+	require.NoError(t, op.Err())
+	return op.Response().Commit.End
+}
+
+func requireIngestTestIntents(t *testing.T, ctx context.Context, etcd *clientv3.Client, expect int64) {
+	var resp, err = etcd.Get(ctx, ingestTestPrefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	require.NoError(t, err)
+	require.Equal(t, expect, resp.Count)
+}
+
+type ingestTestLine struct {
+	ID    int
+	Ack   bool
+	Flags message.Flags
+}
+
+type ingestTestJournal struct {
+	lines     []ingestTestLine
+	producers map[message.ProducerID]struct{}
+}
+
+func ingestTestSpec(name pf.Collection) *pf.CollectionSpec {
+	return &pf.CollectionSpec{
+		Name:            name,
+		AckTemplateJson: json.RawMessage(`{"_meta":{"uuid":"` + string(pf.DocumentUUIDPlaceholder) + `"},"ack":true}`),
+	}
+}
+
+func ingestTestDoc(spec *pf.CollectionSpec, id int) flow.Mappable {
+	var doc, _ = json.Marshal(map[string]interface{}{
+		"_meta": map[string]string{"uuid": string(pf.DocumentUUIDPlaceholder)},
+		"id":    id,
+	})
+	return flow.Mappable{Spec: spec, Doc: doc}
+}
+
+func readIngestTestJournal(t *testing.T, ctx context.Context, bk *brokertest.Broker, journal pb.Journal, end pb.Offset) ingestTestJournal {
+	var rr = client.NewReader(ctx, bk.Client(), pb.ReadRequest{Journal: journal, EndOffset: end})
+	var br = bufio.NewReader(rr)
+	var out = ingestTestJournal{producers: make(map[message.ProducerID]struct{})}
+
+	for {
+		var line, err = br.ReadBytes('\n')
+		if err == io.EOF || errors.Is(err, client.ErrOffsetNotYetAvailable) {
+			return out
+		}
+		require.NoError(t, err)
+
+		var doc struct {
+			Meta struct {
+				UUID message.UUID `json:"uuid"`
+			} `json:"_meta"`
+			ID  int  `json:"id"`
+			Ack bool `json:"ack"`
+		}
+		require.NoError(t, json.Unmarshal(line, &doc))
+
+		out.lines = append(out.lines, ingestTestLine{
+			ID:    doc.ID,
+			Ack:   doc.Ack,
+			Flags: message.GetFlags(doc.Meta.UUID),
+		})
+		out.producers[message.GetProducerID(doc.Meta.UUID)] = struct{}{}
+	}
+}
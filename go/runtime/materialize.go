@@ -6,6 +6,7 @@ import (
 	"io"
 
 	"github.com/estuary/flow/go/bindings"
+	"github.com/estuary/flow/go/flow"
 	"github.com/estuary/flow/go/protocols/catalog"
 	pf "github.com/estuary/flow/go/protocols/flow"
 	pm "github.com/estuary/flow/go/protocols/materialize"
@@ -22,6 +23,12 @@ type Materialize struct {
 	*taskReader[*pf.MaterializationSpec]
 	acknowledged *pf.AsyncOperation
 	client       pm.Connector_MaterializeClient
+	// persisted resolves when the recovery log writes of the prior transaction
+	// are durable and its Acknowledge has been sent. Loads of the next
+	// transaction are held in `pending` until it resolves, as the protocol
+	// requires that Acknowledge precede them.
+	persisted *pf.AsyncOperation
+	pending   []*pm.Request
 }
 
 var _ Application = (*Materialize)(nil)
@@ -115,6 +122,9 @@ func (m *Materialize) ConsumeMessage(shard consumer.Shard, envelope message.Enve
 		return nil // We just ignore the ACK documents.
 	}
 
+	// Loaded documents are combined by the runtime until the transaction is flushed.
+	m.memory.Acquire(flow.MemoryCombine, int64(len(docJson)+len(keyPacked)))
+
 	var request = &pm.Request{
 		Load: &pm.Request_Load{
 			Binding:   uint32(isr.ShuffleIndex),
@@ -122,6 +132,25 @@ func (m *Materialize) ConsumeMessage(shard consumer.Shard, envelope message.Enve
 			KeyPacked: keyPacked,
 		},
 	}
+
+	if m.persisted != nil {
+		select {
+		case <-m.persisted.Done():
+			if err := m.sendPending(); err != nil {
+				return err
+			}
+		default:
+			// The prior commit is still being persisted. Hold the Load
+			// so that we may continue to read the current transaction.
+			m.pending = append(m.pending, request)
+			return nil
+		}
+	}
+	return m.sendLoad(request)
+}
+
+// sendLoad sends a Load request of the current transaction.
+func (m *Materialize) sendLoad(request *pm.Request) error {
 	if m.client.Send(request) == io.EOF {
 		// We must await readAcknowledged() before attempting to read from `m.client`.
 		if m.acknowledged.Err() != nil {
@@ -130,11 +159,41 @@ func (m *Materialize) ConsumeMessage(shard consumer.Shard, envelope message.Enve
 		var _, err = doRecv[pm.Response](m.client)
 		return err
 	}
+	return nil
+}
+
+// sendPending awaits the persistence of the prior transaction,
+// and then sends Loads which were held while it was being persisted.
+func (m *Materialize) sendPending() error {
+	if err := m.persisted.Err(); err != nil {
+		return err
+	}
+	if len(m.pending) != 0 {
+		ops.PublishLog(m.publisher, ops.Log_debug,
+			"sending Loads held while the prior transaction was persisted",
+			"loads", len(m.pending),
+		)
+	}
+	for i, request := range m.pending {
+		if err := m.sendLoad(request); err != nil {
+			return err
+		}
+		m.pending[i] = nil
+	}
+	m.pending = m.pending[:0]
+	m.persisted = nil
 
 	return nil
 }
 
 func (m *Materialize) FinalizeTxn(shard consumer.Shard, pub *message.Publisher) error {
+	// Fence: the prior transaction must be persisted and its Acknowledge
+	// sent before we may send held Loads or a Flush.
+	if m.persisted != nil {
+		if err := m.sendPending(); err != nil {
+			return err
+		}
+	}
 	// Precondition: m.acknowledged has resolved successfully and m.client is not being read.
 
 	// Send Flush and await Flushed response.
@@ -149,6 +208,8 @@ func (m *Materialize) FinalizeTxn(shard consumer.Shard, pub *message.Publisher)
 		return fmt.Errorf("expected Flushed (got %#v)", resp)
 	}
 
+	m.memory.ReleaseAll(flow.MemoryCombine)
+
 	var flushedExt = pr.FromInternal[pr.MaterializeResponseExt](resp.Internal)
 	if err := m.publisher.PublishStats(*flushedExt.Flushed.Stats, pub.PublishUncommitted); err != nil {
 		return fmt.Errorf("publishing stats: %w", err)
@@ -181,24 +242,15 @@ func (m *Materialize) StartCommit(shard consumer.Shard, cp pf.Checkpoint, waitFo
 		return client.FinishedOperation(fmt.Errorf("expected StartedCommit, but got %#v", started))
 	}
 
-	// Synchronously wait for another barrier which notifies when recovery log
-	// writes have been durably recorded. This should be fast (milliseconds)
-	// because we're only writing state & checkpoint updates.
+	// Install another barrier which notifies when recovery log writes have
+	// been durably recorded. Rather than synchronously awaiting it, we
+	// persist and acknowledge in the background so that the next transaction
+	// may begin to read while this one is still being persisted.
 	var barrier = m.recorder.Barrier(nil)
-	if barrier.Err() != nil {
-		return barrier
-	}
 
-	// Send Acknowledge.
-	if err := doSend[pm.Response](m.client, &pm.Request{
-		Acknowledge: &pm.Request_Acknowledge{},
-	}); err != nil {
-		return client.FinishedOperation(err)
-	}
-
-	// Start async read of Acknowledged.
+	m.persisted = pf.NewAsyncOperation()
 	m.acknowledged = pf.NewAsyncOperation()
-	go readAcknowledged(m.client, m.acknowledged)
+	go persistAndAcknowledge(m.client, barrier, m.persisted, m.acknowledged)
 
 	// Return `opAcknowledged` so that the next transaction will remain open
 	// so long as the driver is still committing the current transaction.
@@ -216,6 +268,30 @@ func (m *Materialize) Destroy() {
 func (m *Materialize) BeginTxn(shard consumer.Shard) error                    { return nil } // No-op.
 func (m *Materialize) FinishedTxn(shard consumer.Shard, op consumer.OpFuture) {}             // No-op.
 
+// persistAndAcknowledge awaits the durable recording of the |barrier|,
+// sends Acknowledge and resolves |persisted|, and then reads Acknowledged.
+func persistAndAcknowledge(
+	client pm.Connector_MaterializeClient,
+	barrier consumer.OpFuture,
+	persisted *pf.AsyncOperation,
+	acknowledged *pf.AsyncOperation,
+) {
+	var err = barrier.Err()
+
+	if err == nil {
+		err = doSend[pm.Response](client, &pm.Request{
+			Acknowledge: &pm.Request_Acknowledge{},
+		})
+	}
+	persisted.Resolve(err)
+
+	if err != nil {
+		acknowledged.Resolve(err)
+	} else {
+		_ = readAcknowledged(client, acknowledged)
+	}
+}
+
 func readAcknowledged(
 	client pm.Connector_MaterializeClient,
 	acknowledged *pf.AsyncOperation,
@@ -0,0 +1,92 @@
+package runtime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	pf "github.com/estuary/flow/go/protocols/flow"
+	pm "github.com/estuary/flow/go/protocols/materialize"
+	"github.com/stretchr/testify/require"
+	"go.gazette.dev/core/broker/client"
+	"google.golang.org/grpc"
+)
+
+func TestMaterializeCommitAwaitsAcknowledged(t *testing.T) {
+	var stream = newTestMaterializeStream()
+	var barrier = client.NewAsyncOperation()
+	var persisted, acknowledged = pf.NewAsyncOperation(), pf.NewAsyncOperation()
+
+	go persistAndAcknowledge(stream, barrier, persisted, acknowledged)
+
+	// Nothing happens until recovery log writes are durable.
+	requireNotResolved(t, persisted)
+	requireNotResolved(t, acknowledged)
+	require.Empty(t, stream.sent)
+
+	// Once durable, Acknowledge is sent and `persisted` resolves, which
+	// permits Loads of the next transaction to be sent.
+	barrier.Resolve(nil)
+	require.NoError(t, persisted.Err())
+	require.Equal(t, &pm.Request{Acknowledge: &pm.Request_Acknowledge{}}, <-stream.sent)
+
+	// The commit doesn't resolve until Acknowledged is received.
+	requireNotResolved(t, acknowledged)
+	stream.recv <- &pm.Response{Acknowledged: &pm.Response_Acknowledged{}}
+	require.NoError(t, acknowledged.Err())
+}
+
+func TestMaterializeCommitErrors(t *testing.T) {
+	// A failure to persist fails the commit, and Acknowledge isn't sent.
+	var stream = newTestMaterializeStream()
+	var persisted, acknowledged = pf.NewAsyncOperation(), pf.NewAsyncOperation()
+
+	go persistAndAcknowledge(stream, client.FinishedOperation(errors.New("whoops")), persisted, acknowledged)
+
+	require.EqualError(t, persisted.Err(), "whoops")
+	require.EqualError(t, acknowledged.Err(), "whoops")
+	require.Empty(t, stream.sent)
+
+	// A response other than Acknowledged fails the commit.
+	stream = newTestMaterializeStream()
+	persisted, acknowledged = pf.NewAsyncOperation(), pf.NewAsyncOperation()
+
+	go persistAndAcknowledge(stream, client.FinishedOperation(nil), persisted, acknowledged)
+
+	require.NoError(t, persisted.Err())
+	<-stream.sent
+	stream.recv <- &pm.Response{Flushed: &pm.Response_Flushed{}}
+	require.ErrorContains(t, acknowledged.Err(), "expected Acknowledged")
+}
+
+func requireNotResolved(t *testing.T, op *pf.AsyncOperation) {
+	select {
+	case <-op.Done():
+		t.Fatalf("operation resolved unexpectedly (err: %v)", op.Err())
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+// testMaterializeStream is a pm.Connector_MaterializeClient which records
+// sent requests, and returns responses that are sent to `recv`.
+type testMaterializeStream struct {
+	grpc.ClientStream
+	sent chan *pm.Request
+	recv chan *pm.Response
+}
+
+func newTestMaterializeStream() *testMaterializeStream {
+	return &testMaterializeStream{
+		sent: make(chan *pm.Request, 8),
+		recv: make(chan *pm.Response),
+	}
+}
+
+func (s *testMaterializeStream) Send(request *pm.Request) error {
+	s.sent <- request
+	return nil
+}
+
+func (s *testMaterializeStream) Recv() (*pm.Response, error) {
+	return <-s.recv, nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -27,6 +28,11 @@ type OpsPublisher struct {
 	opsLogsSpec  *pf.CollectionSpec
 	opsStatsSpec *pf.CollectionSpec
 	shard        *ops.ShardRef
+
+	// Appends of published logs which haven't yet completed, in publication order.
+	logAppends []client.OpFuture
+	// Number of logs dropped because too many appends were pending.
+	droppedLogs int
 }
 
 var _ ops.Publisher = &OpsPublisher{}
@@ -100,6 +106,33 @@ func (p *OpsPublisher) PublishStats(
 }
 
 func (p *OpsPublisher) PublishLog(out ops.Log) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.reapLogAppendsLocked()
+
+	if len(p.logAppends) >= maxPendingLogAppends {
+		// Journals of the logs collection are likely unavailable (e.x. due to a
+		// broker restart). Rather than buffer without bound, drop the log and
+		// report the count of dropped logs once appends are progressing again.
+		p.droppedLogs++
+		return
+	} else if p.droppedLogs != 0 {
+		p.publishLogLocked(ops.Log{
+			Shard:     out.Shard,
+			Timestamp: out.Timestamp,
+			Level:     ops.Log_warn,
+			Message:   "dropped logs while ops log journals were unavailable",
+			FieldsJsonMap: map[string]json.RawMessage{
+				"dropped": json.RawMessage(strconv.Itoa(p.droppedLogs)),
+			},
+		})
+		p.droppedLogs = 0
+	}
+	p.publishLogLocked(out)
+}
+
+func (p *OpsPublisher) publishLogLocked(out ops.Log) {
 	var key, partitions = shardKeyAndPartitions(out.Shard, out.Timestamp)
 	out.Meta = &ops.Meta{Uuid: string(pf.DocumentUUIDPlaceholder)}
 
@@ -108,9 +141,6 @@ func (p *OpsPublisher) PublishLog(out ops.Log) {
 		panic(fmt.Errorf("marshal of *ops.Log should always succeed but: %w", err))
 	}
 
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
 	var msg = flow.Mappable{
 		Spec:       p.opsLogsSpec,
 		Doc:        json.RawMessage(buf.Bytes()),
@@ -118,9 +148,32 @@ func (p *OpsPublisher) PublishLog(out ops.Log) {
 		PackedKey:  key.Pack(),
 	}
 	// Best effort. PublishCommitted only fails if the publisher itself is cancelled.
-	_, _ = p.logsPublisher.PublishCommitted(p.mapper.Map, msg)
+	if aa, err := p.logsPublisher.PublishCommitted(p.mapper.Map, msg); err == nil {
+		p.logAppends = append(p.logAppends, aa)
+	}
 }
 
+// reapLogAppendsLocked pops completed appends of published logs.
+// Appends complete in the order they were published.
+func (p *OpsPublisher) reapLogAppendsLocked() {
+	for len(p.logAppends) != 0 {
+		select {
+		case <-p.logAppends[0].Done():
+			p.logAppends[0] = nil
+			p.logAppends = p.logAppends[1:]
+		default:
+			return
+		}
+	}
+}
+
+// maxPendingLogAppends bounds the number of published logs of a task
+// which may be buffered while awaiting their append. Appends are retried
+// until they succeed (by the flow.AppendRetryClient of the FlowConsumer,
+// and then by the AppendService), so while brokers are unavailable pending
+// appends accumulate rather than fail.
+var maxPendingLogAppends = 10000
+
 func shardKeyAndPartitions(shard *ops.ShardRef, ts *types.Timestamp) (tuple.Tuple, tuple.Tuple) {
 	var key = tuple.Tuple{
 		shard.Name,
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"sync/atomic"
 
+	"github.com/estuary/flow/go/flow"
 	pf "github.com/estuary/flow/go/protocols/flow"
 	"github.com/estuary/flow/go/protocols/ops"
 	"github.com/prometheus/client_golang/prometheus"
@@ -56,7 +57,7 @@ func (ps *proxyServer) Proxy(client pf.NetworkProxy_ProxyServer) (_err error) {
 	}
 
 	// Resolve the target port to the current container.
-	var container, publisher = resolution.Store.(Application).proxyHook()
+	var container, publisher, memory = resolution.Store.(Application).proxyHook()
 	resolution.Done()
 
 	if tr, ok := trace.FromContext(ctx); ok {
@@ -82,7 +83,8 @@ func (ps *proxyServer) Proxy(client pf.NetworkProxy_ProxyServer) (_err error) {
 		if m, ok := container.MappedHostPorts[open.Open.TargetPort]; ok {
 			address = m
 		} else {
-			address = fmt.Sprintf("%s:%d", container.IpAddr, open.Open.TargetPort)
+			// IpAddr may be an IPv6 address, which must be bracketed.
+			address = net.JoinHostPort(container.IpAddr, strconv.Itoa(int(open.Open.TargetPort)))
 		}
 		break
 	}
@@ -91,6 +93,19 @@ func (ps *proxyServer) Proxy(client pf.NetworkProxy_ProxyServer) (_err error) {
 		return client.Send(opened)
 	}
 
+	// Refuse new connections while the task is over its memory budget,
+	// rather than risk its termination by the OOM killer.
+	if memory.Exceeded() {
+		ops.PublishLog(publisher, ops.Log_warn,
+			"refusing TCP proxy connection because the task is over its memory limit",
+			"clientAddr", open.Open.ClientAddr,
+			"targetPort", open.Open.TargetPort,
+			"usage", memory.Usage(),
+		)
+		opened.OpenResponse.Status = pf.TaskNetworkProxyResponse_INTERNAL_ERROR
+		return client.Send(opened)
+	}
+
 	// Dial the container.
 	var dialer net.Dialer
 	dialed, err := dialer.DialContext(ctx, "tcp", address)
@@ -157,7 +172,10 @@ func (ps *proxyServer) Proxy(client pf.NetworkProxy_ProxyServer) (_err error) {
 	// Backward loop that proxies from `delegate` => `client`.
 	// When this loop completes, so does the Proxy RPC.
 
-	var buffer = make([]byte, 1<<14) // 16KB.
+	var buffer = make([]byte, proxyBufferSize)
+	memory.Acquire(flow.MemoryProxy, proxyBufferSize)
+	defer memory.Release(flow.MemoryProxy, proxyBufferSize)
+
 	var counter = proxyConnBytesOutboundCounter.WithLabelValues(labels...)
 	for {
 		if n, err := delegate.Read(buffer); err == io.EOF {
@@ -220,3 +238,6 @@ var proxyConnBytesOutboundCounter = promauto.NewCounterVec(prometheus.CounterOpt
 
 // See crates/runtime/src/container.rs
 const connectorInitPort = 49092
+
+// proxyBufferSize is the size of the buffer used by each proxy connection.
+const proxyBufferSize = 1 << 14 // 16KB.
@@ -0,0 +1,352 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/estuary/flow/go/flow"
+	"github.com/estuary/flow/go/labels"
+	pd "github.com/estuary/flow/go/protocols/derive"
+	"github.com/estuary/flow/go/protocols/fdb/tuple"
+	pf "github.com/estuary/flow/go/protocols/flow"
+	"github.com/estuary/flow/go/protocols/ops"
+	pr "github.com/estuary/flow/go/protocols/runtime"
+	log "github.com/sirupsen/logrus"
+	pb "go.gazette.dev/core/broker/protocol"
+	"go.gazette.dev/core/consumer"
+	pc "go.gazette.dev/core/consumer/protocol"
+)
+
+// QueryRequest is a read-only SQL query of the SQLite state of a derivation shard.
+type QueryRequest struct {
+	// Derivation having SQLite state to query.
+	Derivation pf.Collection `json:"derivation"`
+	// Key is a shuffle key of the derivation, which routes the query to
+	// the shard responsible for the key. Key may be omitted if the
+	// derivation has only one shard.
+	Key []json.RawMessage `json:"key,omitempty"`
+	// Shard to query. Shard is set when proxying a query to the peer which
+	// is primary for the shard, and is otherwise determined from Key.
+	Shard pc.ShardID `json:"shard,omitempty"`
+	// Query is the SQL query to execute.
+	Query string `json:"query"`
+	// Params are bound parameters of the Query.
+	Params []interface{} `json:"params,omitempty"`
+	// ReadThrough is journals and offsets of prior appends, such as the
+	// JournalWriteHeads of an IngestResponse, which the shard must have read
+	// through before the query is executed. It allows a caller to read its
+	// own writes.
+	ReadThrough pb.Offsets `json:"readThrough,omitempty"`
+}
+
+// QueryResponse is the result of a QueryRequest.
+type QueryResponse struct {
+	// Shard which executed the query.
+	Shard pc.ShardID `json:"shard"`
+	// Columns of the query result.
+	Columns []string `json:"columns"`
+	// Rows of the query result.
+	Rows [][]interface{} `json:"rows"`
+}
+
+// QueryAPI serves read-only queries of the SQLite state of derivation shards.
+// Queries are routed to the shard which is responsible for the given shuffle
+// key, and are proxied to the peer which is shard primary if required.
+// Requests must present the configured bearer token.
+type QueryAPI struct {
+	consumer *FlowConsumer
+	token    string
+}
+
+// queryAPIPath is the HTTP path at which the QueryAPI is served.
+const queryAPIPath = "/api/v1/query"
+
+// maxQueryRows bounds the number of rows returned by a QueryRequest.
+var maxQueryRows = 10000
+
+// maxQueryDuration bounds the time for which a QueryRequest may wait for
+// the SQLite state of a derivation, while its transactions are running.
+var maxQueryDuration = 30 * time.Second
+
+// maxQueryExecution bounds the execution of a QueryRequest, which delays
+// the next transaction of the derivation until the query completes.
+var maxQueryExecution = time.Second
+
+// NewQueryAPI builds a *QueryAPI which authenticates requests with |token|.
+func NewQueryAPI(consumer *FlowConsumer, token string) (*QueryAPI, error) {
+	if token == "" {
+		return nil, fmt.Errorf("a query API token is required")
+	}
+	return &QueryAPI{consumer: consumer, token: token}, nil
+}
+
+// ServeHTTP executes a JSON-encoded QueryRequest POSTed as the request body,
+// and responds with a JSON-encoded QueryResponse.
+func (api *QueryAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "expected POST", http.StatusMethodNotAllowed)
+		return
+	}
+	var bearer = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(bearer), []byte(api.token)) != 1 {
+		http.Error(w, "invalid or missing bearer token", http.StatusUnauthorized)
+		return
+	}
+
+	var req QueryRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("decoding query: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var resp, status, err = api.serve(r.Context(), req, r.Header.Get("Authorization"))
+	if err != nil {
+		log.WithFields(log.Fields{
+			"err":        err,
+			"derivation": req.Derivation,
+			"shard":      req.Shard,
+		}).Debug("failed to serve derivation query")
+
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (api *QueryAPI) serve(ctx context.Context, req QueryRequest, authorization string) (*QueryResponse, int, error) {
+	if req.Query == "" {
+		return nil, http.StatusBadRequest, fmt.Errorf("query is required")
+	}
+
+	var proxied = req.Shard != ""
+	if !proxied {
+		var shard, err = api.route(ctx, req.Derivation, req.Key)
+		if err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+		req.Shard = shard
+	}
+
+	var res, err = api.consumer.Service.Resolver.Resolve(consumer.ResolveArgs{
+		Context:     ctx,
+		ShardID:     req.Shard,
+		MayProxy:    !proxied,
+		ReadThrough: req.ReadThrough,
+	})
+	if err != nil {
+		return nil, http.StatusInternalServerError, fmt.Errorf("resolving shard %s: %w", req.Shard, err)
+	} else if res.Status != pc.Status_OK {
+		return nil, http.StatusServiceUnavailable, fmt.Errorf("shard %s !OK status %s", req.Shard, res.Status)
+	} else if res.Store == nil {
+		// Another peer is primary for the shard.
+		var route = res.Header.Route
+		return proxyQuery(ctx, route.Endpoints[route.Primary], req, authorization)
+	}
+	defer res.Done()
+
+	var derive, ok = res.Store.(*Derive)
+	if !ok {
+		return nil, http.StatusBadRequest, fmt.Errorf("shard %s is not a derivation", req.Shard)
+	}
+	resp, err := derive.Query(ctx, req.Query, req.Params...)
+	if errors.Is(err, errNotSQLiteDerivation) {
+		return nil, http.StatusBadRequest, err
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return nil, http.StatusGatewayTimeout, err
+	} else if err != nil {
+		return nil, http.StatusUnprocessableEntity, err
+	}
+	resp.Shard = req.Shard
+
+	return resp, http.StatusOK, nil
+}
+
+// route returns the shard of |derivation| which is responsible for shuffle |key|.
+func (api *QueryAPI) route(ctx context.Context, derivation pf.Collection, key []json.RawMessage) (pc.ShardID, error) {
+	var listing, err = consumer.ShardList(ctx, api.consumer.Service, &pc.ListRequest{
+		Selector: pb.LabelSelector{
+			Include: pb.MustLabelSet(
+				labels.TaskName, derivation.String(),
+				labels.TaskType, ops.TaskType_derivation.String(),
+			),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list shards: %w", err)
+	}
+	return routeKey(derivation, listing.Shards, key)
+}
+
+// routeKey returns the one of |shards| of |derivation| having a key range
+// which covers the hash of shuffle |key|.
+func routeKey(derivation pf.Collection, shards []pc.ListResponse_Shard, key []json.RawMessage) (pc.ShardID, error) {
+	if len(shards) == 0 {
+		return "", fmt.Errorf("derivation %s has no shards", derivation)
+	} else if len(key) == 0 {
+		if len(shards) != 1 {
+			return "", fmt.Errorf("derivation %s has %d shards, and a key is required to route the query", derivation, len(shards))
+		}
+		return shards[0].Spec.Id, nil
+	}
+
+	var elements tuple.Tuple
+	for _, raw := range key {
+		var element, err = keyElement(raw)
+		if err != nil {
+			return "", err
+		}
+		elements = append(elements, element)
+	}
+	var keyHash = flow.PackedKeyHash_HH64(elements.Pack())
+
+	var matched []pc.ShardID
+	for _, shard := range shards {
+		var spec, err = labels.ParseRangeSpec(shard.Spec.LabelSet)
+		if err != nil {
+			return "", fmt.Errorf("parsing range of shard %s: %w", shard.Spec.Id, err)
+		}
+		if keyHash >= spec.KeyBegin && keyHash <= spec.KeyEnd {
+			matched = append(matched, shard.Spec.Id)
+		}
+	}
+	if len(matched) != 1 {
+		return "", fmt.Errorf("expected one shard of %s to cover key hash %08x, but found %v", derivation, keyHash, matched)
+	}
+	return matched[0], nil
+}
+
+// keyElement maps a JSON-encoded shuffle key component into a tuple element.
+func keyElement(raw json.RawMessage) (tuple.TupleElement, error) {
+	var d = json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, fmt.Errorf("decoding key component: %w", err)
+	}
+
+	switch vv := v.(type) {
+	case nil, bool, string:
+		return vv, nil
+	case json.Number:
+		if i, err := vv.Int64(); err == nil {
+			return i, nil
+		} else if f, err := vv.Float64(); err == nil {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("key component %s must be a string, integer, boolean, or null", string(raw))
+}
+
+// proxyQuery forwards |req| to the consumer peer at |endpoint|.
+func proxyQuery(ctx context.Context, endpoint pb.Endpoint, req QueryRequest, authorization string) (*QueryResponse, int, error) {
+	var body, err = json.Marshal(req)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	var url = endpoint.URL()
+	url.Path = queryAPIPath
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	httpReq.Header.Set("Authorization", authorization)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, http.StatusBadGateway, fmt.Errorf("proxying query to %s: %w", endpoint, err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		var msg bytes.Buffer
+		_, _ = msg.ReadFrom(httpResp.Body)
+		return nil, httpResp.StatusCode, fmt.Errorf("proxied query failed: %s", strings.TrimSpace(msg.String()))
+	}
+
+	var resp = new(QueryResponse)
+	if err = json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return nil, http.StatusBadGateway, fmt.Errorf("decoding proxied query response: %w", err)
+	}
+	return resp, http.StatusOK, nil
+}
+
+// Query executes a read-only |query| against the SQLite state of the Derive.
+// Queries are serialized with derivation transactions, and always observe
+// the state of the last committed transaction. The query is run by the
+// derivation connector over its connection to the state, and is interrupted
+// if it runs for longer than maxQueryExecution.
+func (d *Derive) Query(ctx context.Context, query string, params ...interface{}) (_ *QueryResponse, err error) {
+	if d.sqlite == nil {
+		return nil, errNotSQLiteDerivation
+	}
+	if params == nil {
+		params = []interface{}{}
+	}
+	paramsJson, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("encoding query parameters: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, maxQueryDuration)
+	defer cancel()
+
+	select {
+	case d.queryLock <- struct{}{}:
+		defer func() { <-d.queryLock }()
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("query didn't complete within %s: %w", maxQueryDuration, ctx.Err())
+		}
+		return nil, ctx.Err()
+	}
+
+	// The derivation is between transactions, and its stream is idle.
+	// Once sent, the query's response must be read regardless of |ctx|
+	// to keep the stream in sync. Its execution is bounded by the connector.
+	if err = doSend[pd.Response](d.client, &pd.Request{
+		Internal: pr.ToInternal(&pr.DeriveRequestExt{
+			Query: &pr.DeriveRequestExt_Query{
+				Sql:        query,
+				ParamsJson: string(paramsJson),
+				TimeoutMs:  uint32(maxQueryExecution.Milliseconds()),
+				MaxRows:    uint32(maxQueryRows),
+			},
+		}),
+	}); err != nil {
+		return nil, err
+	}
+	response, err := doRecv[pd.Response](d.client)
+	if err != nil {
+		return nil, err
+	}
+
+	var queried = pr.FromInternal[pr.DeriveResponseExt](response.Internal).Queried
+	if queried == nil {
+		return nil, fmt.Errorf("expected Queried, but got %#v", response)
+	} else if queried.Error != "" {
+		return nil, errors.New(queried.Error)
+	}
+
+	var resp = &QueryResponse{Columns: queried.Columns, Rows: [][]interface{}{}}
+	var dec = json.NewDecoder(strings.NewReader(queried.RowsJson))
+	dec.UseNumber() // Preserve the precision of INTEGER columns.
+
+	if err = dec.Decode(&resp.Rows); err != nil {
+		return nil, fmt.Errorf("decoding query rows: %w", err)
+	}
+	return resp, nil
+}
+
+var errNotSQLiteDerivation = errors.New("derivation doesn't use SQLite")
@@ -0,0 +1,246 @@
+package runtime
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/estuary/flow/go/bindings"
+	"github.com/estuary/flow/go/labels"
+	"github.com/estuary/flow/go/protocols/catalog"
+	pd "github.com/estuary/flow/go/protocols/derive"
+	pf "github.com/estuary/flow/go/protocols/flow"
+	"github.com/estuary/flow/go/protocols/ops"
+	pr "github.com/estuary/flow/go/protocols/runtime"
+	"github.com/stretchr/testify/require"
+	pb "go.gazette.dev/core/broker/protocol"
+	pc "go.gazette.dev/core/consumer/protocol"
+	store_sqlite "go.gazette.dev/core/consumer/store-sqlite"
+)
+
+func TestQueryRejectsRequests(t *testing.T) {
+	var api = &QueryAPI{token: "secret"}
+
+	for _, tc := range []struct {
+		method string
+		auth   string
+		body   string
+		status int
+		expect string
+	}{
+		{"GET", "Bearer secret", `{}`, http.StatusMethodNotAllowed, "expected POST"},
+		{"POST", "", `{}`, http.StatusUnauthorized, "invalid or missing bearer token"},
+		{"POST", "Bearer wrong", `{}`, http.StatusUnauthorized, "invalid or missing bearer token"},
+		{"POST", "Bearer secret", `{"query": `, http.StatusBadRequest, "decoding query"},
+		{"POST", "Bearer secret", `{"derivation": "a/derivation"}`, http.StatusBadRequest, "query is required"},
+	} {
+		var req = httptest.NewRequest(tc.method, queryAPIPath, strings.NewReader(tc.body))
+		if tc.auth != "" {
+			req.Header.Set("Authorization", tc.auth)
+		}
+		var rec = httptest.NewRecorder()
+		api.ServeHTTP(rec, req)
+
+		require.Equal(t, tc.status, rec.Code, tc)
+		require.Contains(t, rec.Body.String(), tc.expect, tc)
+	}
+
+	var _, err = NewQueryAPI(nil, "")
+	require.EqualError(t, err, "a query API token is required")
+}
+
+func TestQueryRoutesByKeyRange(t *testing.T) {
+	var shard = func(id pc.ShardID, begin, end uint32) pc.ListResponse_Shard {
+		return pc.ListResponse_Shard{Spec: pc.ShardSpec{
+			Id: id,
+			LabelSet: labels.EncodeRange(pf.RangeSpec{
+				KeyBegin:    begin,
+				KeyEnd:      end,
+				RClockBegin: 0,
+				RClockEnd:   ^uint32(0),
+			}, pf.LabelSet{}),
+		}}
+	}
+	var key = func(raw ...string) (out []json.RawMessage) {
+		for _, r := range raw {
+			out = append(out, json.RawMessage(r))
+		}
+		return
+	}
+	var one = []pc.ListResponse_Shard{shard("one", 0, ^uint32(0))}
+	var split = []pc.ListResponse_Shard{
+		shard("lower", 0, 0x7fffffff),
+		shard("upper", 0x80000000, ^uint32(0)),
+	}
+
+	for _, tc := range []struct {
+		shards []pc.ListResponse_Shard
+		key    []json.RawMessage
+		expect pc.ShardID
+	}{
+		// A derivation having one shard doesn't require a key.
+		{one, nil, "one"},
+		{one, key(`"any"`), "one"},
+		// Keys are routed to the shard which covers their hash.
+		{split, key(`"a"`, `1`), "upper"},
+		{split, key(`"f"`, `1`), "lower"},
+		{split, key(`true`, `null`), "upper"},
+		{split, key(`false`, `null`), "lower"},
+		{split, key(`2.5`), "upper"},
+		{split, key(`1`), "lower"},
+	} {
+		var routed, err = routeKey("a/derivation", tc.shards, tc.key)
+		require.NoError(t, err, tc.key)
+		require.Equal(t, tc.expect, routed, tc.key)
+	}
+
+	for _, tc := range []struct {
+		shards []pc.ListResponse_Shard
+		key    []json.RawMessage
+		expect string
+	}{
+		{nil, nil, "derivation a/derivation has no shards"},
+		{split, nil, "derivation a/derivation has 2 shards, and a key is required to route the query"},
+		{split, key(`{"an": "object"}`), `key component {"an": "object"} must be a string, integer, boolean, or null`},
+		{split, key(`"a`), "decoding key component: unexpected EOF"},
+		// A gap or overlap in the ranges of shards is an error.
+		{split[:1], key(`"a"`, `1`), "expected one shard of a/derivation to cover key hash"},
+		{append(split, one...), key(`"a"`, `1`), "expected one shard of a/derivation to cover key hash"},
+	} {
+		var _, err = routeKey("a/derivation", tc.shards, tc.key)
+		require.ErrorContains(t, err, tc.expect, tc.key)
+	}
+}
+
+func TestQueryTimesOutAwaitingState(t *testing.T) {
+	defer func(d time.Duration) { maxQueryDuration = d }(maxQueryDuration)
+	maxQueryDuration = 10 * time.Millisecond
+
+	var d = &Derive{
+		sqlite:    new(store_sqlite.Store),
+		queryLock: make(chan struct{}, 1),
+	}
+	d.queryLock <- struct{}{} // Held by a long-running transaction.
+
+	var _, err = d.Query(context.Background(), "SELECT 1")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.EqualError(t, err, "query didn't complete within 10ms: context deadline exceeded")
+
+	// The lock remains held only by the transaction.
+	require.Len(t, d.queryLock, 1)
+}
+
+func TestQueryOfSQLiteDerivation(t *testing.T) {
+	var args = bindings.BuildArgs{
+		Context:  context.Background(),
+		FileRoot: "./testdata",
+		BuildAPI_Config: pf.BuildAPI_Config{
+			BuildId:    "fixture",
+			BuildDb:    path.Join(t.TempDir(), "build.db"),
+			Source:     "file:///query.flow.yaml",
+			SourceType: pf.ContentType_CATALOG,
+		}}
+	require.NoError(t, bindings.BuildCatalog(args))
+
+	var collection *pf.CollectionSpec
+	require.NoError(t, catalog.Extract(args.BuildDb, func(db *sql.DB) (err error) {
+		collection, err = catalog.LoadCollection(db, "a/derivation")
+		return err
+	}))
+
+	var svc, err = bindings.NewTaskService(
+		pr.TaskServiceConfig{
+			TaskName: "a/derivation",
+			UdsPath:  path.Join(t.TempDir(), "sock"),
+		},
+		ops.NewLocalPublisher(ops.ShardLabeling{TaskName: "a/derivation"}),
+	)
+	require.NoError(t, err)
+	defer svc.Drop()
+
+	stream, err := pd.NewConnectorClient(svc.Conn()).Derive(context.Background())
+	require.NoError(t, err)
+
+	// Open without a DeriveRequestExt, which uses an in-memory SQLite database.
+	require.NoError(t, stream.Send(&pd.Request{
+		Open: &pd.Request_Open{
+			Collection: collection,
+			Version:    "fixture",
+			Range: &pf.RangeSpec{
+				KeyEnd:    math.MaxUint32,
+				RClockEnd: math.MaxUint32,
+			},
+			StateJson: []byte("{}"),
+		},
+	}))
+	opened, err := stream.Recv()
+	require.NoError(t, err)
+	require.NotNil(t, opened.Opened)
+
+	var d = &Derive{
+		client:    stream,
+		sqlite:    new(store_sqlite.Store),
+		queryLock: make(chan struct{}, 1),
+	}
+	var transaction = func(docs ...string) {
+		for _, doc := range docs {
+			require.NoError(t, stream.Send(&pd.Request{
+				Read: &pd.Request_Read{Transform: 0, DocJson: []byte(doc)},
+			}))
+		}
+		require.NoError(t, stream.Send(&pd.Request{Flush: &pd.Request_Flush{}}))
+
+		for {
+			var response, err = stream.Recv()
+			require.NoError(t, err)
+
+			if response.Flushed != nil {
+				break
+			}
+			require.NotNil(t, response.Published)
+		}
+
+		require.NoError(t, stream.Send(&pd.Request{StartCommit: &pd.Request_StartCommit{
+			RuntimeCheckpoint: &pc.Checkpoint{
+				Sources: map[pb.Journal]pc.Checkpoint_Source{"a/journal": {ReadThrough: 123}},
+			},
+		}}))
+		startedCommit, err := stream.Recv()
+		require.NoError(t, err)
+		require.NotNil(t, startedCommit.StartedCommit)
+	}
+	var ctx = context.Background()
+
+	transaction(`{"key":"a","val":1}`, `{"key":"b","val":2}`, `{"key":"a","val":3}`)
+
+	resp, err := d.Query(ctx, "SELECT key, total FROM totals WHERE total > ? ORDER BY key", 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"key", "total"}, resp.Columns)
+	require.Equal(t, [][]interface{}{
+		{"a", json.Number("4")},
+		{"b", json.Number("2")},
+	}, resp.Rows)
+
+	// Failed queries don't fail the derivation.
+	_, err = d.Query(ctx, "DELETE FROM totals")
+	require.EqualError(t, err, "query must be read-only")
+	_, err = d.Query(ctx, "SELECT nope FROM totals")
+	require.ErrorContains(t, err, "no such column: nope")
+
+	// Transactions and queries continue to interleave on the stream.
+	transaction(`{"key":"a","val":10}`)
+
+	resp, err = d.Query(ctx, "SELECT total FROM totals WHERE key = ?", "a")
+	require.NoError(t, err)
+	require.Equal(t, [][]interface{}{{json.Number("14")}}, resp.Rows)
+	require.Len(t, d.queryLock, 0)
+
+	require.NoError(t, stream.CloseSend())
+}
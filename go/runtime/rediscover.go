@@ -0,0 +1,285 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/estuary/flow/go/bindings"
+	"github.com/estuary/flow/go/labels"
+	pc "github.com/estuary/flow/go/protocols/capture"
+	pf "github.com/estuary/flow/go/protocols/flow"
+	"github.com/estuary/flow/go/protocols/ops"
+	pr "github.com/estuary/flow/go/protocols/runtime"
+)
+
+// startRediscovers starts a background re-discover loop of the current task
+// term, if the shard opts in through its RediscoverInterval label.
+// Only the first shard of a capture re-discovers, as all shards share an endpoint.
+func (c *Capture) startRediscovers() error {
+	var interval, err = labels.ParseRediscoverInterval(c.term.shardSpec.LabelSet)
+	if err != nil {
+		return err
+	} else if interval == 0 {
+		return nil
+	} else if r := c.term.labels.Range; r.KeyBegin != 0 || r.RClockBegin != 0 {
+		return nil
+	}
+
+	if minimum := c.host.Config.Flow.RediscoverMinInterval; interval < minimum {
+		ops.PublishLog(c.publisher, ops.Log_info,
+			"raising rediscover interval to the minimum of the consumer",
+			"interval", interval.String(),
+			"minimum", minimum.String(),
+		)
+		interval = minimum
+	}
+
+	go rediscoverLoop(
+		c.term.ctx,
+		c.svc,
+		c.host.Rediscovers,
+		c.publisher,
+		c.term.taskSpec,
+		c.term.labels.LogLevel,
+		interval,
+	)
+	return nil
+}
+
+// rediscoverLoop discovers the endpoint of |spec| at each |interval|, until |ctx|
+// is cancelled, and publishes ops logs of bindings which are stale with respect
+// to its discovered resources. Discovers run while holding the |rediscovers| semaphore.
+func rediscoverLoop(
+	ctx context.Context,
+	svc *bindings.TaskService,
+	rediscovers chan struct{},
+	publisher ops.Publisher,
+	spec *pf.CaptureSpec,
+	logLevel ops.Log_Level,
+	interval time.Duration,
+) {
+	// Unbound resources which were reported by the last discover.
+	var reported = make(map[string]struct{})
+
+	for {
+		// Jitter by up to a tenth of the interval, so that captures sharing
+		// an interval don't re-discover in lock-step.
+		var timer = time.NewTimer(interval + time.Duration(rand.Int63n(int64(interval/10)+1)))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case rediscovers <- struct{}{}:
+		}
+
+		var discovered, err = rediscover(ctx, svc, spec, logLevel)
+		<-rediscovers
+
+		if ctx.Err() != nil {
+			return
+		} else if err != nil {
+			ops.PublishLog(publisher, ops.Log_warn,
+				"failed to re-discover capture endpoint (will retry)",
+				"error", err,
+			)
+			continue
+		}
+		reported = reportStaleBindings(publisher, spec, discovered, reported)
+	}
+}
+
+// rediscover runs a Discover of the endpoint of |spec|.
+func rediscover(
+	ctx context.Context,
+	svc *bindings.TaskService,
+	spec *pf.CaptureSpec,
+	logLevel ops.Log_Level,
+) (*pc.Response_Discovered, error) {
+	var ctx2, cancel = context.WithTimeout(ctx, rediscoverTimeout)
+	defer cancel()
+
+	stream, err := pc.NewConnectorClient(svc.Conn()).Capture(ctx2)
+	if err != nil {
+		return nil, fmt.Errorf("starting capture stream: %w", err)
+	}
+	_ = stream.Send(&pc.Request{
+		Discover: &pc.Request_Discover{
+			ConnectorType: spec.ConnectorType,
+			ConfigJson:    spec.ConfigJson,
+		},
+		Internal: pr.ToInternal(&pr.CaptureRequestExt{LogLevel: logLevel}),
+	})
+	_ = stream.CloseSend()
+
+	response, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	} else if response.Discovered == nil {
+		return nil, fmt.Errorf("connector sent an unexpected response (expected Discovered)")
+	}
+	return response.Discovered, nil
+}
+
+// reportStaleBindings compares the bindings of |spec| with |discovered| resources,
+// and publishes ops logs of bindings whose resources or columns are no longer
+// discovered, and of discovered resources which are unbound. Unbound resources
+// are reported only if they're not in |reported|, and the current set of unbound
+// resources is returned.
+func reportStaleBindings(
+	publisher ops.Publisher,
+	spec *pf.CaptureSpec,
+	discovered *pc.Response_Discovered,
+	reported map[string]struct{},
+) map[string]struct{} {
+	var byPath = make(map[string]*pc.Response_Discovered_Binding, len(discovered.Bindings))
+	for _, b := range discovered.Bindings {
+		byPath[resourceKey(b.ResourcePath)] = b
+	}
+	var bound = make(map[string]struct{}, len(spec.Bindings))
+
+	for index, binding := range spec.Bindings {
+		var key = resourceKey(binding.ResourcePath)
+		bound[key] = struct{}{}
+
+		var d, ok = byPath[key]
+		if !ok {
+			ops.PublishLog(publisher, ops.Log_warn,
+				"bound resource is no longer discovered, and may have been dropped",
+				"event", "staleBinding",
+				"binding", index,
+				"resource", strings.Join(binding.ResourcePath, "."),
+				"collection", binding.Collection.Name.String(),
+			)
+			continue
+		}
+
+		var boundColumns, ok1 = schemaProperties(binding.Collection.WriteSchemaJson)
+		var discoveredColumns, ok2 = schemaProperties(d.DocumentSchemaJson)
+		if !ok1 || !ok2 {
+			continue // Columns cannot be compared.
+		}
+
+		var dropped []string
+		for column := range boundColumns {
+			if _, ok := discoveredColumns[column]; !ok && column != "_meta" {
+				dropped = append(dropped, column)
+			}
+		}
+		if len(dropped) == 0 {
+			continue
+		}
+		sort.Strings(dropped)
+
+		ops.PublishLog(publisher, ops.Log_warn,
+			"bound collection has columns which are no longer discovered, and may have been dropped",
+			"event", "staleBinding",
+			"binding", index,
+			"resource", strings.Join(binding.ResourcePath, "."),
+			"collection", binding.Collection.Name.String(),
+			"columns", dropped,
+		)
+	}
+
+	var unbound = make(map[string]struct{})
+	var added []string
+
+	for _, d := range discovered.Bindings {
+		var key = resourceKey(d.ResourcePath)
+		if _, ok := bound[key]; ok {
+			continue
+		}
+		unbound[key] = struct{}{}
+
+		if _, ok := reported[key]; !ok {
+			added = append(added, strings.Join(d.ResourcePath, "."))
+		}
+	}
+	if len(added) != 0 {
+		sort.Strings(added)
+
+		ops.PublishLog(publisher, ops.Log_info,
+			"discovered resources which aren't bound by the capture",
+			"event", "unboundResources",
+			"resources", added,
+		)
+	}
+
+	return unbound
+}
+
+// resourceKey returns a map key of a resource |path|.
+func resourceKey(path []string) string {
+	return strings.Join(path, "\x00")
+}
+
+// schemaProperties returns the top-level properties of a JSON |schema|, which are
+// the properties of its root or its root's combinators and local references.
+// It returns false if the schema has no top-level properties which may be compared.
+func schemaProperties(schema json.RawMessage) (map[string]struct{}, bool) {
+	var root map[string]json.RawMessage
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return nil, false
+	}
+	var out = make(map[string]struct{})
+	collectSchemaProperties(root, root, out, 0)
+
+	return out, len(out) != 0
+}
+
+func collectSchemaProperties(root, schema map[string]json.RawMessage, out map[string]struct{}, depth int) {
+	if depth > maxSchemaPropertiesDepth {
+		return
+	}
+
+	var properties map[string]json.RawMessage
+	if json.Unmarshal(schema["properties"], &properties) == nil {
+		for name := range properties {
+			out[name] = struct{}{}
+		}
+	}
+
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+		var subs []map[string]json.RawMessage
+		if json.Unmarshal(schema[keyword], &subs) != nil {
+			continue
+		}
+		for _, sub := range subs {
+			collectSchemaProperties(root, sub, out, depth+1)
+		}
+	}
+
+	var ref string
+	if json.Unmarshal(schema["$ref"], &ref) != nil {
+		return
+	}
+	var defs map[string]map[string]json.RawMessage
+	if json.Unmarshal(root["$defs"], &defs) != nil {
+		return
+	}
+	for name, def := range defs {
+		var id string
+		_ = json.Unmarshal(def["$id"], &id)
+
+		if ref == "#/$defs/"+name || ref == name || (id != "" && ref == id) {
+			collectSchemaProperties(root, def, out, depth+1)
+		}
+	}
+}
+
+// rediscoverTimeout bounds the duration of one background re-discover.
+var rediscoverTimeout = 10 * time.Minute
+
+// maxSchemaPropertiesDepth bounds the combinators and references which are
+// followed while collecting the top-level properties of a schema.
+var maxSchemaPropertiesDepth = 8
@@ -39,6 +39,7 @@ type taskBase[TaskSpec pf.Task] struct {
 	host             *FlowConsumer                           // Host Consumer application of the shard.
 	legacyCheckpoint pc.Checkpoint                           // Legacy state.json runtime checkpoint.
 	legacyState      json.RawMessage                         // Legacy state.json connector state.
+	memory           *flow.MemoryBudget                      // Accounted memory of the task.
 	publisher        *OpsPublisher                           // ops.Publisher of task ops.Logs and ops.Stats.
 	recorder         *recoverylog.Recorder                   // Recorder of the shard's recovery log.
 	svc              *bindings.TaskService                   // Associated Rust runtime service.
@@ -94,9 +95,13 @@ func newTaskBase[TaskSpec pf.Task](
 		return nil, fmt.Errorf("creating task service: %w", err)
 	}
 
+	var memory = flow.NewMemoryBudget(host.Config.Flow.TaskMemoryLimit)
+
 	// Start a long-lived task which writes stats at regular intervals,
 	// and then logs the final exit status of this shard.
-	go taskHeartbeatLoop(shard, publisher)
+	go taskHeartbeatLoop(shard, publisher, memory)
+	// Events of retried appends of the shard are published to the task.
+	host.registerShardPublisher(shard.Spec().Id, publisher)
 
 	return &taskBase[TaskSpec]{
 		container:        atomic.Pointer[pr.Container]{},
@@ -104,6 +109,7 @@ func newTaskBase[TaskSpec pf.Task](
 		host:             host,
 		legacyCheckpoint: legacyCheckpoint,
 		legacyState:      legacyState,
+		memory:           memory,
 		publisher:        publisher,
 		recorder:         recorder,
 		svc:              svc,
@@ -116,6 +122,13 @@ func (t *taskBase[TaskSpec]) initTerm(shard consumer.Shard) error {
 	if err != nil {
 		return err
 	}
+	memoryLimit, err := labels.ParseMemoryLimit(next.shardSpec.LabelSet)
+	if err != nil {
+		return fmt.Errorf("parsing task memory limit: %w", err)
+	} else if memoryLimit == 0 {
+		memoryLimit = t.host.Config.Flow.TaskMemoryLimit
+	}
+	t.memory.SetLimit(memoryLimit)
 
 	ops.PublishLog(t.publisher, ops.Log_info,
 		"initialized catalog task term",
@@ -131,11 +144,12 @@ func (t *taskBase[TaskSpec]) initTerm(shard consumer.Shard) error {
 	return nil
 }
 
-func (t *taskBase[TaskSpec]) proxyHook() (*pr.Container, ops.Publisher) {
-	return t.container.Load(), t.publisher
+func (t *taskBase[TaskSpec]) proxyHook() (*pr.Container, ops.Publisher, *flow.MemoryBudget) {
+	return t.container.Load(), t.publisher, t.memory
 }
 
 func (t *taskBase[TaskSpec]) drop() {
+	t.host.unregisterShardPublisher(t.term.shardSpec.Id, t.publisher)
 	t.svc.Drop()
 }
 
@@ -228,6 +242,7 @@ func (t *taskReader[TaskSpec]) initTerm(shard consumer.Shard) error {
 		t.term.labels.Build,
 		t.term.ctx.Done(), // Drain reads upon term cancellation.
 		t.host.Journals,
+		t.memory,
 		t.publisher,
 		t.host.Service,
 		t.term.shardSpec.Id,
@@ -269,9 +284,9 @@ func (t *taskReader[TaskSpec]) ReadThrough(offsets pb.Offsets) (pb.Offsets, erro
 
 func (t *taskReader[TaskSpec]) Coordinator() *shuffle.Coordinator { return t.coordinator }
 
-// taskHeartbeatLoop publishes ops.Stats at regular intervals while the shard is running,
-// and then logs its final exit status.
-func taskHeartbeatLoop(shard consumer.Shard, pub *OpsPublisher) {
+// taskHeartbeatLoop publishes interval ops.Stats, including memory usage,
+// at regular intervals while the shard is running, and then logs its final exit status.
+func taskHeartbeatLoop(shard consumer.Shard, pub *OpsPublisher, memory *flow.MemoryBudget) {
 	var (
 		// Period between regularly-published stat intervals.
 		// This period must cleanly divide into one hour!
@@ -284,7 +299,9 @@ func taskHeartbeatLoop(shard consumer.Shard, pub *OpsPublisher) {
 	for {
 		select {
 		case now := <-time.After(jitter + durationToNextInterval(time.Now(), period)):
-			_ = pub.PublishStats(*intervalStats(now, period, pub.Labels()), pub.logsPublisher.PublishCommitted)
+			var stats = intervalStats(now, period, pub.Labels(), memory)
+			_ = pub.PublishStats(*stats, pub.logsPublisher.PublishCommitted)
+			warnMemoryExceeded(pub, stats.Interval)
 
 		case <-op.Done():
 			if err := op.Err(); err != nil && !errors.Is(err, context.Canceled) {
@@ -296,8 +313,23 @@ func taskHeartbeatLoop(shard consumer.Shard, pub *OpsPublisher) {
 	}
 }
 
-// intervalStats returns an ops.Stats for a task's current time interval.
-func intervalStats(now time.Time, period time.Duration, labels ops.ShardLabeling) *ops.Stats {
+// warnMemoryExceeded logs a warning if the task exceeded its
+// memory limit during the |interval|, and was back-pressured.
+func warnMemoryExceeded(pub ops.Publisher, interval *ops.Stats_Interval) {
+	if interval.MemoryLimitBytes == 0 || interval.MemoryPeakBytes <= interval.MemoryLimitBytes {
+		return
+	}
+	ops.PublishLog(pub, ops.Log_warn,
+		"task memory usage exceeded its limit and was back-pressured",
+		"usage", interval.MemoryUsageBytes,
+		"peak", interval.MemoryPeakBytes,
+		"limit", interval.MemoryLimitBytes,
+	)
+}
+
+// intervalStats returns an ops.Stats for a task's current time interval,
+// which takes the peak memory usage of the interval from |memory|.
+func intervalStats(now time.Time, period time.Duration, labels ops.ShardLabeling, memory *flow.MemoryBudget) *ops.Stats {
 	var usageRate float32
 
 	// Variable rate is currently fixed to 1.0 or 0.0 depending on task type.
@@ -312,13 +344,17 @@ func intervalStats(now time.Time, period time.Duration, labels ops.ShardLabeling
 	if err != nil {
 		panic(err)
 	}
+	var usage, peak = memory.Usage(), memory.TakePeak()
 
 	return &ops.Stats{
 		Shard:     ops.NewShardRef(labels),
 		Timestamp: ts,
 		Interval: &ops.Stats_Interval{
-			UptimeSeconds: uint32(math.Round(period.Seconds())),
-			UsageRate:     usageRate,
+			UptimeSeconds:    uint32(math.Round(period.Seconds())),
+			UsageRate:        usageRate,
+			MemoryUsageBytes: uint64(usage.Total()),
+			MemoryPeakBytes:  uint64(peak),
+			MemoryLimitBytes: uint64(usage.Limit),
 		},
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/estuary/flow/go/flow"
 	pf "github.com/estuary/flow/go/protocols/flow"
 	"github.com/estuary/flow/go/protocols/ops"
 	"github.com/stretchr/testify/require"
@@ -35,11 +36,25 @@ func TestIntervalStatsShape(t *testing.T) {
 
 	require.Equal(t,
 		`shard:<kind:capture name:"some/task" key_begin:"00000000" r_clock_begin:"00000000" > timestamp:<seconds:1600000000 > interval:<uptime_seconds:300 usage_rate:1 > `,
-		intervalStats(time.Unix(1600000000, 0), 5*time.Minute, labels).String())
+		intervalStats(time.Unix(1600000000, 0), 5*time.Minute, labels, nil).String())
 
 	labels.TaskType = ops.TaskType_derivation
 
 	require.Equal(t,
 		`shard:<kind:derivation name:"some/task" key_begin:"00000000" r_clock_begin:"00000000" > timestamp:<seconds:1500000000 > interval:<uptime_seconds:600 > `,
-		intervalStats(time.Unix(1500000000, 0), 10*time.Minute, labels).String())
+		intervalStats(time.Unix(1500000000, 0), 10*time.Minute, labels, nil).String())
+
+	// Memory usage of the interval is taken from the task's budget.
+	var memory = flow.NewMemoryBudget(1 << 20)
+	memory.Acquire(flow.MemoryCombine, 3<<20)
+	memory.Release(flow.MemoryCombine, 2<<20)
+	memory.Acquire(flow.MemoryReadAhead, 1024)
+
+	require.Equal(t,
+		`shard:<kind:derivation name:"some/task" key_begin:"00000000" r_clock_begin:"00000000" > timestamp:<seconds:1500000000 > interval:<uptime_seconds:600 memory_usage_bytes:1049600 memory_peak_bytes:3145728 memory_limit_bytes:1048576 > `,
+		intervalStats(time.Unix(1500000000, 0), 10*time.Minute, labels, memory).String())
+
+	// The peak was reset by the prior interval.
+	require.Equal(t, uint64(1049600),
+		intervalStats(time.Unix(1500000000, 0), 10*time.Minute, labels, memory).Interval.MemoryPeakBytes)
 }
@@ -3,6 +3,7 @@ package shuffle
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"runtime/pprof"
 	"sort"
 	"strings"
@@ -25,6 +26,7 @@ type ReadBuilder struct {
 	buildID   string
 	drainCh   <-chan struct{}
 	journals  flow.Journals
+	memory    *flow.MemoryBudget
 	publisher ops.Publisher
 	service   *consumer.Service
 	shardID   pc.ShardID
@@ -47,10 +49,12 @@ type ReadBuilder struct {
 // and scoped to the context of the given |shardID|.
 // When |drainCh| closes, the ReadBuilder will gracefully converge
 // to a drained state with no active reads.
+// Read-ahead responses are accounted to the |memory| budget, which may be nil.
 func NewReadBuilder(
 	buildID string,
 	drainCh <-chan struct{},
 	journals flow.Journals,
+	memory *flow.MemoryBudget,
 	publisher ops.Publisher,
 	service *consumer.Service,
 	shardID pc.ShardID,
@@ -92,6 +96,7 @@ func NewReadBuilder(
 		drainCh:         drainCh,
 		journals:        journals,
 		members:         members,
+		memory:          memory,
 		publisher:       publisher,
 		service:         service,
 		shardID:         shardID,
@@ -120,6 +125,7 @@ func (rb *ReadBuilder) ReadThrough(offsets pb.Offsets) (pb.Offsets, error) {
 }
 
 type read struct {
+	memory    *flow.MemoryBudget
 	publisher ops.Publisher
 	req       pr.ShuffleRequest
 	resp      pr.IndexedShuffleResponse
@@ -151,6 +157,7 @@ func (rb *ReadBuilder) buildReplayRead(journal pb.Journal, begin, end pb.Offset)
 			}
 
 			out = &read{
+				memory:    nil, // Replays are not accounted as read-ahead.
 				publisher: rb.publisher,
 				req: pr.ShuffleRequest{
 					Journal:         spec.Name,
@@ -234,6 +241,7 @@ func (rb *ReadBuilder) buildReads(
 			// A *read of this journal doesn't exist. Start one.
 
 			added[spec.Name] = &read{
+				memory:    rb.memory,
 				publisher: rb.publisher,
 				req: pr.ShuffleRequest{
 					Journal:         spec.Name,
@@ -361,6 +369,9 @@ func (r *read) start(
 // as the channel becomes full, up to the channel capacity, after which we
 // cancel the read to release its server-side resources and prevent the server
 // from blocking on send going forward.
+//
+// If the task's memory budget is exceeded, the read back-pressures as though
+// its channel held one more response than it does.
 func (r *read) sendReadResult(resp *pr.ShuffleResponse, err error, wakeCh chan<- struct{}) error {
 	if err != nil {
 		// This is a final call, delivering a terminal error.
@@ -386,8 +397,13 @@ func (r *read) sendReadResult(resp *pr.ShuffleResponse, err error, wakeCh chan<-
 		return context.Canceled
 	}
 
-	if queue != 0 {
-		var dur = time.Millisecond << (queue - 1)
+	var backoffQueue = queue
+	if r.memory.Exceeded() {
+		backoffQueue++
+	}
+
+	if backoffQueue != 0 {
+		var dur = time.Millisecond << (backoffQueue - 1)
 		var timer = time.NewTimer(dur)
 
 		select {
@@ -416,6 +432,8 @@ func (r *read) sendReadResult(resp *pr.ShuffleResponse, err error, wakeCh chan<-
 		}
 	}
 
+	r.memory.Acquire(flow.MemoryReadAhead, responseMemory(resp))
+
 	select {
 	case r.ch <- resp:
 	default:
@@ -453,6 +471,7 @@ func (r *read) onRead(p *pr.ShuffleResponse, ok bool) error {
 	} else if !ok {
 		panic("read !ok but chErr is nil")
 	}
+	r.memory.Release(flow.MemoryReadAhead, responseMemory(p))
 
 	r.resp.ShuffleResponse = *p
 	r.resp.Index = 0 // Reset.
@@ -479,6 +498,24 @@ func (r *read) dequeue() message.Envelope {
 	return env
 }
 
+// discard cancels the read and releases its queued responses,
+// which will never be dequeued.
+func (r *read) discard() {
+	r.cancel()
+
+	go func() {
+		for resp := range r.ch {
+			r.memory.Release(flow.MemoryReadAhead, responseMemory(resp))
+		}
+	}()
+}
+
+// responseMemory approximates the memory held by a queued ShuffleResponse
+// by its encoded size.
+func responseMemory(resp *pr.ShuffleResponse) int64 {
+	return int64(resp.ProtoSize())
+}
+
 func (r *read) log(lvl ops.Log_Level, message string, fields ...interface{}) {
 	if lvl > r.publisher.Labels().LogLevel {
 		return
@@ -548,9 +585,16 @@ func walkReads(id pc.ShardID, shardSpecs []*pc.ShardSpec, allJournals flow.Journ
 	allJournals.Mu.RLock()
 	defer allJournals.Mu.RUnlock()
 
+	for _, remote := range allJournals.Remotes {
+		remote.Mu.RLock()
+		defer remote.Mu.RUnlock()
+	}
+
 	for shuffleIndex, shuffle := range shuffles {
-		var prefix = allocator.ItemKey(allJournals.KeySpace, shuffle.sourceCollection.String()) + "/"
-		var sources = allJournals.Prefixed(prefix)
+		// Journals of a federated collection are listed by its remote KeySpace.
+		var ks, _ = allJournals.For(shuffle.sourceCollection.String())
+		var prefix = allocator.ItemKey(ks, shuffle.sourceCollection.String()) + "/"
+		var sources = ks.Prefixed(prefix)
 
 		for _, kv := range sources {
 			var source = kv.Decoded.(allocator.Item).ItemValue.(*pb.JournalSpec)
@@ -704,16 +748,20 @@ func backoff(attempt int) time.Duration {
 	// cluster to converge on a shared understanding of ownership, and that
 	// involves a couple of Nagle-like read delays (~30ms) as Etcd watch
 	// updates are applied by participants.
+	var d time.Duration
 	switch attempt {
 	case 0:
 		return 0
 	case 1:
-		return time.Millisecond * 50
+		d = time.Millisecond * 50
 	case 2, 3:
-		return time.Millisecond * 100
+		d = time.Millisecond * 100
 	case 4, 5:
-		return time.Second
+		d = time.Second
 	default:
-		return 5 * time.Second
+		d = 5 * time.Second
 	}
+	// Jitter by +/- 20%, so that many reads which failed together
+	// (e.x. due to a broker or coordinator restart) don't retry in lockstep.
+	return d - d/5 + time.Duration(rand.Int63n(int64(2*d/5)+1))
 }
@@ -31,6 +31,7 @@ func TestReadBuilding(t *testing.T) {
 			"build-id",
 			drainCh,
 			flow.Journals{KeySpace: &keyspace.KeySpace{Root: allJournals.Root}},
+			nil, // Memory is not accounted.
 			localPublisher,
 			nil, // Service is not used.
 			allShards[1].Id,
@@ -314,6 +315,43 @@ func TestReadSendBackoffAndCancel(t *testing.T) {
 	<-wakeCh // Was signaled.
 }
 
+func TestReadMemoryAccounting(t *testing.T) {
+	var r = &read{
+		memory:    flow.NewMemoryBudget(64),
+		publisher: localPublisher,
+		ch:        make(chan *pr.ShuffleResponse, 4),
+		drainedCh: make(chan struct{}, 1),
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	var wakeCh = make(chan struct{}, 1)
+
+	var resp = &pr.ShuffleResponse{Arena: make(pf.Arena, 100)}
+	var size = int64(resp.ProtoSize())
+
+	// Queued responses are accounted as read-ahead memory.
+	require.NoError(t, r.sendReadResult(resp, nil, wakeCh))
+	require.Equal(t, flow.MemoryUsage{Limit: 64, ReadAhead: size}, r.memory.Usage())
+	require.True(t, r.memory.Exceeded())
+
+	// Dequeued responses are released.
+	var rr, ok = <-r.ch
+	require.NoError(t, r.onRead(rr, ok))
+	require.Equal(t, flow.MemoryUsage{Limit: 64}, r.memory.Usage())
+
+	// Over budget, a send to an empty channel backs off until it's drained.
+	r.memory.Acquire(flow.MemoryProxy, 65)
+	r.drainedCh <- struct{}{}
+	require.NoError(t, r.sendReadResult(resp, nil, wakeCh))
+	require.Equal(t, size, r.memory.Usage().ReadAhead)
+	r.memory.Release(flow.MemoryProxy, 65)
+
+	// Discarded reads release responses which remain queued.
+	require.NoError(t, r.sendReadResult(nil, io.EOF, wakeCh))
+	r.discard()
+	require.Eventually(t, func() bool { return r.memory.Usage().ReadAhead == 0 },
+		time.Second, time.Millisecond)
+}
+
 func TestReadSendBackoffAndWake(t *testing.T) {
 	const capacity = 24 // Very long backoff interval.
 	var r = &read{
@@ -583,3 +621,23 @@ func buildReadTestJournalsAndTransforms() (flow.Journals, []*pc.ShardSpec, *pf.C
 	}
 	return journals, shards, task
 }
+
+func TestBackoffJitter(t *testing.T) {
+	require.Equal(t, time.Duration(0), backoff(0))
+
+	for _, tc := range []struct {
+		attempt int
+		base    time.Duration
+	}{
+		{1, 50 * time.Millisecond},
+		{2, 100 * time.Millisecond},
+		{5, time.Second},
+		{9, 5 * time.Second},
+	} {
+		for i := 0; i != 100; i++ {
+			var b = backoff(tc.attempt)
+			require.GreaterOrEqual(t, b, tc.base-tc.base/5)
+			require.LessOrEqual(t, b, tc.base+tc.base/5)
+		}
+	}
+}
@@ -41,6 +41,8 @@ type governor struct {
 	idle map[pb.Journal]pb.Offset
 	// Number of started reads since the last read document.
 	attempts map[pb.Journal]int
+	// Journals having reads which have failed since their last read document.
+	failing map[pb.Journal]*readFailure
 	// Channel signaled by readers when a new ShuffleResponse has
 	// been sent on the *read's channel. Used to wake poll() when
 	// blocking for more data.
@@ -68,6 +70,7 @@ func newGovernor(rb *ReadBuilder, cp pc.Checkpoint, tp *flow.Timepoint) *governo
 		active:      make(map[pb.Journal]*read),
 		idle:        offsets,
 		attempts:    make(map[pb.Journal]int),
+		failing:     make(map[pb.Journal]*readFailure),
 		readReadyCh: make(chan struct{}, 1),
 	}
 	g.wallTime.Update(time.Now())
@@ -80,9 +83,11 @@ func (g *governor) serveDocuments(ctx context.Context, ch chan<- consumer.Envelo
 	defer close(ch)
 
 	defer func() {
-		// Clean up remaining metrics for reads still active at time of terminal error.
+		// Clean up remaining metrics and queued responses
+		// of reads still active at time of terminal error.
 		for _, r := range g.active {
 			g.setPollState(r, pollStateIdle)
+			r.discard()
 		}
 	}()
 
@@ -162,6 +167,12 @@ func StartReplayRead(ctx context.Context, rb *ReadBuilder, journal pb.Journal, b
 // The supplied Context -- associated with the owning Shard -- is used
 // with started reads.
 func (g *governor) next(ctx context.Context) (message.Envelope, error) {
+	// If the task is over its memory budget, hold back further documents
+	// so that the current transaction closes and releases its combined memory.
+	if err := g.rb.memory.Wait(ctx); err != nil {
+		return message.Envelope{}, err
+	}
+
 	for {
 		if g.mustPoll || len(g.queued) == 0 {
 			if err := g.poll(ctx); err == errPollAgain {
@@ -271,7 +282,7 @@ func (g *governor) poll(ctx context.Context) error {
 			// shard assignments change and the read is restarted against
 			// an new coordinator. Other errors aren't as typical.
 			if err != context.Canceled {
-				r.log(ops.Log_warn, "shuffled read failed (will retry)", "error", err)
+				g.onReadFailure(r, err)
 			} else {
 				r.log(ops.Log_debug, "shuffled read has drained")
 			}
@@ -298,6 +309,7 @@ func (g *governor) poll(ctx context.Context) error {
 			// Successful read. Queue it for consumption.
 			delete(g.pending, r)
 			delete(g.attempts, r.spec.Name)
+			g.onReadSuccess(r)
 			g.setPollState(r, pollStateReady)
 			heap.Push(&g.queued, r)
 		}
@@ -407,3 +419,45 @@ func (g *governor) setPollState(r *read, state float64) {
 		pollState.WithLabelValues(g.rb.shardID.String(), r.req.Journal.String()).Set(state)
 	}
 }
+
+// readFailure tracks consecutive failures of reads of a journal.
+type readFailure struct {
+	since    time.Time // Time of the first failure.
+	failures int       // Number of failures.
+}
+
+// onReadFailure tracks a failed read of |r|, which will be retried.
+// The first failure publishes an event that reads of the journal are
+// degraded, and further failures are logged until a read succeeds.
+func (g *governor) onReadFailure(r *read, err error) {
+	var f, ok = g.failing[r.spec.Name]
+	if !ok {
+		f = &readFailure{since: time.Now()}
+		g.failing[r.spec.Name] = f
+
+		r.log(ops.Log_warn, "shuffled reads are degraded, and are being retried", "error", err)
+	} else {
+		r.log(ops.Log_warn, "shuffled read failed (will retry)",
+			"error", err,
+			"failures", f.failures+1,
+			"elapsed", time.Since(f.since).Seconds(),
+		)
+	}
+	f.failures++
+}
+
+// onReadSuccess clears tracked failures of the journal of |r|,
+// and publishes an event that its reads have recovered.
+func (g *governor) onReadSuccess(r *read) {
+	var f, ok = g.failing[r.spec.Name]
+	if !ok {
+		return
+	}
+	delete(g.failing, r.spec.Name)
+
+	r.log(ops.Log_info,
+		"shuffled reads have recovered",
+		"failures", f.failures,
+		"elapsed", time.Since(f.since).Seconds(),
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
 	"math/rand"
@@ -303,6 +304,7 @@ func (a testApp) NewStore(shard consumer.Shard, recorder *recoverylog.Recorder)
 		a.buildID,
 		make(<-chan struct{}),
 		a.journals,
+		flow.NewMemoryBudget(0),
 		localPublisher,
 		a.service,
 		shard.Spec().Id,
@@ -372,3 +374,22 @@ type testMsg struct {
 func (m *testMsg) GetUUID() message.UUID                         { return m.Meta.UUID }
 func (m *testMsg) SetUUID(uuid message.UUID)                     { m.Meta.UUID = uuid }
 func (m *testMsg) NewAcknowledgement(pb.Journal) message.Message { return new(testMsg) }
+
+func TestGovernorReadFailureTracking(t *testing.T) {
+	var g = &governor{failing: make(map[pb.Journal]*readFailure)}
+	var r = &read{
+		publisher: localPublisher,
+		spec:      pb.JournalSpec{Name: "a/journal"},
+	}
+
+	// The first failure begins tracking, and further failures are counted.
+	g.onReadFailure(r, errors.New("whoops"))
+	require.Equal(t, 1, g.failing["a/journal"].failures)
+	g.onReadFailure(r, errors.New("whoops"))
+	require.Equal(t, 2, g.failing["a/journal"].failures)
+
+	// A successful read clears tracked failures.
+	g.onReadSuccess(r)
+	require.Empty(t, g.failing)
+	g.onReadSuccess(r) // No-op.
+}
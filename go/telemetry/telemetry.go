@@ -0,0 +1,273 @@
+// Package telemetry reports anonymous usage metrics of Flow commands,
+// which maintainers use to prioritize their work.
+//
+// Telemetry is strictly opt-in: nothing is reported unless a user has enabled
+// it (see `flowctl-go telemetry enable`). Reported events include the invoked
+// command name, the class of its error (if any), and histograms of durations.
+// They never include arguments, error messages, catalog contents, or host names.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	mbp "go.gazette.dev/core/mainboilerplate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Config is the persisted telemetry configuration of a user.
+type Config struct {
+	// Enabled is true if the user has opted into telemetry.
+	Enabled bool `json:"enabled"`
+	// InstallID is a random identifier of this installation,
+	// which isn't derived from any property of the user or host.
+	InstallID string `json:"installId,omitempty"`
+	// Endpoint to which telemetry events are POSTed.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// ConfigPath returns the path of the persisted Config.
+// It may be overridden by the FLOW_TELEMETRY_CONFIG environment variable.
+func ConfigPath() (string, error) {
+	if path := os.Getenv("FLOW_TELEMETRY_CONFIG"); path != "" {
+		return path, nil
+	}
+	var dir, err = os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locating user config directory: %w", err)
+	}
+	return filepath.Join(dir, "flowctl-go", "telemetry.json"), nil
+}
+
+// LoadConfig loads the persisted Config. If no Config has been persisted,
+// a zero-valued (and disabled) Config is returned.
+func LoadConfig() (Config, error) {
+	var cfg Config
+
+	var path, err = ConfigPath()
+	if err != nil {
+		return cfg, err
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	} else if err != nil {
+		return cfg, fmt.Errorf("reading telemetry config: %w", err)
+	} else if err = json.Unmarshal(b, &cfg); err != nil {
+		return cfg, fmt.Errorf("decoding telemetry config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Save persists the Config, generating an InstallID if it doesn't have one.
+func (c *Config) Save() error {
+	if c.InstallID == "" {
+		var id [16]byte
+		if _, err := rand.Read(id[:]); err != nil {
+			return fmt.Errorf("generating install ID: %w", err)
+		}
+		c.InstallID = hex.EncodeToString(id[:])
+	}
+
+	var path, err = ConfigPath()
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("creating telemetry config directory: %w", err)
+	}
+	b, err := json.MarshalIndent(c, "", " ")
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(path, b, 0600); err != nil {
+		return fmt.Errorf("writing telemetry config: %w", err)
+	}
+	return nil
+}
+
+// Resolve returns the endpoint to which events should be reported,
+// or a reason why events are not reported. The FLOW_TELEMETRY_ENDPOINT
+// environment variable overrides the configured Endpoint. Telemetry is
+// always suppressed if the DO_NOT_TRACK or FLOW_AIRGAPPED environment
+// variables are set.
+func (c Config) Resolve() (endpoint string, reason string) {
+	var endpointEnv = os.Getenv("FLOW_TELEMETRY_ENDPOINT")
+
+	if !c.Enabled {
+		return "", "telemetry is not enabled"
+	} else if envTrue("DO_NOT_TRACK") {
+		return "", "telemetry is suppressed by DO_NOT_TRACK"
+	} else if envTrue("FLOW_AIRGAPPED") {
+		return "", "telemetry is suppressed by air-gapped operation"
+	} else if endpointEnv != "" {
+		return endpointEnv, ""
+	} else if c.Endpoint == "" {
+		return "", "no telemetry endpoint is configured"
+	}
+	return c.Endpoint, ""
+}
+
+// Event is an anonymous usage event of a single command invocation.
+type Event struct {
+	InstallID  string               `json:"installId"`
+	Version    string               `json:"version"`
+	OS         string               `json:"os"`
+	Arch       string               `json:"arch"`
+	Command    string               `json:"command"`
+	ErrorClass string               `json:"errorClass,omitempty"`
+	Histograms map[string]Histogram `json:"histograms,omitempty"`
+}
+
+// DurationBounds are the upper bounds of Histogram buckets.
+// A final, implicit bucket holds durations beyond the last bound.
+var DurationBounds = []time.Duration{
+	100 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	5 * time.Second,
+	30 * time.Second,
+	time.Minute,
+	5 * time.Minute,
+}
+
+// Histogram is a count of observed durations, bucketed by DurationBounds.
+type Histogram struct {
+	Counts []int `json:"counts"`
+}
+
+// Observe a duration into the Histogram.
+func (h *Histogram) Observe(d time.Duration) {
+	if h.Counts == nil {
+		h.Counts = make([]int, len(DurationBounds)+1)
+	}
+	var i = sort.Search(len(DurationBounds), func(i int) bool {
+		return d <= DurationBounds[i]
+	})
+	h.Counts[i]++
+}
+
+// Observe a named duration into the histograms of this process,
+// which are included in the reported Event of the current command.
+func Observe(name string, d time.Duration) {
+	histogramsMu.Lock()
+	defer histogramsMu.Unlock()
+
+	if histograms == nil {
+		histograms = make(map[string]Histogram)
+	}
+	var h = histograms[name]
+	h.Observe(d)
+	histograms[name] = h
+}
+
+// ClassifyError maps an error into a coarse and anonymous class.
+func ClassifyError(err error) string {
+	var exitErr *exec.ExitError
+
+	if err == nil {
+		return ""
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return "deadline_exceeded"
+	} else if errors.Is(err, context.Canceled) {
+		return "canceled"
+	} else if errors.Is(err, fs.ErrNotExist) {
+		return "not_found"
+	} else if errors.As(err, &exitErr) {
+		return "subprocess_failed"
+	} else if s, ok := status.FromError(err); ok && s.Code() != codes.Unknown {
+		return "grpc_" + s.Code().String()
+	}
+	return "other"
+}
+
+// Report the invocation of |command|, which ran for |duration| and returned |err|.
+// Nothing is reported unless telemetry is enabled. Failures to report are
+// logged at debug level, and never interfere with the command.
+func Report(command string, duration time.Duration, err error) {
+	var cfg, cfgErr = LoadConfig()
+	if cfgErr != nil {
+		log.WithField("err", cfgErr).Debug("failed to load telemetry config")
+		return
+	}
+	var endpoint, reason = cfg.Resolve()
+	if endpoint == "" {
+		log.WithField("reason", reason).Debug("not reporting telemetry")
+		return
+	}
+	Observe("command", duration)
+
+	histogramsMu.Lock()
+	var event = Event{
+		InstallID:  cfg.InstallID,
+		Version:    mbp.Version,
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		Command:    command,
+		ErrorClass: ClassifyError(err),
+		Histograms: histograms,
+	}
+	histograms = nil
+	histogramsMu.Unlock()
+
+	var ctx, cancel = context.WithTimeout(context.Background(), reportTimeout)
+	defer cancel()
+
+	if err := send(ctx, endpoint, event); err != nil {
+		log.WithField("err", err).Debug("failed to report telemetry")
+	}
+}
+
+func send(ctx context.Context, endpoint string, event Event) error {
+	var body, err = json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("telemetry endpoint responded with %s", resp.Status)
+	}
+	return nil
+}
+
+func envTrue(name string) bool {
+	var b, err = strconv.ParseBool(os.Getenv(name))
+	return err == nil && b
+}
+
+var (
+	histogramsMu sync.Mutex
+	histograms   map[string]Histogram
+)
+
+// reportTimeout bounds the time spent reporting an Event,
+// so that an unreachable endpoint doesn't delay command exit.
+var reportTimeout = 2 * time.Second
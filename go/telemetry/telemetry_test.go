@@ -0,0 +1,124 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConfigRoundTripAndResolve(t *testing.T) {
+	t.Setenv("FLOW_TELEMETRY_CONFIG", filepath.Join(t.TempDir(), "nested", "telemetry.json"))
+	t.Setenv("FLOW_TELEMETRY_ENDPOINT", "")
+	t.Setenv("DO_NOT_TRACK", "")
+	t.Setenv("FLOW_AIRGAPPED", "")
+
+	// A missing config is disabled.
+	var cfg, err = LoadConfig()
+	require.NoError(t, err)
+	require.Equal(t, Config{}, cfg)
+
+	var endpoint, reason = cfg.Resolve()
+	require.Equal(t, "", endpoint)
+	require.Equal(t, "telemetry is not enabled", reason)
+
+	cfg.Enabled = true
+	_, reason = cfg.Resolve()
+	require.Equal(t, "no telemetry endpoint is configured", reason)
+
+	cfg.Endpoint = "http://example/events"
+	require.NoError(t, cfg.Save())
+	require.Len(t, cfg.InstallID, 32)
+
+	loaded, err := LoadConfig()
+	require.NoError(t, err)
+	require.Equal(t, cfg, loaded)
+
+	endpoint, _ = loaded.Resolve()
+	require.Equal(t, "http://example/events", endpoint)
+
+	t.Setenv("FLOW_TELEMETRY_ENDPOINT", "http://override/events")
+	endpoint, _ = loaded.Resolve()
+	require.Equal(t, "http://override/events", endpoint)
+
+	t.Setenv("FLOW_AIRGAPPED", "true")
+	endpoint, reason = loaded.Resolve()
+	require.Equal(t, "", endpoint)
+	require.Equal(t, "telemetry is suppressed by air-gapped operation", reason)
+
+	t.Setenv("DO_NOT_TRACK", "1")
+	_, reason = loaded.Resolve()
+	require.Equal(t, "telemetry is suppressed by DO_NOT_TRACK", reason)
+}
+
+func TestHistogramBuckets(t *testing.T) {
+	var h Histogram
+	for _, d := range []time.Duration{
+		0,
+		100 * time.Millisecond,
+		101 * time.Millisecond,
+		3 * time.Second,
+		time.Hour,
+	} {
+		h.Observe(d)
+	}
+	require.Equal(t, []int{2, 1, 0, 1, 0, 0, 0, 1}, h.Counts)
+}
+
+func TestClassifyError(t *testing.T) {
+	for _, tc := range []struct {
+		err    error
+		expect string
+	}{
+		{nil, ""},
+		{fmt.Errorf("running: %w", context.DeadlineExceeded), "deadline_exceeded"},
+		{context.Canceled, "canceled"},
+		{fmt.Errorf("opening: %w", os.ErrNotExist), "not_found"},
+		{fmt.Errorf("listing: %w", status.Error(codes.Unavailable, "secret host name")), "grpc_Unavailable"},
+		{fmt.Errorf("something with a secret message"), "other"},
+	} {
+		require.Equal(t, tc.expect, ClassifyError(tc.err))
+	}
+}
+
+func TestReport(t *testing.T) {
+	var events = make(chan Event, 1)
+	var server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		events <- event
+	}))
+	defer server.Close()
+
+	t.Setenv("FLOW_TELEMETRY_CONFIG", filepath.Join(t.TempDir(), "telemetry.json"))
+	t.Setenv("FLOW_TELEMETRY_ENDPOINT", "")
+	t.Setenv("DO_NOT_TRACK", "")
+	t.Setenv("FLOW_AIRGAPPED", "")
+
+	// Nothing is reported while telemetry is disabled.
+	Report("api build", time.Second, nil)
+
+	var cfg = Config{Enabled: true, Endpoint: server.URL}
+	require.NoError(t, cfg.Save())
+
+	Observe("build", 2*time.Second)
+	Report("api build", 20*time.Second, context.DeadlineExceeded)
+
+	var event = <-events
+	require.Equal(t, cfg.InstallID, event.InstallID)
+	require.Equal(t, "api build", event.Command)
+	require.Equal(t, "deadline_exceeded", event.ErrorClass)
+	require.Equal(t, []int{0, 0, 0, 1, 0, 0, 0, 0}, event.Histograms["build"].Counts)
+	require.Equal(t, []int{0, 0, 0, 0, 1, 0, 0, 0}, event.Histograms["command"].Counts)
+
+	require.Empty(t, events)
+}
@@ -26,6 +26,22 @@ export type Document = /* Flow catalog task stats Statistics related to the proc
         };
     };
     taskStats?: {
+        bindings?: /* Stats of each binding of a capture or materialization, keyed on the binding's state key. Unlike `capture` and `materialize`, which are keyed on collection name, bindings of the same collection are reported separately. */ {
+            [k: string]: {
+                left?: {
+                    bytesTotal: /* Total number of bytes representing the JSON encoded documents */ number;
+                    docsTotal: /* Total number of documents */ number;
+                };
+                out?: {
+                    bytesTotal: /* Total number of bytes representing the JSON encoded documents */ number;
+                    docsTotal: /* Total number of documents */ number;
+                };
+                right?: {
+                    bytesTotal: /* Total number of bytes representing the JSON encoded documents */ number;
+                    docsTotal: /* Total number of documents */ number;
+                };
+            };
+        };
         capture?: /* Capture stats, organized by collection. The keys of this object are the collection names, and the values are the stats for that collection. */ {
             [k: string]: {
                 out?: {
@@ -58,6 +74,9 @@ export type Document = /* Flow catalog task stats Statistics related to the proc
             };
         };
         interval?: {
+            memoryLimitBytes?: /* Bytes of memory which the task shard is limited to using, or zero if it has no limit */ number;
+            memoryPeakBytes?: /* Peak bytes of memory used by the task shard over the interval */ number;
+            memoryUsageBytes?: /* Bytes of memory used by the task shard as of the end of the interval */ number;
             uptimeSeconds: /* Number of seconds that the task shard is metered as having been running */ number;
             usageRate?: /* Usage rate which adjusts `uptimeSeconds` to determine the task's effective usage */ number;
         };
@@ -99,6 +118,22 @@ export type SourceLogs = /* Flow task logs Logs related to the processing of a F
 
 // Generated for read documents of sourced collection ops.us-central1.v1/stats.
 export type SourceStats = /* Flow task stats Statistics related to the processing of a Flow capture, derivation, or materialization */ {
+    bindings?: /* Stats of each binding of a capture or materialization, keyed on the binding's state key. Unlike `capture` and `materialize`, which are keyed on collection name, bindings of the same collection are reported separately. */ {
+        [k: string]: {
+            left?: {
+                bytesTotal: /* Total number of bytes representing the JSON encoded documents */ number;
+                docsTotal: /* Total number of documents */ number;
+            };
+            out?: {
+                bytesTotal: /* Total number of bytes representing the JSON encoded documents */ number;
+                docsTotal: /* Total number of documents */ number;
+            };
+            right?: {
+                bytesTotal: /* Total number of bytes representing the JSON encoded documents */ number;
+                docsTotal: /* Total number of documents */ number;
+            };
+        };
+    };
     capture?: /* Capture stats, organized by collection. The keys of this object are the collection names, and the values are the stats for that collection. */ {
         [k: string]: {
             out?: {
@@ -131,6 +166,9 @@ export type SourceStats = /* Flow task stats Statistics related to the processin
         };
     };
     interval?: {
+        memoryLimitBytes?: /* Bytes of memory which the task shard is limited to using, or zero if it has no limit */ number;
+        memoryPeakBytes?: /* Peak bytes of memory used by the task shard over the interval */ number;
+        memoryUsageBytes?: /* Bytes of memory used by the task shard as of the end of the interval */ number;
         uptimeSeconds: /* Number of seconds that the task shard is metered as having been running */ number;
         usageRate?: /* Usage rate which adjusts `uptimeSeconds` to determine the task's effective usage */ number;
     };